@@ -34,6 +34,7 @@ import (
 	"github.com/AliceO2Group/Control/core/integration/ccdb"
 	"github.com/AliceO2Group/Control/core/integration/dcs"
 	"github.com/AliceO2Group/Control/core/integration/ddsched"
+	"github.com/AliceO2Group/Control/core/integration/declarative"
 	"github.com/AliceO2Group/Control/core/integration/kafka"
 	"github.com/AliceO2Group/Control/core/integration/lhc"
 	"github.com/AliceO2Group/Control/core/integration/odc"
@@ -81,6 +82,10 @@ func init() {
 		"testplugin",
 		"testPluginEndpoint",
 		testplugin.NewPlugin)
+	integration.RegisterPluginSet(
+		"declarative",
+		"declarativePlugins",
+		declarative.NewPlugins)
 
 	log.SetFormatter(&prefixed.TextFormatter{
 		FullTimestamp: true,
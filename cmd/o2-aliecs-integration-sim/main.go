@@ -0,0 +1,130 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// o2-aliecs-integration-sim serves local simulators of the DCS, TRG, ODC and DD scheduler
+// gRPC services, for testing AliECS integration plugins without the real services.
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/AliceO2Group/Control/core/integration/simulator"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	"github.com/teo/logrus-prefixed-formatter"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	log.SetFormatter(&prefixed.TextFormatter{
+		FullTimestamp: true,
+		SpacePadding:  20,
+		PrefixPadding: 12,
+
+		// Needed for colored stdout/stderr in GoLand, IntelliJ, etc.
+		ForceColors:     true,
+		ForceFormatting: true,
+	})
+	log.SetOutput(os.Stdout)
+}
+
+func main() {
+	// defaults match the service endpoints in the AliECS core configuration
+	endpoints := map[string]*string{
+		simulator.DCS:     pflag.String("dcs", ":50051", "Listen address of the DCS service simulator, empty to disable"),
+		simulator.DDSCHED: pflag.String("ddsched", ":50052", "Listen address of the DD scheduler simulator, empty to disable"),
+		simulator.ODC:     pflag.String("odc", ":50053", "Listen address of the ODC simulator, empty to disable"),
+		simulator.TRG:     pflag.String("trg", ":50060", "Listen address of the TRG service simulator, empty to disable"),
+	}
+	scenarioPath := pflag.String("scenario", "", "YAML file scripting the behaviour of the simulators, reloaded on SIGHUP")
+	verbose := pflag.Bool("verbose", false, "Log every simulated call")
+	pflag.Parse()
+
+	if *verbose {
+		log.SetLevel(log.DebugLevel)
+	}
+
+	scenario, err := simulator.LoadScenario(*scenarioPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sim := simulator.New(scenario)
+
+	servers := make([]*grpc.Server, 0, len(simulator.Services))
+	errCh := make(chan error, len(simulator.Services))
+	for _, service := range simulator.Services {
+		addr := *endpoints[service]
+		if len(addr) == 0 {
+			continue
+		}
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			log.Fatalf("cannot listen for %s simulator on %s: %v", service, addr, err)
+		}
+		server, err := sim.NewServer(service)
+		if err != nil {
+			log.Fatal(err)
+		}
+		servers = append(servers, server)
+		go func(service string) {
+			if err := server.Serve(lis); err != nil {
+				errCh <- fmt.Errorf("%s simulator: %w", service, err)
+			}
+		}(service)
+		log.WithField("endpoint", lis.Addr().String()).
+			Infof("%s simulator listening", service)
+	}
+	if len(servers) == 0 {
+		log.Fatal("all simulators disabled, nothing to do")
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+	for {
+		select {
+		case err := <-errCh:
+			log.Fatal(err)
+		case sig := <-signals:
+			if sig == syscall.SIGHUP {
+				scenario, err := simulator.LoadScenario(*scenarioPath)
+				if err != nil {
+					log.WithError(err).Error("cannot reload scenario, keeping the current one")
+					continue
+				}
+				sim.SetScenario(scenario)
+				log.WithField("scenario", *scenarioPath).Info("scenario reloaded")
+				continue
+			}
+			log.Infof("received %s, shutting down", sig.String())
+			sim.Stop()
+			for _, server := range servers {
+				server.GracefulStop()
+			}
+			return
+		}
+	}
+}
@@ -27,11 +27,18 @@ package coconut
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
 
 	"github.com/AliceO2Group/Control/coconut/protos"
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var log = logger.New(logrus.StandardLogger(), "coconut")
@@ -42,11 +49,21 @@ type internalState struct {
 }
 
 func NewClient(cxt context.Context, cancel context.CancelFunc, endpoint string) *RpcClient {
+	credentialOpts, err := dialCredentials()
+	if err != nil {
+		log.WithField("error", err.Error()).
+			WithField("endpoint", endpoint).
+			Errorf("cannot set up RPC credentials")
+		cancel()
+		return nil
+	}
+
 	conn, err := grpc.DialContext(
 		cxt,
 		endpoint,
-		grpc.WithInsecure(),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(GrpcMaxCallRecvSize)),
+		append(credentialOpts,
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(GrpcMaxCallRecvSize)),
+		)...,
 	)
 	if err != nil {
 		log.WithField("error", err.Error()).
@@ -76,3 +93,56 @@ type RpcClient struct {
 func (m *RpcClient) Close() error {
 	return m.conn.Close()
 }
+
+// dialCredentials builds the transport and per-call credentials from the
+// tls_ca, tls_cert, tls_key and token settings. Without any of them, the
+// connection is insecure and anonymous.
+func dialCredentials() (opts []grpc.DialOption, err error) {
+	caPath := viper.GetString("tls_ca")
+	certPath := viper.GetString("tls_cert")
+	keyPath := viper.GetString("tls_key")
+	token := viper.GetString("token")
+
+	useTls := len(caPath) != 0 || len(certPath) != 0 || len(keyPath) != 0
+	if !useTls {
+		if len(token) != 0 {
+			return nil, fmt.Errorf("a token can only be sent over TLS, set tls_ca")
+		}
+		return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(caPath) != 0 {
+		caData, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read CA certificate: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("no valid certificates found in %s", caPath)
+		}
+	}
+	if len(certPath) != 0 || len(keyPath) != 0 {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+	if len(token) != 0 {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials(token)))
+	}
+	return opts, nil
+}
+
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
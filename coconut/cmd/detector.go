@@ -0,0 +1,49 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// detectorCmd represents the detector command
+var detectorCmd = &cobra.Command{
+	Use:     "detector",
+	Aliases: []string{"det", "d"},
+	Short:   "manage detector reservations",
+	Long: fmt.Sprintf(`The detector command allows you to reserve detectors ahead of environment
+creation, to release such reservations, and to list the detector leases held in %s.
+
+Every environment holds a lease on its detectors until it is destroyed. A reservation
+is a lease which is not held by an environment yet: it expires after its TTL, and it
+is taken over by the next environment created by the same user with those detectors.
+Environment creation requests which wait for their detectors are served in order.`, product.PRETTY_SHORTNAME),
+}
+
+func init() {
+	rootCmd.AddCommand(detectorCmd)
+}
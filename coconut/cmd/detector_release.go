@@ -0,0 +1,44 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// detectorReleaseCmd represents the detector release command
+var detectorReleaseCmd = &cobra.Command{
+	Use:     "release [detector]...",
+	Aliases: []string{"rel", "rm"},
+	Short:   "release detector reservations",
+	Long: `The detector release command releases the reservations of the current user for
+the given detectors, or all of them if no detector is passed. Detectors held by an
+environment are released when the environment is destroyed.`,
+	Run: control.WrapCall(control.ReleaseDetectors),
+}
+
+func init() {
+	detectorCmd.AddCommand(detectorReleaseCmd)
+}
@@ -0,0 +1,46 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// detectorReservationsCmd represents the detector reservations command
+var detectorReservationsCmd = &cobra.Command{
+	Use:     "reservations",
+	Aliases: []string{"list", "ls", "l"},
+	Short:   "list detector leases and queued environment requests",
+	Long: `The detector reservations command shows the detector leases, both those held by
+environments and the reservations made ahead of environment creation, followed by the
+environment creation requests waiting for their detectors, in the order in which they
+are served.`,
+	Run:  control.WrapCall(control.GetDetectorReservations),
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	detectorCmd.AddCommand(detectorReservationsCmd)
+}
@@ -0,0 +1,51 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// detectorReserveCmd represents the detector reserve command
+var detectorReserveCmd = &cobra.Command{
+	Use:     "reserve [detector]...",
+	Aliases: []string{"res", "r"},
+	Short:   "reserve detectors ahead of environment creation",
+	Long: `The detector reserve command reserves one or more detectors for the current user,
+for the duration passed via the ttl flag. The reservation fails if any of the detectors
+is in use, reserved by somebody else, or awaited by a queued environment request.
+Examples:
+  coconut detector reserve TPC ITS --ttl 2h`,
+	Run:  control.WrapCall(control.ReserveDetectors),
+	Args: cobra.MinimumNArgs(1),
+}
+
+func init() {
+	detectorCmd.AddCommand(detectorReserveCmd)
+
+	detectorReserveCmd.Flags().DurationP("ttl", "t", time.Hour, "how long the reservation lasts")
+}
@@ -31,7 +31,7 @@ import (
 
 // environmentControlCmd represents the environment list command
 var environmentControlCmd = &cobra.Command{
-	Use:     "control [environment id]",
+	Use:     "control [environment id]...",
 	Aliases: []string{"ctl", "ct", "t"},
 	Short:   "control the state machine of an environment",
 	Long: `The environment control command triggers an event in the state 
@@ -41,11 +41,20 @@ The reached state is returned.
 An event name must be passed via the mandatory event flag.
 Valid events:
   CONFIGURE            RESET                EXIT
-  START_ACTIVITY       STOP_ACTIVITY
+  START_ACTIVITY       STOP_ACTIVITY        RECOVER
 
-Not all events are available in all states.`,
+Not all events are available in all states. RECOVER brings an environment
+in ERROR back to DEPLOYED, keeping its ID.
+
+Several environments can be controlled at once, by passing more than one
+environment id, or by selecting them with --all-with-detector, --match and/or
+--with-label
+(all the given criteria must match). The transitions then run in parallel,
+and the outcome is reported for each environment:
+
+  coconut environment control --all-with-detector TPC -e STOP_ACTIVITY`,
 	Run:  control.WrapCall(control.ControlEnvironment),
-	Args: cobra.ExactArgs(1),
+	Args: cobra.ArbitraryArgs,
 }
 
 func init() {
@@ -53,4 +62,8 @@ func init() {
 
 	environmentControlCmd.Flags().StringP("event", "e", "", "environment state machine event to trigger")
 	environmentControlCmd.MarkFlagRequired("event")
+	environmentControlCmd.Flags().String("all-with-detector", "", "act on all environments which include this detector")
+	environmentControlCmd.Flags().StringToString("match", map[string]string{}, "act on all environments whose user vars have these values (key1=value1,key2=value2)")
+	environmentControlCmd.Flags().StringToString("with-label", map[string]string{}, "act on all environments which have these labels, an empty value matches any value (key1=value1,key2=)")
+	environmentControlCmd.Flags().Int32("max-concurrency", 0, "maximum number of environments transitioned in parallel (default and upper bound set by the core)")
 }
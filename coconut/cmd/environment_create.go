@@ -67,6 +67,8 @@ func init() {
 	environmentCreateCmd.Flags().BoolP("auto", "a", false, "create an autorun environment")
 	environmentCreateCmd.Flags().BoolP("public", "p", true, "control public rights of the environment")
 	environmentCreateCmd.Flags().BoolP("asynchronous", "y", false, "use asynchronous mode for environment creation")
+	environmentCreateCmd.Flags().Bool("wait-for-detectors", false, "if the detectors are in use or reserved, wait for them to be free instead of failing")
 
+	environmentCreateCmd.Flags().StringToString("label", map[string]string{}, "labels of the new environment, used to filter and select environments `key1=val1,key2=val2`")
 	environmentCreateCmd.Flags().StringP("extra-vars", "e", "", "values passed using key=value CSV or JSON syntax, interpreted as strings `key1=val1,key2=val2` or `{\"key1\": \"value1\", \"key2\": \"value2\"}`")
 }
@@ -34,16 +34,22 @@ import (
 
 // environmentDestroyCmd represents the environment list command
 var environmentDestroyCmd = &cobra.Command{
-	Use:     "destroy [environment id]",
+	Use:     "destroy [environment id]...",
 	Aliases: []string{"des", "d"},
 	Short:   "destroy an environment",
 	Long: fmt.Sprintf(`The environment destroy command instructs %s to
 teardown an existing O² environment. The environment must be in the 
 CONFIGURED or STANDBY state.
 
-By default, all active tasks are killed unless the keep-tasks flag is passed, in which case all tasks are left idle.`, product.PRETTY_SHORTNAME),
+By default, all active tasks are killed unless the keep-tasks flag is passed, in which case all tasks are left idle.
+
+Several environments can be destroyed at once, by passing more than one
+environment id, or by selecting them with --all-with-detector, --match and/or
+--with-label
+(all the given criteria must match). They are then torn down in parallel, and
+the outcome is reported for each environment.`, product.PRETTY_SHORTNAME),
 	Run:  control.WrapCall(control.DestroyEnvironment),
-	Args: cobra.ExactArgs(1),
+	Args: cobra.ArbitraryArgs,
 }
 
 func init() {
@@ -52,4 +58,8 @@ func init() {
 	environmentDestroyCmd.Flags().BoolP("keep-tasks", "k", false, "keep tasks active after destroying the environment")
 	environmentDestroyCmd.Flags().BoolP("allow-in-running-state", "a", false, "allows destroying an environment while in Running state")
 	environmentDestroyCmd.Flags().BoolP("force", "f", false, "force destroy of an environment")
+	environmentDestroyCmd.Flags().String("all-with-detector", "", "destroy all environments which include this detector")
+	environmentDestroyCmd.Flags().StringToString("match", map[string]string{}, "destroy all environments whose user vars have these values (key1=value1,key2=value2)")
+	environmentDestroyCmd.Flags().StringToString("with-label", map[string]string{}, "destroy all environments which have these labels, an empty value matches any value (key1=value1,key2=)")
+	environmentDestroyCmd.Flags().Int32("max-concurrency", 0, "maximum number of environments destroyed in parallel (default and upper bound set by the core)")
 }
@@ -0,0 +1,51 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// environmentLabelCmd represents the environment label command
+var environmentLabelCmd = &cobra.Command{
+	Use:   "label [environment id] [key=value]...",
+	Short: "set or remove labels of an environment",
+	Long: `The environment label command sets, overwrites or removes labels of an
+existing environment, and prints the resulting labels.
+
+Labels are free-form key/value pairs which can be used to filter the output of
+` + "`coconut environment list`" + ` and to select environments in bulk operations.
+Examples:
+  coconut environment label 2oDvieFrVTi owner=tpc-test purpose=calibration
+  coconut environment label 2oDvieFrVTi --remove purpose`,
+	Run:  control.WrapCall(control.SetEnvironmentLabels),
+	Args: cobra.MinimumNArgs(1),
+}
+
+func init() {
+	environmentCmd.AddCommand(environmentLabelCmd)
+
+	environmentLabelCmd.Flags().StringSliceP("remove", "r", []string{}, "keys of the labels to remove")
+}
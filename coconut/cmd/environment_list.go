@@ -35,11 +35,26 @@ var environmentListCmd = &cobra.Command{
 	Aliases: []string{"ls", "l"},
 	Short:   "list environments",
 	Long: `The environment list command shows a list of currently active environments.
-This includes environments in any state.`,
+This includes environments in any state.
+
+The list can be narrowed down with filters, which must all match. The created
+filters take either a duration, meaning that long ago, or an RFC 3339 timestamp.
+Examples:
+  coconut environment list --label owner=tpc-test --state RUNNING,CONFIGURED
+  coconut environment list --detector TPC --created-after 2h
+  coconut environment list --page-size 20`,
 	Run: control.WrapCall(control.GetEnvironments),
 }
 
 func init() {
 	environmentCmd.AddCommand(environmentListCmd)
 	environmentListCmd.Flags().BoolP("show-all", "s", false, "print public and non-public environments")
+	environmentListCmd.Flags().StringToString("label", map[string]string{}, "only environments which have these labels, an empty value matches any value (key1=value1,key2=)")
+	environmentListCmd.Flags().StringSlice("state", []string{}, "only environments in any of these states")
+	environmentListCmd.Flags().String("detector", "", "only environments which include this detector")
+	environmentListCmd.Flags().String("run-type", "", "only environments with this run type")
+	environmentListCmd.Flags().String("created-after", "", "only environments created at or after this time")
+	environmentListCmd.Flags().String("created-before", "", "only environments created before this time")
+	environmentListCmd.Flags().Int32("page-size", 0, "maximum number of environments to list, 0 for all")
+	environmentListCmd.Flags().String("page-token", "", "token of the page to list, as printed with the previous page")
 }
@@ -29,21 +29,29 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// environmentModifyCmd represents the environment list command
+// environmentModifyCmd represents the environment modify command
 var environmentModifyCmd = &cobra.Command{
 	Use:     "modify [environment id]",
 	Aliases: []string{"mod", "m"},
 	Short:   "modify an environment",
-	Long: `The environment modify command changes the roles workflow of an 
-existing O² environment.`,
+	Long: `The environment modify command changes the roles workflow of an
+existing O² environment in the CONFIGURED state.
+
+Roles to add are specified as a full role path followed by the workflow template to graft at
+that path, for example:
+  coconut environment modify 2oDvieFrVTi --addroles readout-dataflow.tpc-flp001=readout
+
+Roles to remove are specified by their full role path. The tasks of added roles are deployed
+and configured before the command returns. All other roles are left untouched, unless
+--reconfigure is passed.`,
 	Run:  control.WrapCall(control.ModifyEnvironment),
 	Args: cobra.ExactArgs(1),
 }
 
 func init() {
-	//environmentCmd.AddCommand(environmentModifyCmd)
-	//
-	//environmentModifyCmd.Flags().StringArrayP("addroles", "a", []string{}, "a list of roles to add to the environment")
-	//environmentModifyCmd.Flags().StringArrayP("removeroles", "r", []string{}, "a list of roles to remove from the environment")
-	//environmentModifyCmd.Flags().BoolP("reconfigure", "c", false, "reconfigure all roles")
+	environmentCmd.AddCommand(environmentModifyCmd)
+
+	environmentModifyCmd.Flags().StringArrayP("addroles", "a", []string{}, "a list of roles to add to the environment, as rolePath=workflowTemplate")
+	environmentModifyCmd.Flags().StringArrayP("removeroles", "r", []string{}, "a list of role paths to remove from the environment")
+	environmentModifyCmd.Flags().BoolP("reconfigure", "c", false, "reconfigure all roles")
 }
@@ -0,0 +1,63 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// environmentPlanCmd represents the environment plan command
+var environmentPlanCmd = &cobra.Command{
+	Use:     "plan [environment id]",
+	Aliases: []string{"p"},
+	Short:   "show the hooks a transition of an environment would execute",
+	Long: `The environment plan command shows what an event would do to an existing
+O² environment in its current state, without triggering it.
+
+The transition is split in steps, which run in this order:
+  before_<EVENT>       leave_<STATE>        tasks_<EVENT>
+  enter_<STATE>        after_<EVENT>
+
+For each step, the hook groups are listed by weight. In each group, calls are
+started first, then the calls awaited at that trigger and weight are waited
+for, including ones started by earlier transitions, and finally the hook tasks
+are run. Calls started by this transition but awaited at a later one are
+listed at the end.
+
+An event name must be passed via the mandatory event flag.
+Valid events:
+  CONFIGURE            RESET                EXIT
+  START_ACTIVITY       STOP_ACTIVITY        RECOVER`,
+	Example: ` * ` + "`coconut env plan 2oDvieFrVTi -e START_ACTIVITY`" + ` shows the hooks that starting a run would execute`,
+	Run:     control.WrapCall(control.GetTransitionPlan),
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	environmentCmd.AddCommand(environmentPlanCmd)
+
+	environmentPlanCmd.Flags().StringP("event", "e", "", "environment state machine event to plan")
+	environmentPlanCmd.MarkFlagRequired("event")
+}
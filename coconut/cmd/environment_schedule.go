@@ -0,0 +1,48 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// environmentScheduleCmd represents the environment schedule command
+var environmentScheduleCmd = &cobra.Command{
+	Use:     "schedule",
+	Aliases: []string{"sched"},
+	Short:   "manage scheduled environment transitions",
+	Long: `The environment schedule command allows you to schedule transitions which are
+triggered automatically, and to list and cancel them.
+
+A transition can be scheduled at a given time, after the current run (or the next one,
+if the environment is not running) has lasted for a given duration, or when an
+integrated service event satisfies a condition. Scheduled transitions are cancelled
+when their environment is destroyed. If the environment journal is enabled, they are
+restored together with their environment after a core restart, except those whose time
+passed in the meantime.`,
+}
+
+func init() {
+	environmentCmd.AddCommand(environmentScheduleCmd)
+}
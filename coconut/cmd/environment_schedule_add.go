@@ -0,0 +1,65 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// environmentScheduleAddCmd represents the environment schedule add command
+var environmentScheduleAddCmd = &cobra.Command{
+	Use:     "add [environment id]",
+	Aliases: []string{"new", "a"},
+	Short:   "schedule a transition of an environment",
+	Long: `The environment schedule add command schedules a transition of an existing
+environment. The event name is passed via the mandatory event flag, and exactly one
+of --at, --in, --after-run-duration and --when must be passed.
+
+The --when condition is evaluated on every integrated service event. All events
+provide ` + "`service`" + ` and ` + "`event`" + `, LHC events add ` + "`beamMode`" + `, ` + "`beamType`" + `, ` + "`fillNumber`" + ` and
+` + "`fillingSchemeName`" + `, and ODC partition state changes add ` + "`state`" + ` and ` + "`environmentId`" + `.
+ODC partition state changes are only matched against the schedules of their own
+environment. A conditional transition which is not possible in the current environment
+state stays scheduled until the next matching event, while a timed transition which is
+not possible when due is dropped and reported as failed.
+Examples:
+  coconut environment schedule add 2oDvieFrVTi -e STOP_ACTIVITY --at 2026-10-17T18:00:00+02:00
+  coconut environment schedule add 2oDvieFrVTi -e STOP_ACTIVITY --after-run-duration 2h
+  coconut environment schedule add 2oDvieFrVTi -e START_ACTIVITY --when 'service == "LHC" && beamMode == "STABLE_BEAMS"'`,
+	Run:  control.WrapCall(control.ScheduleTransition),
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	environmentScheduleCmd.AddCommand(environmentScheduleAddCmd)
+
+	environmentScheduleAddCmd.Flags().StringP("event", "e", "", "environment state machine event to trigger")
+	environmentScheduleAddCmd.MarkFlagRequired("event")
+	environmentScheduleAddCmd.Flags().String("at", "", "trigger at this time, as an RFC 3339 timestamp")
+	environmentScheduleAddCmd.Flags().Duration("in", 0, "trigger after this duration from now")
+	environmentScheduleAddCmd.Flags().Duration("after-run-duration", 0, "trigger once the run has lasted for this duration")
+	environmentScheduleAddCmd.Flags().String("when", "", "trigger when an integrated service event satisfies this condition")
+	environmentScheduleAddCmd.MarkFlagsMutuallyExclusive("at", "in", "after-run-duration", "when")
+}
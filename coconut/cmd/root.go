@@ -85,12 +85,20 @@ func init() {
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "show verbose output for debug purposes")
 	rootCmd.PersistentFlags().Bool("nospinner", false, "disable animations in output")
 	rootCmd.PersistentFlags().Bool("nocolor", false, "disable colors in output")
+	rootCmd.PersistentFlags().String("tls_ca", "", "path to the PEM CA bundle used to verify the core endpoint, enables TLS")
+	rootCmd.PersistentFlags().String("tls_cert", "", "path to the PEM client certificate used to authenticate with the core")
+	rootCmd.PersistentFlags().String("tls_key", "", "path to the PEM private key of the client certificate")
+	rootCmd.PersistentFlags().String("token", "", "bearer token used to authenticate with the core (requires TLS)")
 
 	viper.BindPFlag("endpoint", rootCmd.PersistentFlags().Lookup("endpoint"))
 	viper.BindPFlag("config_endpoint", rootCmd.PersistentFlags().Lookup("config_endpoint"))
 	viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	viper.BindPFlag("nospinner", rootCmd.PersistentFlags().Lookup("nospinner"))
 	viper.BindPFlag("nocolor", rootCmd.PersistentFlags().Lookup("nocolor"))
+	viper.BindPFlag("tls_ca", rootCmd.PersistentFlags().Lookup("tls_ca"))
+	viper.BindPFlag("tls_cert", rootCmd.PersistentFlags().Lookup("tls_cert"))
+	viper.BindPFlag("tls_key", rootCmd.PersistentFlags().Lookup("tls_key"))
+	viper.BindPFlag("token", rootCmd.PersistentFlags().Lookup("token"))
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -105,6 +113,10 @@ func initConfig() {
 	viper.SetDefault("verbose", false)
 	viper.SetDefault("nospinner", false)
 	viper.SetDefault("nocolor", false)
+	viper.SetDefault("tls_ca", "")
+	viper.SetDefault("tls_cert", "")
+	viper.SetDefault("tls_key", "")
+	viper.SetDefault("token", "")
 
 	if cfgFile != "" {
 		// Use config file from the flag.
@@ -0,0 +1,47 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// taskLogsCmd represents the task logs command
+var taskLogsCmd = &cobra.Command{
+	Use:     "logs [task id]",
+	Aliases: []string{"log", "l"},
+	Short:   "show the output of an O² task",
+	Long: `The task logs command fetches the output of a task from its executor.
+Only tasks whose stdout or stderr is set to ` + "`file`" + ` in their task template have their output stored.
+By default the whole stored output is shown, up to a size limit. The --lines flag shows only the last lines.`,
+	Run:  control.WrapCall(control.GetTaskLogs),
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	taskCmd.AddCommand(taskLogsCmd)
+
+	taskLogsCmd.Flags().IntP("lines", "n", 0, "show only the last lines of output (default: all)")
+}
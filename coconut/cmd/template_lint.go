@@ -0,0 +1,62 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// templateLintCmd represents the template lint command
+var templateLintCmd = &cobra.Command{
+	Use:     "lint [repo[@revision]]",
+	Aliases: []string{"check"},
+	Short:   "statically check the workflow and task templates of a repository",
+	Long: `The template lint command checks all the workflow and task templates of a repository at a given revision,
+without loading or deploying anything. The repository and revision default to the default repository and its
+default revision.
+
+The following are reported:
+ * templates which do not parse, and template expressions which are not valid
+ * variables used in templates, but defined neither in an enclosing role, nor in the configuration defaults and vars, nor by the core at runtime
+ * ` + "`include`" + ` and task ` + "`load`" + ` targets which do not exist in the repository
+ * ` + "`connect`" + ` channels whose target no role binds
+ * call roles which call functions that the plugins loaded in the core do not provide
+ * sibling roles with the same name
+
+Undefined variables and calls to plugins which are not loaded are reported as warnings, everything else as errors.
+The command fails if any error is found, or with ` + "`--strict`" + ` if any warning is found, so that it can be
+used as a check before merging changes to a workflow repository.`,
+	Example: ` * ` + "`coconut templ lint`" + ` checks the default repository at its default revision
+ * ` + "`coconut templ lint github.com/AliceO2Group/ControlWorkflows@myBranch`" + ` checks branch myBranch of the given repository
+ * ` + "`coconut templ lint github.com/AliceO2Group/ControlWorkflows@myBranch --strict`" + ` also fails on warnings`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  control.WrapCall(control.LintWorkflowTemplates),
+}
+
+func init() {
+	templateCmd.AddCommand(templateLintCmd)
+
+	templateLintCmd.Flags().BoolP("strict", "s", false, "fail on warnings as well as on errors")
+}
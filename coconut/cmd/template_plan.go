@@ -0,0 +1,57 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/spf13/cobra"
+)
+
+// templatePlanCmd represents the template plan command
+var templatePlanCmd = &cobra.Command{
+	Use:     "plan [workflow template]",
+	Aliases: []string{"p", "dry-run"},
+	Short:   "show what a workflow template expands to, without deploying it",
+	Long: `The template plan command loads and processes a workflow template with the given variables,
+exactly as environment creation would, but without deploying anything.
+
+It shows the task classes the workflow needs, the fully expanded role tree and the hooks that would be
+called for each trigger, sorted by weight. With ` + "`--details`" + `, the channels and constraints of each task
+are shown as well.
+
+The workflow template may be provided simply by name or with repository and branch/tag/hash constraints,
+as with ` + "`coconut environment create`" + `.`,
+	Example: ` * ` + "`coconut templ plan readout-dataflow`" + ` expands the readout-dataflow template from the default repository
+ * ` + "`coconut templ plan readout-dataflow@myBranch -e '{\"hosts\":\"[\\\"my-test-machine\\\"]\"}'`" + ` expands the template on branch myBranch for a given set of hosts
+ * ` + "`coconut templ plan readout-dataflow --details`" + ` also shows the channels and constraints of each task`,
+	Args: cobra.ExactArgs(1),
+	Run:  control.WrapCall(control.PlanWorkflowTemplate),
+}
+
+func init() {
+	templateCmd.AddCommand(templatePlanCmd)
+
+	templatePlanCmd.Flags().StringP("extra-vars", "e", "", "values passed using key=value CSV or JSON syntax, interpreted as strings `key1=val1,key2=val2` or `{\"key1\": \"value1\", \"key2\": \"value2\"}`")
+	templatePlanCmd.Flags().BoolP("details", "d", false, "show the channels and constraints of each task")
+}
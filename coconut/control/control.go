@@ -234,9 +234,14 @@ func Teardown(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, a
 }
 
 func GetEnvironments(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	var request *pb.GetEnvironmentsRequest
+	request, err = environmentsRequestFromFlags(cmd)
+	if err != nil {
+		return
+	}
+
 	var response *pb.GetEnvironmentsReply
-	showAll, _ := cmd.Flags().GetBool("show-all")
-	response, err = rpc.GetEnvironments(cxt, &pb.GetEnvironmentsRequest{ShowAll: showAll}, grpc.EmptyCallOption{})
+	response, err = rpc.GetEnvironments(cxt, request, grpc.EmptyCallOption{})
 	if err != nil {
 		return
 	}
@@ -244,22 +249,84 @@ func GetEnvironments(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 	if len(response.GetEnvironments()) == 0 {
 		fmt.Fprintln(o, "no environments running")
 	} else {
+		showLabels := false
+		for _, envi := range response.GetEnvironments() {
+			if len(envi.GetLabels()) > 0 {
+				showLabels = true
+				break
+			}
+		}
+
+		headers := []string{"id", "workflow template", "created", "state"}
+		if showLabels {
+			headers = append(headers, "labels")
+		}
 		table := tablewriter.NewWriter(o)
-		table.SetHeader([]string{"id", "workflow template", "created", "state"})
+		table.SetHeader(headers)
 		table.SetBorder(false)
 		fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
-		table.SetHeaderColor(fg, fg, fg, fg)
+		fgColSlice := make([]tablewriter.Colors, len(headers))
+		for i := range fgColSlice {
+			fgColSlice[i] = fg
+		}
+		table.SetHeaderColor(fgColSlice...)
 
 		data := make([][]string, 0, 0)
 		for _, envi := range response.GetEnvironments() {
 			formatted := formatTimestamp(time.Millisecond*time.Duration(envi.GetCreatedWhen()))
-			data = append(data, []string{envi.GetId(), envi.GetRootRole(), formatted, colorState(envi.GetState())})
+			row := []string{envi.GetId(), envi.GetRootRole(), formatted, colorState(envi.GetState())}
+			if showLabels {
+				row = append(row, formatLabels(envi.GetLabels()))
+			}
+			data = append(data, row)
 		}
 
 		table.AppendBulk(data)
 		table.Render()
 	}
 
+	if len(response.GetNextPageToken()) > 0 {
+		fmt.Fprintf(o, "\nshowing %d of %d environments, next page with --page-token %s\n",
+			len(response.GetEnvironments()), response.GetTotalSize(), response.GetNextPageToken())
+	}
+
+	return nil
+}
+
+func SetEnvironmentLabels(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) == 0 {
+		err = errors.New("an environment id is required")
+		return
+	}
+	labels := make(map[string]string)
+	for _, arg := range args[1:] {
+		key, value, found := strings.Cut(arg, "=")
+		if !found {
+			err = fmt.Errorf("invalid label %s, expected key=value", arg)
+			return
+		}
+		labels[key] = value
+	}
+	removeLabels, err := cmd.Flags().GetStringSlice("remove")
+	if err != nil {
+		return
+	}
+
+	var response *pb.SetEnvironmentLabelsReply
+	response, err = rpc.SetEnvironmentLabels(cxt, &pb.SetEnvironmentLabelsRequest{
+		Id:           args[0],
+		Labels:       labels,
+		RemoveLabels: removeLabels,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	if len(response.GetLabels()) == 0 {
+		fmt.Fprintf(o, "environment %s has no labels\n", args[0])
+	} else {
+		fmt.Fprintf(o, "environment %s labels: %s\n", args[0], formatLabels(response.GetLabels()))
+	}
 	return nil
 }
 
@@ -428,6 +495,8 @@ func CreateEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 	// TODO: add support for acquiring bot config here OCTRL-177
 
 	asynchronous, _ := cmd.Flags().GetBool("asynchronous")
+	labels, _ := cmd.Flags().GetStringToString("label")
+	waitForDetectors, _ := cmd.Flags().GetBool("wait-for-detectors")
 
 	var response *pb.NewEnvironmentReply
 	if asynchronous {
@@ -435,6 +504,8 @@ func CreateEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 			WorkflowTemplate: wfPath,
 			Vars:             extraVarsMap,
 			Public:           public,
+			Labels:           labels,
+			WaitForDetectors: waitForDetectors,
 			RequestUser: &commonpb.User{
 				Name: getUserAndHost(),
 			},
@@ -444,6 +515,8 @@ func CreateEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 			WorkflowTemplate: wfPath,
 			Vars:             extraVarsMap,
 			Public:           public,
+			Labels:           labels,
+			WaitForDetectors: waitForDetectors,
 			RequestUser: &commonpb.User{
 				Name: getUserAndHost(),
 			},
@@ -591,8 +664,8 @@ func ShowEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 }
 
 func ControlEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
-	if len(args) != 1 {
-		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+	selector, bulk, err := environmentSelectorFromFlags(cmd, args)
+	if err != nil {
 		return
 	}
 
@@ -600,12 +673,10 @@ func ControlEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.
 	if err != nil {
 		return
 	}
-	event = strings.ToUpper(event)
-	switch event {
-	case "START":
-		fallthrough
-	case "STOP":
-		event = event + "_ACTIVITY"
+	event = normalizeEventName(event)
+
+	if bulk {
+		return controlEnvironments(cxt, rpc, cmd, selector, event, o)
 	}
 
 	var response *pb.ControlEnvironmentReply
@@ -638,6 +709,62 @@ func ControlEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.
 	return
 }
 
+// GetTransitionPlan prints the hook groups that a transition would execute on an environment,
+// in order, without performing the transition.
+func GetTransitionPlan(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	event, err := cmd.Flags().GetString("event")
+	if err != nil {
+		return
+	}
+	event = normalizeEventName(event)
+
+	var response *pb.GetTransitionPlanReply
+	response, err = rpc.GetTransitionPlan(cxt, &pb.GetTransitionPlanRequest{
+		Id:   args[0],
+		Type: pb.ControlEnvironmentRequest_Optype(pb.ControlEnvironmentRequest_Optype_value[event]),
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(o, "environment id:  %s\n", response.GetId())
+	_, _ = fmt.Fprintf(o, "transition:      %s (%s → %s)\n", response.GetTransition(),
+		colorState(response.GetCurrentState()), colorState(response.GetTargetState()))
+
+	groupsByTrigger := make(map[string][]*pb.PlannedHookGroup)
+	for _, group := range response.GetHookGroups() {
+		groupsByTrigger[group.GetTrigger()] = append(groupsByTrigger[group.GetTrigger()], group)
+	}
+
+	for _, step := range response.GetSteps() {
+		_, _ = fmt.Fprintf(o, "\n%s\n", yellow(step))
+		if strings.HasPrefix(step, "tasks_") {
+			_, _ = fmt.Fprintln(o, grey("  task state machine transition"))
+			continue
+		}
+		groups := groupsByTrigger[step]
+		if len(groups) == 0 {
+			_, _ = fmt.Fprintln(o, grey("  no hooks"))
+			continue
+		}
+		for _, group := range groups {
+			_, _ = fmt.Fprintf(o, "  weight %+d\n", group.GetWeight())
+			drawTablePlannedHookGroup(group, o)
+		}
+	}
+
+	if len(response.GetPending()) > 0 {
+		_, _ = fmt.Fprintf(o, "\n%s\n", yellow("still pending after the transition"))
+		drawTablePlannedHooks(response.GetPending(), o)
+	}
+	return
+}
+
 func ModifyEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 1 {
 		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
@@ -665,9 +792,15 @@ func ModifyEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 
 	ops := make([]*pb.EnvironmentOperation, 0)
 	for _, it := range addRoles {
+		roleName, workflowTemplate, found := strings.Cut(it, "=")
+		if !found || len(roleName) == 0 || len(workflowTemplate) == 0 {
+			err = fmt.Errorf("invalid role to add %s, expected format is rolePath=workflowTemplate", it)
+			return
+		}
 		ops = append(ops, &pb.EnvironmentOperation{
-			Type:     pb.EnvironmentOperation_ADD_ROLE,
-			RoleName: it,
+			Type:             pb.EnvironmentOperation_ADD_ROLE,
+			RoleName:         roleName,
+			WorkflowTemplate: workflowTemplate,
 		})
 	}
 	for _, it := range removeRoles {
@@ -723,11 +856,10 @@ func ModifyEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 }
 
 func DestroyEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
-	if len(args) != 1 {
-		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+	selector, bulk, err := environmentSelectorFromFlags(cmd, args)
+	if err != nil {
 		return
 	}
-	envId := args[0]
 
 	keepTasks, err := cmd.Flags().GetBool("keep-tasks")
 	if err != nil {
@@ -744,6 +876,11 @@ func DestroyEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.
 		keepTasks = false
 	}
 
+	if bulk {
+		return destroyEnvironments(cxt, rpc, cmd, selector, keepTasks, allowInRunningState, force, o)
+	}
+	envId := args[0]
+
 	_, err = rpc.DestroyEnvironment(cxt, &pb.DestroyEnvironmentRequest{
 		Id:                  envId,
 		KeepTasks:           keepTasks,
@@ -762,6 +899,100 @@ func DestroyEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.
 	return
 }
 
+func controlEnvironments(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, selector *pb.EnvironmentSelector, event string, o io.Writer) (err error) {
+	maxConcurrency, err := cmd.Flags().GetInt32("max-concurrency")
+	if err != nil {
+		return
+	}
+
+	var response *pb.ControlEnvironmentsReply
+	response, err = rpc.ControlEnvironments(cxt, &pb.ControlEnvironmentsRequest{
+		Selector: selector,
+		Type:     pb.ControlEnvironmentRequest_Optype(pb.ControlEnvironmentRequest_Optype_value[event]),
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+		MaxConcurrency: maxConcurrency,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	outcomes := response.GetOutcomes()
+	if len(outcomes) == 0 {
+		_, _ = fmt.Fprintln(o, "no environments matched")
+		return
+	}
+
+	failed := 0
+	table := newOutcomesTable([]string{"environment id", "state", "run number", "duration", "result"}, o)
+	for _, outcome := range outcomes {
+		reply := outcome.GetReply()
+		state, runNumber, duration := "", "", ""
+		if reply != nil {
+			state = colorState(reply.GetState())
+			runNumber = formatRunNumber(reply.GetCurrentRunNumber())
+			duration = strconv.FormatFloat(time.Duration(reply.GetTransitionDuration()*int64(time.Millisecond)).Seconds(), 'f', 3, 64) + "s"
+		}
+		if !outcome.GetOutcome().GetOk() {
+			failed++
+		}
+		table.Append([]string{outcome.GetOutcome().GetId(), state, runNumber, duration, formatOutcome(outcome.GetOutcome())})
+	}
+	table.Render()
+
+	_, _ = fmt.Fprintf(o, "%s transition complete for %d of %d environments\n", event, len(outcomes)-failed, len(outcomes))
+	if failed > 0 {
+		err = fmt.Errorf("%s failed for %d environment(s)", event, failed)
+	}
+	return
+}
+
+func destroyEnvironments(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, selector *pb.EnvironmentSelector, keepTasks bool, allowInRunningState bool, force bool, o io.Writer) (err error) {
+	maxConcurrency, err := cmd.Flags().GetInt32("max-concurrency")
+	if err != nil {
+		return
+	}
+
+	var response *pb.DestroyEnvironmentsReply
+	response, err = rpc.DestroyEnvironments(cxt, &pb.DestroyEnvironmentsRequest{
+		Selector:            selector,
+		KeepTasks:           keepTasks,
+		AllowInRunningState: allowInRunningState,
+		Force:               force,
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+		MaxConcurrency: maxConcurrency,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	outcomes := response.GetOutcomes()
+	if len(outcomes) == 0 {
+		_, _ = fmt.Fprintln(o, "no environments matched")
+		return
+	}
+
+	failed := 0
+	table := newOutcomesTable([]string{"environment id", "killed tasks", "result"}, o)
+	for _, outcome := range outcomes {
+		if !outcome.GetOutcome().GetOk() {
+			failed++
+		}
+		killed := strconv.Itoa(len(outcome.GetReply().GetCleanupTasksReply().GetKilledTasks()))
+		table.Append([]string{outcome.GetOutcome().GetId(), killed, formatOutcome(outcome.GetOutcome())})
+	}
+	table.Render()
+
+	_, _ = fmt.Fprintf(o, "teardown complete for %d of %d environments\n", len(outcomes)-failed, len(outcomes))
+	if failed > 0 {
+		err = fmt.Errorf("teardown failed for %d environment(s)", failed)
+	}
+	return
+}
+
 func GetTasks(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	var response *pb.GetTasksReply
 	response, err = rpc.GetTasks(cxt, &pb.GetTasksRequest{}, grpc.EmptyCallOption{})
@@ -832,6 +1063,33 @@ func CleanTasks(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command,
 	return
 }
 
+func GetTaskLogs(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 || len(args[0]) == 0 {
+		err = errors.New("a task ID is required")
+		return
+	}
+	lines, err := cmd.Flags().GetInt("lines")
+	if err != nil {
+		return
+	}
+	if lines < 0 {
+		err = errors.New("the number of lines cannot be negative")
+		return
+	}
+
+	var response *pb.GetTaskLogsReply
+	response, err = rpc.GetTaskLogs(cxt, &pb.GetTaskLogsRequest{TaskId: args[0], Lines: int32(lines)}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	if response.GetTruncated() {
+		_, _ = fmt.Fprintln(o, yellow("(older output omitted)"))
+	}
+	_, _ = fmt.Fprint(o, response.GetLogs())
+	return
+}
+
 func QueryRoles(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 2 {
 		err = errors.New(fmt.Sprintf("accepts 2 arg(s), received %d", len(args)))
@@ -1011,6 +1269,123 @@ func ListWorkflowTemplates(cxt context.Context, rpc *coconut.RpcClient, cmd *cob
 	return nil
 }
 
+// PlanWorkflowTemplate loads and processes a workflow template on the core without deploying it,
+// and prints the resulting role tree, task classes and hooks.
+func PlanWorkflowTemplate(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	wfPath := args[0]
+
+	var extraVars string
+	extraVars, err = cmd.Flags().GetString("extra-vars")
+	if err != nil {
+		return
+	}
+	extraVars = strings.TrimSpace(extraVars)
+	if cmd.Flags().Changed("extra-vars") && len(extraVars) == 0 {
+		err = errors.New("empty list of extra-vars supplied")
+		return
+	}
+	extraVarsMap, err := utils.ParseExtraVars(extraVars)
+	if err != nil {
+		return
+	}
+
+	showDetails, _ := cmd.Flags().GetBool("details")
+
+	var response *pb.GetWorkflowTemplatePlanReply
+	response, err = rpc.GetWorkflowTemplatePlan(cxt, &pb.GetWorkflowTemplatePlanRequest{
+		WorkflowTemplate: wfPath,
+		Vars:             extraVarsMap,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(o, "workflow template:  %s\n", wfPath)
+	_, _ = fmt.Fprintf(o, "task classes (%d):\n", len(response.GetTaskClasses()))
+	for _, taskClass := range response.GetTaskClasses() {
+		_, _ = fmt.Fprintf(o, "  %s\n", taskClass)
+	}
+
+	_, _ = fmt.Fprintf(o, "\nworkflow:\n")
+	drawPlannedWorkflow(response.GetRoot(), showDetails, o)
+
+	_, _ = fmt.Fprintf(o, "\nhooks:\n")
+	if len(response.GetTriggers()) == 0 {
+		_, _ = fmt.Fprintln(o, "  none")
+		return
+	}
+	for _, trigger := range response.GetTriggers() {
+		_, _ = fmt.Fprintf(o, "%s\n", yellow(trigger.GetTrigger()))
+		drawTablePlannedHooks(trigger.GetHooks(), o)
+	}
+	return
+}
+
+// LintWorkflowTemplates statically checks the workflow and task templates of a repository.
+func LintWorkflowTemplates(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) > 1 {
+		err = errors.New(fmt.Sprintf("accepts at most 1 arg(s), received %d", len(args)))
+		return
+	}
+	var repo, revision string
+	if len(args) == 1 {
+		repo = args[0]
+		if i := strings.LastIndex(repo, "@"); i >= 0 {
+			repo, revision = repo[:i], repo[i+1:]
+		}
+	}
+
+	strict, _ := cmd.Flags().GetBool("strict")
+
+	var response *pb.LintWorkflowTemplatesReply
+	response, err = rpc.LintWorkflowTemplates(cxt, &pb.LintWorkflowTemplatesRequest{
+		Repo:     repo,
+		Revision: revision,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(o, "repository:  %s@%s %s\n", response.GetRepo(), response.GetRevision(), grey(response.GetHash()))
+	_, _ = fmt.Fprintf(o, "templates:   %d workflow templates, %d task templates\n",
+		response.GetWorkflowTemplateCount(), response.GetTaskTemplateCount())
+
+	findings := response.GetFindings()
+	errorCount := 0
+	for _, finding := range findings {
+		if finding.GetSeverity() == pb.LintFinding_ERROR {
+			errorCount++
+		}
+	}
+	warningCount := len(findings) - errorCount
+
+	if len(findings) == 0 {
+		_, _ = fmt.Fprintf(o, "%s\n", green("no problems found"))
+		return
+	}
+
+	_, _ = fmt.Fprintln(o)
+	table := newOutcomesTable([]string{"severity", "check", "template", "role", "message"}, o)
+	for _, finding := range findings {
+		severity := yellow("warning")
+		if finding.GetSeverity() == pb.LintFinding_ERROR {
+			severity = red("error")
+		}
+		table.Append([]string{severity, finding.GetCheck(), finding.GetTemplate(), finding.GetRolePath(), finding.GetMessage()})
+	}
+	table.Render()
+	_, _ = fmt.Fprintf(o, "\n%d error(s), %d warning(s)\n", errorCount, warningCount)
+
+	if errorCount > 0 || (strict && warningCount > 0) {
+		err = fmt.Errorf("template lint found %d error(s) and %d warning(s)", errorCount, warningCount)
+	}
+	return
+}
+
 // ListRepos lists all available git repositories that are used for configuration.
 func ListRepos(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 0 {
@@ -1200,3 +1575,170 @@ func SetDefaultRevision(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.
 
 	return nil
 }
+
+func ScheduleTransition(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	event, err := cmd.Flags().GetString("event")
+	if err != nil {
+		return
+	}
+	event = normalizeEventName(event)
+	optype, ok := pb.ControlEnvironmentRequest_Optype_value[event]
+	if !ok {
+		return fmt.Errorf("invalid event %s", event)
+	}
+
+	request := &pb.ScheduleTransitionRequest{
+		EnvironmentId: args[0],
+		Type:          pb.ControlEnvironmentRequest_Optype(optype),
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+	}
+
+	at, _ := cmd.Flags().GetString("at")
+	in, _ := cmd.Flags().GetDuration("in")
+	afterRunDuration, _ := cmd.Flags().GetDuration("after-run-duration")
+	request.Condition, _ = cmd.Flags().GetString("when")
+	switch {
+	case len(at) > 0:
+		var timestamp time.Time
+		timestamp, err = time.Parse(time.RFC3339, at)
+		if err != nil {
+			return fmt.Errorf("invalid --at value, expected an RFC 3339 timestamp: %w", err)
+		}
+		request.At = timestamp.UnixMilli()
+	case in > 0:
+		request.At = time.Now().Add(in).UnixMilli()
+	}
+	request.AfterRunDuration = afterRunDuration.Milliseconds()
+
+	var response *pb.ScheduleTransitionReply
+	response, err = rpc.ScheduleTransition(cxt, request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	fmt.Fprintf(o, "transition %s scheduled %s for environment %s\nschedule id: %s\n",
+		response.GetSchedule().GetType().String(),
+		formatScheduleTrigger(response.GetSchedule()),
+		response.GetSchedule().GetEnvironmentId(),
+		response.GetSchedule().GetId())
+	return nil
+}
+
+func GetScheduledTransitions(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	request := &pb.GetScheduledTransitionsRequest{}
+	if len(args) > 0 {
+		request.EnvironmentId = args[0]
+	}
+
+	var response *pb.GetScheduledTransitionsReply
+	response, err = rpc.GetScheduledTransitions(cxt, request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	if len(response.GetSchedules()) == 0 {
+		fmt.Fprintln(o, "no scheduled transitions")
+		return nil
+	}
+
+	table := newOutcomesTable([]string{"id", "environment id", "transition", "trigger", "created", "requested by"}, o)
+	for _, schedule := range response.GetSchedules() {
+		table.Append([]string{
+			schedule.GetId(),
+			schedule.GetEnvironmentId(),
+			schedule.GetType().String(),
+			formatScheduleTrigger(schedule),
+			formatTimestamp(time.Duration(schedule.GetCreatedWhen()) * time.Millisecond),
+			schedule.GetRequestUser().GetName(),
+		})
+	}
+	table.Render()
+	return nil
+}
+
+func CancelScheduledTransition(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	var response *pb.CancelScheduledTransitionReply
+	response, err = rpc.CancelScheduledTransition(cxt, &pb.CancelScheduledTransitionRequest{Id: args[0]}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	fmt.Fprintf(o, "scheduled transition %s for environment %s cancelled\n",
+		response.GetSchedule().GetType().String(),
+		response.GetSchedule().GetEnvironmentId())
+	return nil
+}
+
+func GetDetectorReservations(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	var response *pb.GetDetectorReservationsReply
+	response, err = rpc.GetDetectorReservations(cxt, &pb.GetDetectorReservationsRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	if len(response.GetLeases()) == 0 {
+		fmt.Fprintln(o, "no detector leases")
+	} else {
+		printDetectorLeases(response.GetLeases(), o)
+	}
+
+	if len(response.GetQueue()) > 0 {
+		fmt.Fprintln(o, "\nenvironment requests waiting for detectors:")
+		table := newOutcomesTable([]string{"environment id", "detectors", "queued", "requested by"}, o)
+		for _, queued := range response.GetQueue() {
+			table.Append([]string{
+				queued.GetEnvironmentId(),
+				strings.Join(queued.GetDetectors(), " "),
+				formatTimestamp(time.Duration(queued.GetQueuedWhen()) * time.Millisecond),
+				queued.GetOwner(),
+			})
+		}
+		table.Render()
+	}
+	return nil
+}
+
+func ReserveDetectors(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	ttl, err := cmd.Flags().GetDuration("ttl")
+	if err != nil {
+		return
+	}
+
+	var response *pb.ReserveDetectorsReply
+	response, err = rpc.ReserveDetectors(cxt, &pb.ReserveDetectorsRequest{
+		Detectors:  args,
+		TtlSeconds: int64(ttl.Seconds()),
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	printDetectorLeases(response.GetLeases(), o)
+	return nil
+}
+
+func ReleaseDetectors(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	var response *pb.ReleaseDetectorsReply
+	response, err = rpc.ReleaseDetectors(cxt, &pb.ReleaseDetectorsRequest{
+		Detectors: args,
+		RequestUser: &commonpb.User{
+			Name: getUserAndHost(),
+		},
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	if len(response.GetLeases()) == 0 {
+		fmt.Fprintln(o, "no detector reservations released")
+		return nil
+	}
+	fmt.Fprintln(o, "released detector reservations:")
+	printDetectorLeases(response.GetLeases(), o)
+	return nil
+}
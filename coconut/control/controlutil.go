@@ -26,17 +26,21 @@ package control
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/user"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/AliceO2Group/Control/coconut/protos"
+	commonpb "github.com/AliceO2Group/Control/common/protos"
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
 	"github.com/xlab/treeprint"
 	"gopkg.in/yaml.v3"
 )
@@ -125,6 +129,289 @@ func drawWorkflow(root *pb.RoleInfo, o io.Writer) {
 	_, _ = fmt.Fprint(o, tree.String())
 }
 
+func buildPlannedTree(tree *treeprint.Tree, node *pb.PlannedRole, showDetails bool, level int) {
+	if len(node.GetRoles()) != 0 {
+		branch := (*tree).AddBranch(node.GetName())
+		for _, n := range node.GetRoles() {
+			buildPlannedTree(&branch, n, showDetails, level+1)
+		}
+		return
+	}
+
+	nodeText := node.GetName()
+	padded := fmt.Sprintf("%-"+strconv.Itoa(50-(4*level))+"s", node.GetName())
+	if node.GetTask() != nil {
+		nodeText = padded + yellow(" --> ") + "task " + node.GetTask().GetClassName()
+	} else if node.GetCall() != nil {
+		nodeText = padded + yellow(" --> ") + "call " + node.GetCall().GetFunc()
+	}
+	if len(node.GetTrigger()) != 0 {
+		nodeText += grey(" [" + node.GetTrigger() + "]")
+	}
+
+	if !showDetails || node.GetTask() == nil {
+		(*tree).AddNode(nodeText)
+		return
+	}
+	branch := (*tree).AddBranch(nodeText)
+	for _, ch := range node.GetTask().GetInboundChannels() {
+		branch.AddNode(fmt.Sprintf("bind %s (%s)", ch.GetName(), ch.GetType()))
+	}
+	for _, ch := range node.GetTask().GetOutboundChannels() {
+		branch.AddNode(fmt.Sprintf("connect %s (%s) -> %s", ch.GetName(), ch.GetType(), ch.GetTarget()))
+	}
+	for _, ct := range node.GetTask().GetConstraints() {
+		branch.AddNode(dark("constraint " + ct))
+	}
+}
+
+func drawPlannedWorkflow(root *pb.PlannedRole, showDetails bool, o io.Writer) {
+	if root == nil {
+		return
+	}
+	tree := treeprint.New()
+
+	tree.SetValue(root.GetName())
+	for _, n := range root.GetRoles() {
+		buildPlannedTree(&tree, n, showDetails, 0)
+	}
+	_, _ = fmt.Fprint(o, tree.String())
+}
+
+func drawTablePlannedHooks(hooks []*pb.PlannedHook, o io.Writer) {
+	table := tablewriter.NewWriter(o)
+	headers := []string{"weight", "role", "kind", "name", "await", "timeout", "crit"}
+	table.SetHeader(headers)
+	table.SetBorder(false)
+	fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+	fgColSlice := make([]tablewriter.Colors, len(headers))
+	for i := 0; i < len(headers); i++ {
+		fgColSlice[i] = fg
+	}
+	table.SetHeaderColor(fgColSlice...)
+
+	data := make([][]string, 0, len(hooks))
+	for _, hook := range hooks {
+		kind := "task"
+		if hook.GetIsCall() {
+			kind = "call"
+		}
+		crit := "NO"
+		if hook.GetCritical() {
+			crit = "YES"
+		}
+		data = append(data, []string{
+			fmt.Sprintf("%+d", hook.GetWeight()),
+			hook.GetRolePath(),
+			kind,
+			hook.GetName(),
+			hook.GetAwait(),
+			hook.GetTimeout(),
+			crit,
+		})
+	}
+
+	table.AppendBulk(data)
+	table.Render()
+}
+
+// drawTablePlannedHookGroup renders the hooks of one transition plan group in the
+// order in which the core handles them: calls are started, then awaited, then hook
+// tasks are run.
+func drawTablePlannedHookGroup(group *pb.PlannedHookGroup, o io.Writer) {
+	table := tablewriter.NewWriter(o)
+	headers := []string{"action", "role", "kind", "name", "await", "timeout", "crit"}
+	table.SetHeader(headers)
+	table.SetBorder(false)
+	fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+	fgColSlice := make([]tablewriter.Colors, len(headers))
+	for i := 0; i < len(headers); i++ {
+		fgColSlice[i] = fg
+	}
+	table.SetHeaderColor(fgColSlice...)
+
+	data := make([][]string, 0)
+	appendHooks := func(action string, hooks []*pb.PlannedHook) {
+		for _, hook := range hooks {
+			kind := "task"
+			if hook.GetIsCall() {
+				kind = "call"
+			}
+			crit := "NO"
+			if hook.GetCritical() {
+				crit = "YES"
+			}
+			data = append(data, []string{
+				action,
+				hook.GetRolePath(),
+				kind,
+				hook.GetName(),
+				hook.GetAwait(),
+				hook.GetTimeout(),
+				crit,
+			})
+		}
+	}
+	appendHooks("start", group.GetCalls())
+	appendHooks("await", group.GetAwaited())
+	appendHooks("run", group.GetTasks())
+
+	table.AppendBulk(data)
+	table.Render()
+}
+
+// environmentSelectorFromFlags builds a bulk environment selector from the
+// positional environment IDs and the selector flags. It returns bulk = false
+// when a single environment ID was passed without any selector flag, in which
+// case the single-environment request should be used.
+func environmentSelectorFromFlags(cmd *cobra.Command, args []string) (selector *pb.EnvironmentSelector, bulk bool, err error) {
+	detector, err := cmd.Flags().GetString("all-with-detector")
+	if err != nil {
+		return
+	}
+	userVars, err := cmd.Flags().GetStringToString("match")
+	if err != nil {
+		return
+	}
+	labels, err := cmd.Flags().GetStringToString("with-label")
+	if err != nil {
+		return
+	}
+
+	if len(detector) == 0 && len(userVars) == 0 && len(labels) == 0 {
+		switch len(args) {
+		case 0:
+			err = errors.New("an environment id, --all-with-detector, --match or --with-label is required")
+			return
+		case 1:
+			return nil, false, nil
+		}
+	}
+
+	selector = &pb.EnvironmentSelector{
+		Ids:      args,
+		Detector: strings.ToUpper(detector),
+		UserVars: userVars,
+		Labels:   labels,
+	}
+	return selector, true, nil
+}
+
+func environmentsRequestFromFlags(cmd *cobra.Command) (request *pb.GetEnvironmentsRequest, err error) {
+	request = &pb.GetEnvironmentsRequest{}
+	if request.ShowAll, err = cmd.Flags().GetBool("show-all"); err != nil {
+		return
+	}
+	if request.Labels, err = cmd.Flags().GetStringToString("label"); err != nil {
+		return
+	}
+	if request.States, err = cmd.Flags().GetStringSlice("state"); err != nil {
+		return
+	}
+	if request.Detector, err = cmd.Flags().GetString("detector"); err != nil {
+		return
+	}
+	if request.RunType, err = cmd.Flags().GetString("run-type"); err != nil {
+		return
+	}
+	if request.PageSize, err = cmd.Flags().GetInt32("page-size"); err != nil {
+		return
+	}
+	if request.PageToken, err = cmd.Flags().GetString("page-token"); err != nil {
+		return
+	}
+	request.Detector = strings.ToUpper(request.Detector)
+
+	for flag, target := range map[string]*int64{
+		"created-after":  &request.CreatedAfter,
+		"created-before": &request.CreatedBefore,
+	} {
+		var value string
+		if value, err = cmd.Flags().GetString(flag); err != nil {
+			return
+		}
+		if len(value) == 0 {
+			continue
+		}
+		if *target, err = parseTimeFlag(value); err != nil {
+			err = fmt.Errorf("invalid --%s value: %w", flag, err)
+			return
+		}
+	}
+	return
+}
+
+// parseTimeFlag accepts either a duration, interpreted as that long ago, or an
+// RFC 3339 timestamp, and returns it in unix milliseconds.
+func parseTimeFlag(value string) (int64, error) {
+	if duration, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-duration).UnixMilli(), nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, fmt.Errorf("%s is neither a duration nor an RFC 3339 timestamp", value)
+	}
+	return timestamp.UnixMilli(), nil
+}
+
+func formatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	formatted := make([]string, len(keys))
+	for i, k := range keys {
+		formatted[i] = k + "=" + labels[k]
+	}
+	return strings.Join(formatted, ",")
+}
+
+// normalizeEventName accepts START and STOP as shorthands for START_ACTIVITY
+// and STOP_ACTIVITY.
+func normalizeEventName(event string) string {
+	event = strings.ToUpper(event)
+	switch event {
+	case "START":
+		fallthrough
+	case "STOP":
+		event = event + "_ACTIVITY"
+	}
+	return event
+}
+
+func formatScheduleTrigger(schedule *pb.TransitionSchedule) string {
+	switch {
+	case schedule.GetAt() != 0:
+		return "at " + formatTimestamp(time.Duration(schedule.GetAt())*time.Millisecond)
+	case schedule.GetAfterRunDuration() != 0:
+		return fmt.Sprintf("after %s of run", time.Duration(schedule.GetAfterRunDuration())*time.Millisecond)
+	default:
+		return "when " + schedule.GetCondition()
+	}
+}
+
+func newOutcomesTable(headers []string, o io.Writer) *tablewriter.Table {
+	table := tablewriter.NewWriter(o)
+	table.SetHeader(headers)
+	table.SetBorder(false)
+	fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+	fgColSlice := make([]tablewriter.Colors, len(headers))
+	for i := 0; i < len(headers); i++ {
+		fgColSlice[i] = fg
+	}
+	table.SetHeaderColor(fgColSlice...)
+	table.SetAutoWrapText(false)
+	return table
+}
+
+func formatOutcome(outcome *pb.EnvironmentOutcome) string {
+	if outcome.GetOk() {
+		return green("OK")
+	}
+	return red(outcome.GetErrorCode() + ": " + outcome.GetError())
+}
+
 func drawIntegratedServicesData(services map[string]string, o io.Writer) {
 	if len(services) == 0 {
 		return
@@ -209,3 +496,25 @@ func getUserAndHost() string {
 
 	return fmt.Sprintf("%s@%s", userName, hostName)
 }
+
+func printDetectorLeases(leases []*commonpb.DetectorLease, o io.Writer) {
+	table := newOutcomesTable([]string{"detector", "environment id", "owner", "acquired", "expires"}, o)
+	for _, lease := range leases {
+		environmentId := lease.GetEnvironmentId()
+		if len(environmentId) == 0 {
+			environmentId = grey("reserved")
+		}
+		expires := grey("never")
+		if lease.GetExpiresWhen() != 0 {
+			expires = formatTimestamp(time.Duration(lease.GetExpiresWhen()) * time.Millisecond)
+		}
+		table.Append([]string{
+			lease.GetDetector(),
+			environmentId,
+			lease.GetOwner(),
+			formatTimestamp(time.Duration(lease.GetAcquiredWhen()) * time.Millisecond),
+			expires,
+		})
+	}
+	table.Render()
+}
@@ -43,32 +43,45 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Control_GetFrameworkInfo_FullMethodName         = "/o2control.Control/GetFrameworkInfo"
-	Control_GetEnvironments_FullMethodName          = "/o2control.Control/GetEnvironments"
-	Control_NewAutoEnvironment_FullMethodName       = "/o2control.Control/NewAutoEnvironment"
-	Control_NewEnvironment_FullMethodName           = "/o2control.Control/NewEnvironment"
-	Control_GetEnvironment_FullMethodName           = "/o2control.Control/GetEnvironment"
-	Control_ControlEnvironment_FullMethodName       = "/o2control.Control/ControlEnvironment"
-	Control_DestroyEnvironment_FullMethodName       = "/o2control.Control/DestroyEnvironment"
-	Control_GetActiveDetectors_FullMethodName       = "/o2control.Control/GetActiveDetectors"
-	Control_GetAvailableDetectors_FullMethodName    = "/o2control.Control/GetAvailableDetectors"
-	Control_NewEnvironmentAsync_FullMethodName      = "/o2control.Control/NewEnvironmentAsync"
-	Control_GetTasks_FullMethodName                 = "/o2control.Control/GetTasks"
-	Control_GetTask_FullMethodName                  = "/o2control.Control/GetTask"
-	Control_CleanupTasks_FullMethodName             = "/o2control.Control/CleanupTasks"
-	Control_GetRoles_FullMethodName                 = "/o2control.Control/GetRoles"
-	Control_GetWorkflowTemplates_FullMethodName     = "/o2control.Control/GetWorkflowTemplates"
-	Control_ListRepos_FullMethodName                = "/o2control.Control/ListRepos"
-	Control_AddRepo_FullMethodName                  = "/o2control.Control/AddRepo"
-	Control_RemoveRepo_FullMethodName               = "/o2control.Control/RemoveRepo"
-	Control_RefreshRepos_FullMethodName             = "/o2control.Control/RefreshRepos"
-	Control_SetDefaultRepo_FullMethodName           = "/o2control.Control/SetDefaultRepo"
-	Control_SetGlobalDefaultRevision_FullMethodName = "/o2control.Control/SetGlobalDefaultRevision"
-	Control_SetRepoDefaultRevision_FullMethodName   = "/o2control.Control/SetRepoDefaultRevision"
-	Control_Subscribe_FullMethodName                = "/o2control.Control/Subscribe"
-	Control_GetIntegratedServices_FullMethodName    = "/o2control.Control/GetIntegratedServices"
-	Control_Teardown_FullMethodName                 = "/o2control.Control/Teardown"
-	Control_ModifyEnvironment_FullMethodName        = "/o2control.Control/ModifyEnvironment"
+	Control_GetFrameworkInfo_FullMethodName          = "/o2control.Control/GetFrameworkInfo"
+	Control_GetEnvironments_FullMethodName           = "/o2control.Control/GetEnvironments"
+	Control_NewAutoEnvironment_FullMethodName        = "/o2control.Control/NewAutoEnvironment"
+	Control_NewEnvironment_FullMethodName            = "/o2control.Control/NewEnvironment"
+	Control_GetEnvironment_FullMethodName            = "/o2control.Control/GetEnvironment"
+	Control_ControlEnvironment_FullMethodName        = "/o2control.Control/ControlEnvironment"
+	Control_ModifyEnvironment_FullMethodName         = "/o2control.Control/ModifyEnvironment"
+	Control_DestroyEnvironment_FullMethodName        = "/o2control.Control/DestroyEnvironment"
+	Control_ControlEnvironments_FullMethodName       = "/o2control.Control/ControlEnvironments"
+	Control_DestroyEnvironments_FullMethodName       = "/o2control.Control/DestroyEnvironments"
+	Control_SetEnvironmentLabels_FullMethodName      = "/o2control.Control/SetEnvironmentLabels"
+	Control_ScheduleTransition_FullMethodName        = "/o2control.Control/ScheduleTransition"
+	Control_GetScheduledTransitions_FullMethodName   = "/o2control.Control/GetScheduledTransitions"
+	Control_CancelScheduledTransition_FullMethodName = "/o2control.Control/CancelScheduledTransition"
+	Control_GetTransitionPlan_FullMethodName         = "/o2control.Control/GetTransitionPlan"
+	Control_GetActiveDetectors_FullMethodName        = "/o2control.Control/GetActiveDetectors"
+	Control_GetAvailableDetectors_FullMethodName     = "/o2control.Control/GetAvailableDetectors"
+	Control_GetDetectorReservations_FullMethodName   = "/o2control.Control/GetDetectorReservations"
+	Control_ReserveDetectors_FullMethodName          = "/o2control.Control/ReserveDetectors"
+	Control_ReleaseDetectors_FullMethodName          = "/o2control.Control/ReleaseDetectors"
+	Control_NewEnvironmentAsync_FullMethodName       = "/o2control.Control/NewEnvironmentAsync"
+	Control_GetTasks_FullMethodName                  = "/o2control.Control/GetTasks"
+	Control_GetTask_FullMethodName                   = "/o2control.Control/GetTask"
+	Control_CleanupTasks_FullMethodName              = "/o2control.Control/CleanupTasks"
+	Control_GetTaskLogs_FullMethodName               = "/o2control.Control/GetTaskLogs"
+	Control_GetRoles_FullMethodName                  = "/o2control.Control/GetRoles"
+	Control_GetWorkflowTemplates_FullMethodName      = "/o2control.Control/GetWorkflowTemplates"
+	Control_GetWorkflowTemplatePlan_FullMethodName   = "/o2control.Control/GetWorkflowTemplatePlan"
+	Control_LintWorkflowTemplates_FullMethodName     = "/o2control.Control/LintWorkflowTemplates"
+	Control_ListRepos_FullMethodName                 = "/o2control.Control/ListRepos"
+	Control_AddRepo_FullMethodName                   = "/o2control.Control/AddRepo"
+	Control_RemoveRepo_FullMethodName                = "/o2control.Control/RemoveRepo"
+	Control_RefreshRepos_FullMethodName              = "/o2control.Control/RefreshRepos"
+	Control_SetDefaultRepo_FullMethodName            = "/o2control.Control/SetDefaultRepo"
+	Control_SetGlobalDefaultRevision_FullMethodName  = "/o2control.Control/SetGlobalDefaultRevision"
+	Control_SetRepoDefaultRevision_FullMethodName    = "/o2control.Control/SetRepoDefaultRevision"
+	Control_Subscribe_FullMethodName                 = "/o2control.Control/Subscribe"
+	Control_GetIntegratedServices_FullMethodName     = "/o2control.Control/GetIntegratedServices"
+	Control_Teardown_FullMethodName                  = "/o2control.Control/Teardown"
 )
 
 // ControlClient is the client API for Control service.
@@ -85,9 +98,33 @@ type ControlClient interface {
 	NewEnvironment(ctx context.Context, in *NewEnvironmentRequest, opts ...grpc.CallOption) (*NewEnvironmentReply, error)
 	GetEnvironment(ctx context.Context, in *GetEnvironmentRequest, opts ...grpc.CallOption) (*GetEnvironmentReply, error)
 	ControlEnvironment(ctx context.Context, in *ControlEnvironmentRequest, opts ...grpc.CallOption) (*ControlEnvironmentReply, error)
+	// Adds roles to or removes roles from an environment in the CONFIGURED state.
+	// Added roles are deployed and configured before the call returns.
+	ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error)
 	DestroyEnvironment(ctx context.Context, in *DestroyEnvironmentRequest, opts ...grpc.CallOption) (*DestroyEnvironmentReply, error)
+	// Bulk variants of ControlEnvironment and DestroyEnvironment.
+	// They act in parallel on all the environments matched by the selector and return once every
+	// operation is done, with one outcome per environment.
+	ControlEnvironments(ctx context.Context, in *ControlEnvironmentsRequest, opts ...grpc.CallOption) (*ControlEnvironmentsReply, error)
+	DestroyEnvironments(ctx context.Context, in *DestroyEnvironmentsRequest, opts ...grpc.CallOption) (*DestroyEnvironmentsReply, error)
+	// Sets and removes labels of an existing environment.
+	SetEnvironmentLabels(ctx context.Context, in *SetEnvironmentLabelsRequest, opts ...grpc.CallOption) (*SetEnvironmentLabelsReply, error)
+	// Schedules a transition to be triggered automatically at a given time, after a given run
+	// duration, or when an integrated service event satisfies a condition.
+	ScheduleTransition(ctx context.Context, in *ScheduleTransitionRequest, opts ...grpc.CallOption) (*ScheduleTransitionReply, error)
+	GetScheduledTransitions(ctx context.Context, in *GetScheduledTransitionsRequest, opts ...grpc.CallOption) (*GetScheduledTransitionsReply, error)
+	CancelScheduledTransition(ctx context.Context, in *CancelScheduledTransitionRequest, opts ...grpc.CallOption) (*CancelScheduledTransitionReply, error)
+	// Returns the ordered hook groups that a transition would execute on an environment in its current state,
+	// without performing the transition.
+	GetTransitionPlan(ctx context.Context, in *GetTransitionPlanRequest, opts ...grpc.CallOption) (*GetTransitionPlanReply, error)
 	GetActiveDetectors(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetActiveDetectorsReply, error)
 	GetAvailableDetectors(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetAvailableDetectorsReply, error)
+	// Detector reservations: every detector used by an environment is leased to it, and detectors
+	// can also be reserved ahead of environment creation. A reservation expires after its TTL, unless
+	// it is released or taken over by an environment created by the same user.
+	GetDetectorReservations(ctx context.Context, in *GetDetectorReservationsRequest, opts ...grpc.CallOption) (*GetDetectorReservationsReply, error)
+	ReserveDetectors(ctx context.Context, in *ReserveDetectorsRequest, opts ...grpc.CallOption) (*ReserveDetectorsReply, error)
+	ReleaseDetectors(ctx context.Context, in *ReleaseDetectorsRequest, opts ...grpc.CallOption) (*ReleaseDetectorsReply, error)
 	// Creates a new environment.
 	// It returns once an environment ID is created and continues the creation asynchronously to the call.
 	// The environment will be listed in GetEnvironments() only once the workflow is loaded and deployment starts.
@@ -95,8 +132,16 @@ type ControlClient interface {
 	GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksReply, error)
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskReply, error)
 	CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error)
+	GetTaskLogs(ctx context.Context, in *GetTaskLogsRequest, opts ...grpc.CallOption) (*GetTaskLogsReply, error)
 	GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error)
 	GetWorkflowTemplates(ctx context.Context, in *GetWorkflowTemplatesRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatesReply, error)
+	// Loads and processes a workflow template with the given variables without deploying anything.
+	// It returns the expanded role tree, along with the task classes, channels, constraints and hooks it would use.
+	GetWorkflowTemplatePlan(ctx context.Context, in *GetWorkflowTemplatePlanRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatePlanReply, error)
+	// Statically checks all the workflow and task templates of a repository at a given revision, without loading
+	// or deploying anything. It reports undefined variables, unresolved includes, unmatched channels, unknown calls
+	// and duplicate role names.
+	LintWorkflowTemplates(ctx context.Context, in *LintWorkflowTemplatesRequest, opts ...grpc.CallOption) (*LintWorkflowTemplatesReply, error)
 	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error)
 	AddRepo(ctx context.Context, in *AddRepoRequest, opts ...grpc.CallOption) (*AddRepoReply, error)
 	RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (*RemoveRepoReply, error)
@@ -108,7 +153,6 @@ type ControlClient interface {
 	GetIntegratedServices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListIntegratedServicesReply, error)
 	// Reserved and not implemented:
 	Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownReply, error)
-	ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error)
 }
 
 type controlClient struct {
@@ -173,6 +217,15 @@ func (c *controlClient) ControlEnvironment(ctx context.Context, in *ControlEnvir
 	return out, nil
 }
 
+func (c *controlClient) ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error) {
+	out := new(ModifyEnvironmentReply)
+	err := c.cc.Invoke(ctx, Control_ModifyEnvironment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) DestroyEnvironment(ctx context.Context, in *DestroyEnvironmentRequest, opts ...grpc.CallOption) (*DestroyEnvironmentReply, error) {
 	out := new(DestroyEnvironmentReply)
 	err := c.cc.Invoke(ctx, Control_DestroyEnvironment_FullMethodName, in, out, opts...)
@@ -182,6 +235,69 @@ func (c *controlClient) DestroyEnvironment(ctx context.Context, in *DestroyEnvir
 	return out, nil
 }
 
+func (c *controlClient) ControlEnvironments(ctx context.Context, in *ControlEnvironmentsRequest, opts ...grpc.CallOption) (*ControlEnvironmentsReply, error) {
+	out := new(ControlEnvironmentsReply)
+	err := c.cc.Invoke(ctx, Control_ControlEnvironments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) DestroyEnvironments(ctx context.Context, in *DestroyEnvironmentsRequest, opts ...grpc.CallOption) (*DestroyEnvironmentsReply, error) {
+	out := new(DestroyEnvironmentsReply)
+	err := c.cc.Invoke(ctx, Control_DestroyEnvironments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetEnvironmentLabels(ctx context.Context, in *SetEnvironmentLabelsRequest, opts ...grpc.CallOption) (*SetEnvironmentLabelsReply, error) {
+	out := new(SetEnvironmentLabelsReply)
+	err := c.cc.Invoke(ctx, Control_SetEnvironmentLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ScheduleTransition(ctx context.Context, in *ScheduleTransitionRequest, opts ...grpc.CallOption) (*ScheduleTransitionReply, error) {
+	out := new(ScheduleTransitionReply)
+	err := c.cc.Invoke(ctx, Control_ScheduleTransition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetScheduledTransitions(ctx context.Context, in *GetScheduledTransitionsRequest, opts ...grpc.CallOption) (*GetScheduledTransitionsReply, error) {
+	out := new(GetScheduledTransitionsReply)
+	err := c.cc.Invoke(ctx, Control_GetScheduledTransitions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CancelScheduledTransition(ctx context.Context, in *CancelScheduledTransitionRequest, opts ...grpc.CallOption) (*CancelScheduledTransitionReply, error) {
+	out := new(CancelScheduledTransitionReply)
+	err := c.cc.Invoke(ctx, Control_CancelScheduledTransition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetTransitionPlan(ctx context.Context, in *GetTransitionPlanRequest, opts ...grpc.CallOption) (*GetTransitionPlanReply, error) {
+	out := new(GetTransitionPlanReply)
+	err := c.cc.Invoke(ctx, Control_GetTransitionPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) GetActiveDetectors(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetActiveDetectorsReply, error) {
 	out := new(GetActiveDetectorsReply)
 	err := c.cc.Invoke(ctx, Control_GetActiveDetectors_FullMethodName, in, out, opts...)
@@ -200,6 +316,33 @@ func (c *controlClient) GetAvailableDetectors(ctx context.Context, in *Empty, op
 	return out, nil
 }
 
+func (c *controlClient) GetDetectorReservations(ctx context.Context, in *GetDetectorReservationsRequest, opts ...grpc.CallOption) (*GetDetectorReservationsReply, error) {
+	out := new(GetDetectorReservationsReply)
+	err := c.cc.Invoke(ctx, Control_GetDetectorReservations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ReserveDetectors(ctx context.Context, in *ReserveDetectorsRequest, opts ...grpc.CallOption) (*ReserveDetectorsReply, error) {
+	out := new(ReserveDetectorsReply)
+	err := c.cc.Invoke(ctx, Control_ReserveDetectors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ReleaseDetectors(ctx context.Context, in *ReleaseDetectorsRequest, opts ...grpc.CallOption) (*ReleaseDetectorsReply, error) {
+	out := new(ReleaseDetectorsReply)
+	err := c.cc.Invoke(ctx, Control_ReleaseDetectors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) NewEnvironmentAsync(ctx context.Context, in *NewEnvironmentRequest, opts ...grpc.CallOption) (*NewEnvironmentReply, error) {
 	out := new(NewEnvironmentReply)
 	err := c.cc.Invoke(ctx, Control_NewEnvironmentAsync_FullMethodName, in, out, opts...)
@@ -236,6 +379,15 @@ func (c *controlClient) CleanupTasks(ctx context.Context, in *CleanupTasksReques
 	return out, nil
 }
 
+func (c *controlClient) GetTaskLogs(ctx context.Context, in *GetTaskLogsRequest, opts ...grpc.CallOption) (*GetTaskLogsReply, error) {
+	out := new(GetTaskLogsReply)
+	err := c.cc.Invoke(ctx, Control_GetTaskLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error) {
 	out := new(GetRolesReply)
 	err := c.cc.Invoke(ctx, Control_GetRoles_FullMethodName, in, out, opts...)
@@ -254,6 +406,24 @@ func (c *controlClient) GetWorkflowTemplates(ctx context.Context, in *GetWorkflo
 	return out, nil
 }
 
+func (c *controlClient) GetWorkflowTemplatePlan(ctx context.Context, in *GetWorkflowTemplatePlanRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatePlanReply, error) {
+	out := new(GetWorkflowTemplatePlanReply)
+	err := c.cc.Invoke(ctx, Control_GetWorkflowTemplatePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) LintWorkflowTemplates(ctx context.Context, in *LintWorkflowTemplatesRequest, opts ...grpc.CallOption) (*LintWorkflowTemplatesReply, error) {
+	out := new(LintWorkflowTemplatesReply)
+	err := c.cc.Invoke(ctx, Control_LintWorkflowTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error) {
 	out := new(ListReposReply)
 	err := c.cc.Invoke(ctx, Control_ListRepos_FullMethodName, in, out, opts...)
@@ -367,15 +537,6 @@ func (c *controlClient) Teardown(ctx context.Context, in *TeardownRequest, opts
 	return out, nil
 }
 
-func (c *controlClient) ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error) {
-	out := new(ModifyEnvironmentReply)
-	err := c.cc.Invoke(ctx, Control_ModifyEnvironment_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
 // ControlServer is the server API for Control service.
 // All implementations should embed UnimplementedControlServer
 // for forward compatibility
@@ -390,9 +551,33 @@ type ControlServer interface {
 	NewEnvironment(context.Context, *NewEnvironmentRequest) (*NewEnvironmentReply, error)
 	GetEnvironment(context.Context, *GetEnvironmentRequest) (*GetEnvironmentReply, error)
 	ControlEnvironment(context.Context, *ControlEnvironmentRequest) (*ControlEnvironmentReply, error)
+	// Adds roles to or removes roles from an environment in the CONFIGURED state.
+	// Added roles are deployed and configured before the call returns.
+	ModifyEnvironment(context.Context, *ModifyEnvironmentRequest) (*ModifyEnvironmentReply, error)
 	DestroyEnvironment(context.Context, *DestroyEnvironmentRequest) (*DestroyEnvironmentReply, error)
+	// Bulk variants of ControlEnvironment and DestroyEnvironment.
+	// They act in parallel on all the environments matched by the selector and return once every
+	// operation is done, with one outcome per environment.
+	ControlEnvironments(context.Context, *ControlEnvironmentsRequest) (*ControlEnvironmentsReply, error)
+	DestroyEnvironments(context.Context, *DestroyEnvironmentsRequest) (*DestroyEnvironmentsReply, error)
+	// Sets and removes labels of an existing environment.
+	SetEnvironmentLabels(context.Context, *SetEnvironmentLabelsRequest) (*SetEnvironmentLabelsReply, error)
+	// Schedules a transition to be triggered automatically at a given time, after a given run
+	// duration, or when an integrated service event satisfies a condition.
+	ScheduleTransition(context.Context, *ScheduleTransitionRequest) (*ScheduleTransitionReply, error)
+	GetScheduledTransitions(context.Context, *GetScheduledTransitionsRequest) (*GetScheduledTransitionsReply, error)
+	CancelScheduledTransition(context.Context, *CancelScheduledTransitionRequest) (*CancelScheduledTransitionReply, error)
+	// Returns the ordered hook groups that a transition would execute on an environment in its current state,
+	// without performing the transition.
+	GetTransitionPlan(context.Context, *GetTransitionPlanRequest) (*GetTransitionPlanReply, error)
 	GetActiveDetectors(context.Context, *Empty) (*GetActiveDetectorsReply, error)
 	GetAvailableDetectors(context.Context, *Empty) (*GetAvailableDetectorsReply, error)
+	// Detector reservations: every detector used by an environment is leased to it, and detectors
+	// can also be reserved ahead of environment creation. A reservation expires after its TTL, unless
+	// it is released or taken over by an environment created by the same user.
+	GetDetectorReservations(context.Context, *GetDetectorReservationsRequest) (*GetDetectorReservationsReply, error)
+	ReserveDetectors(context.Context, *ReserveDetectorsRequest) (*ReserveDetectorsReply, error)
+	ReleaseDetectors(context.Context, *ReleaseDetectorsRequest) (*ReleaseDetectorsReply, error)
 	// Creates a new environment.
 	// It returns once an environment ID is created and continues the creation asynchronously to the call.
 	// The environment will be listed in GetEnvironments() only once the workflow is loaded and deployment starts.
@@ -400,8 +585,16 @@ type ControlServer interface {
 	GetTasks(context.Context, *GetTasksRequest) (*GetTasksReply, error)
 	GetTask(context.Context, *GetTaskRequest) (*GetTaskReply, error)
 	CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error)
+	GetTaskLogs(context.Context, *GetTaskLogsRequest) (*GetTaskLogsReply, error)
 	GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error)
 	GetWorkflowTemplates(context.Context, *GetWorkflowTemplatesRequest) (*GetWorkflowTemplatesReply, error)
+	// Loads and processes a workflow template with the given variables without deploying anything.
+	// It returns the expanded role tree, along with the task classes, channels, constraints and hooks it would use.
+	GetWorkflowTemplatePlan(context.Context, *GetWorkflowTemplatePlanRequest) (*GetWorkflowTemplatePlanReply, error)
+	// Statically checks all the workflow and task templates of a repository at a given revision, without loading
+	// or deploying anything. It reports undefined variables, unresolved includes, unmatched channels, unknown calls
+	// and duplicate role names.
+	LintWorkflowTemplates(context.Context, *LintWorkflowTemplatesRequest) (*LintWorkflowTemplatesReply, error)
 	ListRepos(context.Context, *ListReposRequest) (*ListReposReply, error)
 	AddRepo(context.Context, *AddRepoRequest) (*AddRepoReply, error)
 	RemoveRepo(context.Context, *RemoveRepoRequest) (*RemoveRepoReply, error)
@@ -413,7 +606,6 @@ type ControlServer interface {
 	GetIntegratedServices(context.Context, *Empty) (*ListIntegratedServicesReply, error)
 	// Reserved and not implemented:
 	Teardown(context.Context, *TeardownRequest) (*TeardownReply, error)
-	ModifyEnvironment(context.Context, *ModifyEnvironmentRequest) (*ModifyEnvironmentReply, error)
 }
 
 // UnimplementedControlServer should be embedded to have forward compatible implementations.
@@ -438,15 +630,48 @@ func (UnimplementedControlServer) GetEnvironment(context.Context, *GetEnvironmen
 func (UnimplementedControlServer) ControlEnvironment(context.Context, *ControlEnvironmentRequest) (*ControlEnvironmentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ControlEnvironment not implemented")
 }
+func (UnimplementedControlServer) ModifyEnvironment(context.Context, *ModifyEnvironmentRequest) (*ModifyEnvironmentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyEnvironment not implemented")
+}
 func (UnimplementedControlServer) DestroyEnvironment(context.Context, *DestroyEnvironmentRequest) (*DestroyEnvironmentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnvironment not implemented")
 }
+func (UnimplementedControlServer) ControlEnvironments(context.Context, *ControlEnvironmentsRequest) (*ControlEnvironmentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControlEnvironments not implemented")
+}
+func (UnimplementedControlServer) DestroyEnvironments(context.Context, *DestroyEnvironmentsRequest) (*DestroyEnvironmentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnvironments not implemented")
+}
+func (UnimplementedControlServer) SetEnvironmentLabels(context.Context, *SetEnvironmentLabelsRequest) (*SetEnvironmentLabelsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnvironmentLabels not implemented")
+}
+func (UnimplementedControlServer) ScheduleTransition(context.Context, *ScheduleTransitionRequest) (*ScheduleTransitionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTransition not implemented")
+}
+func (UnimplementedControlServer) GetScheduledTransitions(context.Context, *GetScheduledTransitionsRequest) (*GetScheduledTransitionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledTransitions not implemented")
+}
+func (UnimplementedControlServer) CancelScheduledTransition(context.Context, *CancelScheduledTransitionRequest) (*CancelScheduledTransitionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransition not implemented")
+}
+func (UnimplementedControlServer) GetTransitionPlan(context.Context, *GetTransitionPlanRequest) (*GetTransitionPlanReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransitionPlan not implemented")
+}
 func (UnimplementedControlServer) GetActiveDetectors(context.Context, *Empty) (*GetActiveDetectorsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetActiveDetectors not implemented")
 }
 func (UnimplementedControlServer) GetAvailableDetectors(context.Context, *Empty) (*GetAvailableDetectorsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableDetectors not implemented")
 }
+func (UnimplementedControlServer) GetDetectorReservations(context.Context, *GetDetectorReservationsRequest) (*GetDetectorReservationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDetectorReservations not implemented")
+}
+func (UnimplementedControlServer) ReserveDetectors(context.Context, *ReserveDetectorsRequest) (*ReserveDetectorsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveDetectors not implemented")
+}
+func (UnimplementedControlServer) ReleaseDetectors(context.Context, *ReleaseDetectorsRequest) (*ReleaseDetectorsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDetectors not implemented")
+}
 func (UnimplementedControlServer) NewEnvironmentAsync(context.Context, *NewEnvironmentRequest) (*NewEnvironmentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewEnvironmentAsync not implemented")
 }
@@ -459,12 +684,21 @@ func (UnimplementedControlServer) GetTask(context.Context, *GetTaskRequest) (*Ge
 func (UnimplementedControlServer) CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CleanupTasks not implemented")
 }
+func (UnimplementedControlServer) GetTaskLogs(context.Context, *GetTaskLogsRequest) (*GetTaskLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskLogs not implemented")
+}
 func (UnimplementedControlServer) GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoles not implemented")
 }
 func (UnimplementedControlServer) GetWorkflowTemplates(context.Context, *GetWorkflowTemplatesRequest) (*GetWorkflowTemplatesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowTemplates not implemented")
 }
+func (UnimplementedControlServer) GetWorkflowTemplatePlan(context.Context, *GetWorkflowTemplatePlanRequest) (*GetWorkflowTemplatePlanReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowTemplatePlan not implemented")
+}
+func (UnimplementedControlServer) LintWorkflowTemplates(context.Context, *LintWorkflowTemplatesRequest) (*LintWorkflowTemplatesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflowTemplates not implemented")
+}
 func (UnimplementedControlServer) ListRepos(context.Context, *ListReposRequest) (*ListReposReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepos not implemented")
 }
@@ -495,9 +729,6 @@ func (UnimplementedControlServer) GetIntegratedServices(context.Context, *Empty)
 func (UnimplementedControlServer) Teardown(context.Context, *TeardownRequest) (*TeardownReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Teardown not implemented")
 }
-func (UnimplementedControlServer) ModifyEnvironment(context.Context, *ModifyEnvironmentRequest) (*ModifyEnvironmentReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyEnvironment not implemented")
-}
 
 // UnsafeControlServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ControlServer will
@@ -618,6 +849,24 @@ func _Control_ControlEnvironment_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_ModifyEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ModifyEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ModifyEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ModifyEnvironment(ctx, req.(*ModifyEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_DestroyEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyEnvironmentRequest)
 	if err := dec(in); err != nil {
@@ -636,6 +885,132 @@ func _Control_DestroyEnvironment_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_ControlEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlEnvironmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ControlEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ControlEnvironments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ControlEnvironments(ctx, req.(*ControlEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_DestroyEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyEnvironmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).DestroyEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_DestroyEnvironments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).DestroyEnvironments(ctx, req.(*DestroyEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetEnvironmentLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnvironmentLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetEnvironmentLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_SetEnvironmentLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetEnvironmentLabels(ctx, req.(*SetEnvironmentLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ScheduleTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ScheduleTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ScheduleTransition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ScheduleTransition(ctx, req.(*ScheduleTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetScheduledTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetScheduledTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetScheduledTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetScheduledTransitions(ctx, req.(*GetScheduledTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CancelScheduledTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CancelScheduledTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_CancelScheduledTransition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CancelScheduledTransition(ctx, req.(*CancelScheduledTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetTransitionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransitionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetTransitionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetTransitionPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetTransitionPlan(ctx, req.(*GetTransitionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_GetActiveDetectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -672,6 +1047,60 @@ func _Control_GetAvailableDetectors_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_GetDetectorReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDetectorReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetDetectorReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetDetectorReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetDetectorReservations(ctx, req.(*GetDetectorReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ReserveDetectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveDetectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ReserveDetectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ReserveDetectors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ReserveDetectors(ctx, req.(*ReserveDetectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ReleaseDetectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseDetectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ReleaseDetectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_ReleaseDetectors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ReleaseDetectors(ctx, req.(*ReleaseDetectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_NewEnvironmentAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NewEnvironmentRequest)
 	if err := dec(in); err != nil {
@@ -744,6 +1173,24 @@ func _Control_CleanupTasks_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_GetTaskLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetTaskLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetTaskLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetTaskLogs(ctx, req.(*GetTaskLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_GetRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRolesRequest)
 	if err := dec(in); err != nil {
@@ -780,6 +1227,42 @@ func _Control_GetWorkflowTemplates_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_GetWorkflowTemplatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowTemplatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetWorkflowTemplatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_GetWorkflowTemplatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetWorkflowTemplatePlan(ctx, req.(*GetWorkflowTemplatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_LintWorkflowTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LintWorkflowTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).LintWorkflowTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Control_LintWorkflowTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).LintWorkflowTemplates(ctx, req.(*LintWorkflowTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_ListRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListReposRequest)
 	if err := dec(in); err != nil {
@@ -963,24 +1446,6 @@ func _Control_Teardown_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Control_ModifyEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyEnvironmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).ModifyEnvironment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: Control_ModifyEnvironment_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).ModifyEnvironment(ctx, req.(*ModifyEnvironmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
 // Control_ServiceDesc is the grpc.ServiceDesc for Control service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1012,10 +1477,42 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ControlEnvironment",
 			Handler:    _Control_ControlEnvironment_Handler,
 		},
+		{
+			MethodName: "ModifyEnvironment",
+			Handler:    _Control_ModifyEnvironment_Handler,
+		},
 		{
 			MethodName: "DestroyEnvironment",
 			Handler:    _Control_DestroyEnvironment_Handler,
 		},
+		{
+			MethodName: "ControlEnvironments",
+			Handler:    _Control_ControlEnvironments_Handler,
+		},
+		{
+			MethodName: "DestroyEnvironments",
+			Handler:    _Control_DestroyEnvironments_Handler,
+		},
+		{
+			MethodName: "SetEnvironmentLabels",
+			Handler:    _Control_SetEnvironmentLabels_Handler,
+		},
+		{
+			MethodName: "ScheduleTransition",
+			Handler:    _Control_ScheduleTransition_Handler,
+		},
+		{
+			MethodName: "GetScheduledTransitions",
+			Handler:    _Control_GetScheduledTransitions_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransition",
+			Handler:    _Control_CancelScheduledTransition_Handler,
+		},
+		{
+			MethodName: "GetTransitionPlan",
+			Handler:    _Control_GetTransitionPlan_Handler,
+		},
 		{
 			MethodName: "GetActiveDetectors",
 			Handler:    _Control_GetActiveDetectors_Handler,
@@ -1024,6 +1521,18 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailableDetectors",
 			Handler:    _Control_GetAvailableDetectors_Handler,
 		},
+		{
+			MethodName: "GetDetectorReservations",
+			Handler:    _Control_GetDetectorReservations_Handler,
+		},
+		{
+			MethodName: "ReserveDetectors",
+			Handler:    _Control_ReserveDetectors_Handler,
+		},
+		{
+			MethodName: "ReleaseDetectors",
+			Handler:    _Control_ReleaseDetectors_Handler,
+		},
 		{
 			MethodName: "NewEnvironmentAsync",
 			Handler:    _Control_NewEnvironmentAsync_Handler,
@@ -1040,6 +1549,10 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CleanupTasks",
 			Handler:    _Control_CleanupTasks_Handler,
 		},
+		{
+			MethodName: "GetTaskLogs",
+			Handler:    _Control_GetTaskLogs_Handler,
+		},
 		{
 			MethodName: "GetRoles",
 			Handler:    _Control_GetRoles_Handler,
@@ -1048,6 +1561,14 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkflowTemplates",
 			Handler:    _Control_GetWorkflowTemplates_Handler,
 		},
+		{
+			MethodName: "GetWorkflowTemplatePlan",
+			Handler:    _Control_GetWorkflowTemplatePlan_Handler,
+		},
+		{
+			MethodName: "LintWorkflowTemplates",
+			Handler:    _Control_LintWorkflowTemplates_Handler,
+		},
 		{
 			MethodName: "ListRepos",
 			Handler:    _Control_ListRepos_Handler,
@@ -1084,10 +1605,6 @@ var Control_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Teardown",
 			Handler:    _Control_Teardown_Handler,
 		},
-		{
-			MethodName: "ModifyEnvironment",
-			Handler:    _Control_ModifyEnvironment_Handler,
-		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,177 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/common/monitoring"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	writerMaxRetries   = 5
+	writerRetryBackoff = 500 * time.Millisecond
+)
+
+// bufferedWriter is the common machinery of the writers which serialize events as JSON lines
+// (WebhookWriter and FileWriter). It uses the same two-worker setup as KafkaWriter: a batching
+// loop gathers lines from any goroutine into a FifoBuffer, and a writing loop pops them in
+// batches and hands them to writeFunction.
+// Unlike kafka.Writer, HTTP and file sinks have no built-in retries, so a failed batch is
+// retried up to writerMaxRetries times with exponential backoff before being dropped.
+type bufferedWriter struct {
+	topic               topic.Topic
+	writerMetricName    string
+	prepareMetricName   string
+	toBatchMessagesChan chan []byte
+	messageBuffer       FifoBuffer[[]byte]
+	writeFunction       func([][]byte) error
+	runningWorkers      sync.WaitGroup
+	batchingLoopDoneCh  chan struct{}
+}
+
+func newBufferedWriter(topic topic.Topic, writerMetricName, prepareMetricName string, writeFunction func([][]byte) error) *bufferedWriter {
+	w := &bufferedWriter{
+		topic:               topic,
+		writerMetricName:    writerMetricName,
+		prepareMetricName:   prepareMetricName,
+		toBatchMessagesChan: make(chan []byte, 100000),
+		messageBuffer:       NewFifoBuffer[[]byte](),
+		writeFunction:       writeFunction,
+		runningWorkers:      sync.WaitGroup{},
+		batchingLoopDoneCh:  make(chan struct{}, 1),
+	}
+
+	go w.writingLoop()
+	go w.batchingLoop()
+
+	return w
+}
+
+func (w *bufferedWriter) newMetric(name string) monitoring.Metric {
+	metric := monitoring.NewMetric(name)
+	metric.AddTag("topic", string(w.topic))
+	return metric
+}
+
+// close waits until both loops (batching and writing) are done
+func (w *bufferedWriter) close() {
+	w.runningWorkers.Add(2)
+	close(w.toBatchMessagesChan)
+	w.runningWorkers.Wait()
+}
+
+func (w *bufferedWriter) writingLoop() {
+	for {
+		select {
+		case <-w.batchingLoopDoneCh:
+			// flush whatever is left in the buffer, so that events are not lost on Close
+			for w.messageBuffer.Length() > 0 {
+				w.writeWithRetries(w.messageBuffer.PopMultiple(uint(writerBatchsize)))
+			}
+			w.runningWorkers.Done()
+			return
+		default:
+			messagesToSend := w.messageBuffer.PopMultiple(uint(writerBatchsize))
+			if len(messagesToSend) == 0 {
+				continue
+			}
+			w.writeWithRetries(messagesToSend)
+		}
+	}
+}
+
+func (w *bufferedWriter) batchingLoop() {
+	for message := range w.toBatchMessagesChan {
+		w.messageBuffer.Push(message)
+	}
+	w.batchingLoopDoneCh <- struct{}{}
+	w.messageBuffer.ReleaseGoroutines()
+	w.runningWorkers.Done()
+}
+
+func (w *bufferedWriter) writeWithRetries(messages [][]byte) {
+	metric := w.newMetric(w.writerMetricName)
+	metric.SetFieldUInt64("messages_sent", uint64(len(messages)))
+	metric.SetFieldUInt64("messages_failed", 0)
+
+	metricDuration := w.newMetric(w.writerMetricName)
+	defer monitoring.TimerSendHist(&metricDuration, monitoring.Nanosecond)()
+
+	var err error
+	backoff := writerRetryBackoff
+	for attempt := 0; attempt <= writerMaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		if err = w.writeFunction(messages); err == nil {
+			break
+		}
+		log.WithField("topic", w.topic).
+			WithField("attempt", attempt+1).
+			WithField(infologger.Level, infologger.IL_Devel).
+			Debugf("failed to write %d messages: %v", len(messages), err)
+	}
+	if err != nil {
+		metric.SetFieldUInt64("messages_failed", uint64(len(messages)))
+		log.WithField("topic", w.topic).
+			Errorf("failed to write %d messages after %d attempts with error: %v", len(messages), writerMaxRetries+1, err)
+	}
+
+	monitoring.Send(&metric)
+}
+
+func (w *bufferedWriter) writeEventWithTimestamp(e interface{}, timestamp time.Time) {
+	metric := w.newMetric(w.prepareMetricName)
+	defer monitoring.TimerSendHist(&metric, monitoring.Nanosecond)()
+
+	message, err := internalEventToJSONLine(e, timestamp)
+	if err != nil {
+		log.WithField("event", e).
+			WithField("level", infologger.IL_Support).
+			Errorf("Failed to convert event to JSON: %s", err.Error())
+		return
+	}
+	w.toBatchMessagesChan <- message
+}
+
+// internalEventToJSONLine wraps an event in a pb.Event and serializes it as a single line of
+// JSON, terminated by a newline.
+func internalEventToJSONLine(internalEvent interface{}, timestamp time.Time) ([]byte, error) {
+	wrappedEvent, _, err := internalEventToKafkaEvent(internalEvent, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	data, err := protojson.Marshal(wrappedEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return append(data, '\n'), nil
+}
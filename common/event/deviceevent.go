@@ -88,6 +88,14 @@ func NewDeviceEvent(origin DeviceEventOrigin, t pb.DeviceEventType) (de DeviceEv
 				Origin:    origin,
 			},
 		}
+	case pb.DeviceEventType_TASK_OOM_KILLED:
+		de = &TaskOOMKilled{
+			DeviceEventBase: DeviceEventBase{
+				eventBase: *newDeviceEventBase("DeviceEvent", nil),
+				Type:      t,
+				Origin:    origin,
+			},
+		}
 	case pb.DeviceEventType_NULL_DEVICE_EVENT:
 		de = nil
 	}
@@ -122,3 +130,15 @@ type TaskInternalError struct {
 func (e *TaskInternalError) GetName() string {
 	return "TASK_INTERNAL_ERROR"
 }
+
+// TaskOOMKilled is sent by the executor when a task was killed by the OOM killer for going
+// over the memory limit of its cgroup.
+type TaskOOMKilled struct {
+	DeviceEventBase
+	OomKillCount uint64 `json:"oomKillCount"`
+	MemoryLimit  uint64 `json:"memoryLimit"` // bytes, 0 if unlimited
+}
+
+func (e *TaskOOMKilled) GetName() string {
+	return "TASK_OOM_KILLED"
+}
@@ -0,0 +1,88 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+)
+
+var (
+	FILEWRITER  = "file_writer"
+	FILEPREPARE = "file_prepare"
+)
+
+// FileWriter appends events to a local file in NDJSON format, one JSON-serialized pb.Event per line.
+// Several FileWriters (one per topic) may append to the same file: each batch is written with a
+// single write on a file opened in append mode, so lines from different topics are not interleaved.
+type FileWriter struct {
+	*bufferedWriter
+	file *os.File
+}
+
+func NewFileWriterWithTopic(topic topic.Topic, path string) (*FileWriter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open event file %s: %w", path, err)
+	}
+
+	writer := &FileWriter{
+		file: file,
+	}
+	writer.bufferedWriter = newBufferedWriter(topic, FILEWRITER, FILEPREPARE, writer.append)
+	return writer, nil
+}
+
+func (w *FileWriter) append(messages [][]byte) error {
+	_, err := w.file.Write(bytes.Join(messages, nil))
+	return err
+}
+
+func (w *FileWriter) WriteEvent(e interface{}) {
+	if w != nil {
+		w.WriteEventWithTimestamp(e, time.Now())
+	}
+}
+
+func (w *FileWriter) WriteEventWithTimestamp(e interface{}, timestamp time.Time) {
+	if w != nil {
+		w.writeEventWithTimestamp(e, timestamp)
+	}
+}
+
+func (w *FileWriter) Close() {
+	if w != nil {
+		w.close()
+		err := w.file.Close()
+		if err != nil {
+			log.WithField(infologger.Level, infologger.IL_Devel).
+				Errorf("failed to close event file: %v", err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+
+	pb "github.com/AliceO2Group/Control/common/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ = Describe("FileWriter", func() {
+	When("events are written into writers sharing a file", func() {
+		It("appends one JSON line per event", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "events.ndjson")
+
+			coreWriter, err := NewFileWriterWithTopic("aliecs.core", path)
+			Expect(err).NotTo(HaveOccurred())
+			envWriter, err := NewFileWriterWithTopic("aliecs.environment", path)
+			Expect(err).NotTo(HaveOccurred())
+
+			coreWriter.WriteEvent(&pb.Ev_MetaEvent_CoreStart{FrameworkId: "FrameworkId"})
+			envWriter.WriteEvent(&pb.Ev_EnvironmentEvent{EnvironmentId: "envId"})
+			coreWriter.Close()
+			envWriter.Close()
+
+			file, err := os.Open(path)
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			events := make([]*pb.Event, 0)
+			scanner := bufio.NewScanner(file)
+			for scanner.Scan() {
+				event := &pb.Event{}
+				Expect(protojson.Unmarshal(scanner.Bytes(), event)).To(Succeed())
+				events = append(events, event)
+			}
+			Expect(events).To(HaveLen(2))
+			Expect(events).To(ContainElement(WithTransform(func(e *pb.Event) string {
+				return e.GetEnvironmentEvent().GetEnvironmentId()
+			}, Equal("envId"))))
+		})
+	})
+})
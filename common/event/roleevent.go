@@ -26,10 +26,11 @@ package event
 
 type RoleEvent struct {
 	eventBase
-	Name     string
-	Status   string
-	State    string
-	RolePath string
+	Name          string
+	Status        string
+	State         string
+	RolePath      string
+	EnvironmentId string
 }
 
 func (r *RoleEvent) GetName() string {
@@ -47,3 +48,7 @@ func (r *RoleEvent) GetState() string {
 func (r *RoleEvent) GetRolePath() string {
 	return r.RolePath
 }
+
+func (r *RoleEvent) GetEnvironmentId() string {
+	return r.EnvironmentId
+}
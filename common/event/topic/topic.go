@@ -31,11 +31,12 @@ const (
 	Separator       = "." // used to separate topic segments
 	Root      Topic = "aliecs"
 
-	Run         Topic = Root + Separator + "run" // currently unused, intended for detailed run information e.g. SOR, SOEOR, EOEOR
+	Run         Topic = Root + Separator + "run" // run timeline, e.g. run number allocation, SOR, EOR and their hooks
 	Environment Topic = Root + Separator + "environment"
-	Role        Topic = Root + Separator + "role" // currently unused, intended for role state change events
+	Role        Topic = Root + Separator + "role" // role state and status change events, debounced per role
 	Task        Topic = Root + Separator + "task"
 	Call        Topic = Root + Separator + "call"
+	Reservation Topic = Root + Separator + "reservation" // detector lease and wait queue changes
 
 	Core Topic = Root + Separator + "core"
 
@@ -0,0 +1,110 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+)
+
+var (
+	WEBHOOKWRITER  = "webhook_writer"
+	WEBHOOKPREPARE = "webhook_prepare"
+	webhookTimeout = 10 * time.Second
+)
+
+const (
+	webhookContentType = "application/x-ndjson"
+	webhookTopicHeader = "X-AliECS-Topic"
+)
+
+// WebhookWriter POSTs events to an HTTP endpoint. Events are wrapped in the same pb.Event
+// messages as those sent by KafkaWriter, serialized as JSON, and each request carries a
+// batch of newline-delimited events. The topic is passed in the X-AliECS-Topic header.
+// Any response status other than 2xx is considered a failure and the batch is retried.
+type WebhookWriter struct {
+	*bufferedWriter
+	endpoint string
+	client   *http.Client
+}
+
+func NewWebhookWriterWithTopic(topic topic.Topic, endpoint string) *WebhookWriter {
+	writer := &WebhookWriter{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: webhookTimeout},
+	}
+	writer.bufferedWriter = newBufferedWriter(topic, WEBHOOKWRITER, WEBHOOKPREPARE, writer.post)
+	return writer
+}
+
+func (w *WebhookWriter) post(messages [][]byte) error {
+	body := bytes.Join(messages, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", webhookContentType)
+	req.Header.Set(webhookTopicHeader, string(w.topic))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded with status %s", w.endpoint, resp.Status)
+	}
+	return nil
+}
+
+func (w *WebhookWriter) WriteEvent(e interface{}) {
+	if w != nil {
+		w.WriteEventWithTimestamp(e, time.Now())
+	}
+}
+
+func (w *WebhookWriter) WriteEventWithTimestamp(e interface{}, timestamp time.Time) {
+	if w != nil {
+		w.writeEventWithTimestamp(e, timestamp)
+	}
+}
+
+func (w *WebhookWriter) Close() {
+	if w != nil {
+		w.close()
+		w.client.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/AliceO2Group/Control/common/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ = Describe("WebhookWriter", func() {
+	var (
+		server     *httptest.Server
+		requests   chan *http.Request
+		bodies     chan []byte
+		failures   atomic.Int32
+		oldBackoff time.Duration
+	)
+
+	BeforeEach(func() {
+		oldBackoff = writerRetryBackoff
+		writerRetryBackoff = time.Millisecond
+		requests = make(chan *http.Request, 10)
+		bodies = make(chan []byte, 10)
+		failures.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if failures.Load() > 0 {
+				failures.Add(-1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			requests <- r
+			bodies <- body
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		writerRetryBackoff = oldBackoff
+	})
+
+	When("an event is written into the writer", func() {
+		It("POSTs it as a JSON line with the topic header", func() {
+			writer := NewWebhookWriterWithTopic("aliecs.core", server.URL)
+			defer writer.Close()
+
+			writer.WriteEvent(&pb.Ev_MetaEvent_CoreStart{FrameworkId: "FrameworkId"})
+
+			var req *http.Request
+			Eventually(requests).Should(Receive(&req))
+			Expect(req.Method).To(Equal(http.MethodPost))
+			Expect(req.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+			Expect(req.Header.Get("X-AliECS-Topic")).To(Equal("aliecs.core"))
+
+			var body []byte
+			Eventually(bodies).Should(Receive(&body))
+			scanner := bufio.NewScanner(bytes.NewReader(body))
+			Expect(scanner.Scan()).To(BeTrue())
+			event := &pb.Event{}
+			Expect(protojson.Unmarshal(scanner.Bytes(), event)).To(Succeed())
+			Expect(event.GetCoreStartEvent().GetFrameworkId()).To(Equal("FrameworkId"))
+		})
+	})
+
+	When("the endpoint fails temporarily", func() {
+		It("retries the batch", func() {
+			failures.Store(2)
+			writer := NewWebhookWriterWithTopic("aliecs.core", server.URL)
+			defer writer.Close()
+
+			writer.WriteEvent(&pb.Ev_MetaEvent_CoreStart{FrameworkId: "FrameworkId"})
+
+			Eventually(requests).Should(Receive())
+			Expect(failures.Load()).To(BeZero())
+		})
+	})
+})
@@ -23,7 +23,7 @@
  */
 
 // Package event provides event publishing and streaming functionality for
-// O² Control components, supporting Kafka and dummy event writers.
+// O² Control components, supporting Kafka, HTTP webhook, NDJSON file and dummy event writers.
 package event
 
 import (
@@ -62,6 +62,25 @@ func (*DummyWriter) WriteEvent(interface{})                         {}
 func (*DummyWriter) WriteEventWithTimestamp(interface{}, time.Time) {}
 func (*DummyWriter) Close()                                         {}
 
+// MultiWriter fans out every event to all the writers it contains.
+type MultiWriter []Writer
+
+func (m MultiWriter) WriteEvent(e interface{}) {
+	m.WriteEventWithTimestamp(e, time.Now())
+}
+
+func (m MultiWriter) WriteEventWithTimestamp(e interface{}, timestamp time.Time) {
+	for _, w := range m {
+		w.WriteEventWithTimestamp(e, timestamp)
+	}
+}
+
+func (m MultiWriter) Close() {
+	for _, w := range m {
+		w.Close()
+	}
+}
+
 // Kafka writer is used to convert events from events.proto into kafka messages and to write them.
 // it is built with 2 workers:
 //
@@ -220,6 +239,11 @@ func internalEventToKafkaEvent(internalEvent interface{}, timestamp time.Time) (
 	case *pb.Ev_RunEvent:
 		key = extractAndConvertEnvID(e)
 		kafkaEvent.Payload = &pb.Event_RunEvent{RunEvent: e}
+	case *pb.Ev_DetectorReservationEvent:
+		key = extractAndConvertEnvID(e)
+		kafkaEvent.Payload = &pb.Event_DetectorReservationEvent{DetectorReservationEvent: e}
+	case *pb.Ev_RepositoryRefreshEvent:
+		kafkaEvent.Payload = &pb.Event_RepositoryRefreshEvent{RepositoryRefreshEvent: e}
 	default:
 		err = fmt.Errorf("unsupported event type")
 	}
@@ -91,7 +91,7 @@ func Start(period time.Duration) {
 			default:
 				log.Debug("sending golang metrics")
 				metric := gather()
-				monitoring.Send(&metric)
+				monitoring.SendGauge(&metric)
 				time.Sleep(period)
 			}
 		}
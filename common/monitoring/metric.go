@@ -48,6 +48,8 @@ type Metric struct {
 	fields    FieldsType
 	tags      TagsType
 	timestamp time.Time
+	// gauge metrics replace the previous value in cumulative exports instead of adding to it
+	gauge bool
 }
 
 // Return empty metric, it is used right now mostly in string
@@ -0,0 +1,246 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package monitoring
+
+import (
+	"container/list"
+	"hash/maphash"
+	"sort"
+	"strings"
+)
+
+type cumulativeKind int
+
+const (
+	cumulativeCounter cumulativeKind = iota
+	cumulativeGauge
+	cumulativeHistogram
+)
+
+// default histogram bucket upper bounds, in a 1-2.5-5 progression which covers both
+// millisecond and nanosecond timings
+var histogramBuckets = []float64{
+	1, 2.5, 5, 10, 25, 50, 100, 250, 500,
+	1e3, 2.5e3, 5e3, 1e4, 2.5e4, 5e4, 1e5, 2.5e5, 5e5,
+	1e6, 2.5e6, 5e6, 1e7, 2.5e7, 5e7, 1e8, 2.5e8, 5e8,
+	1e9, 2.5e9, 5e9, 1e10,
+}
+
+// default maximum number of series kept in total. Series are keyed by their tags, which often
+// include a run number, so without a bound they would accumulate for the life of the process.
+const defaultCumulativeMaxSeries = 10000
+
+type cumulativeSeries struct {
+	tags TagsType
+	// value of a counter or gauge
+	value float64
+	// histogram data, bucketCounts[i] counts the observations <= histogramBuckets[i]
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+	// element of this series in the recency list of MetricsCumulative, used for eviction
+	recency *list.Element
+}
+
+// seriesRef locates a series in the recency list
+type seriesRef struct {
+	family *cumulativeFamily
+	key    uint64
+}
+
+type cumulativeFamily struct {
+	name   string
+	kind   cumulativeKind
+	series map[uint64]*cumulativeSeries
+}
+
+// MetricsCumulative keeps running totals of all the metrics it receives, which are never reset
+// on export. Each field of a metric becomes a separate family named <metric>_<field>, and each
+// distinct set of tags a separate series in that family.
+// At most maxSeries series are kept: once the limit is reached, the least recently updated series
+// is dropped to make room for a new one.
+type MetricsCumulative struct {
+	hash        maphash.Hash
+	families    map[string]*cumulativeFamily
+	maxSeries   int
+	seriesCount int
+	// series from the least to the most recently updated, as seriesRef
+	recency *list.List
+}
+
+func NewMetricsCumulative() *MetricsCumulative {
+	metrics := &MetricsCumulative{}
+	metrics.families = make(map[string]*cumulativeFamily)
+	metrics.maxSeries = defaultCumulativeMaxSeries
+	metrics.recency = list.New()
+	metrics.hash.SetSeed(maphash.MakeSeed())
+	return metrics
+}
+
+func sortedTags(tags TagsType) TagsType {
+	sorted := make(TagsType, len(tags))
+	copy(sorted, tags)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].name < sorted[j].name
+	})
+	return sorted
+}
+
+func (this *MetricsCumulative) getSeries(metric *Metric, fieldName string, kind cumulativeKind) *cumulativeSeries {
+	familyName := metric.name + "_" + fieldName
+	family, ok := this.families[familyName]
+	if !ok {
+		family = &cumulativeFamily{name: familyName, kind: kind, series: make(map[uint64]*cumulativeSeries)}
+		this.families[familyName] = family
+	} else if family.kind != kind {
+		// a family cannot mix counters, gauges and histograms, the first kind received wins
+		return nil
+	}
+
+	tags := sortedTags(metric.tags)
+	for _, tag := range tags {
+		_, _ = this.hash.WriteString(tag.name)
+		_, _ = this.hash.WriteString(tag.value)
+	}
+	seriesKey := hashValueAndReset(&this.hash)
+
+	series, ok := family.series[seriesKey]
+	if !ok {
+		if this.maxSeries > 0 && this.seriesCount >= this.maxSeries {
+			this.evictLeastRecentlyUpdated(family)
+		}
+		series = &cumulativeSeries{tags: tags}
+		if kind == cumulativeHistogram {
+			series.bucketCounts = make([]uint64, len(histogramBuckets))
+		}
+		family.series[seriesKey] = series
+		this.seriesCount++
+		series.recency = this.recency.PushBack(seriesRef{family: family, key: seriesKey})
+	} else {
+		this.recency.MoveToBack(series.recency)
+	}
+	return series
+}
+
+// evictLeastRecentlyUpdated drops the series which was updated the longest time ago, and its
+// family if it becomes empty, unless it is keep.
+func (this *MetricsCumulative) evictLeastRecentlyUpdated(keep *cumulativeFamily) {
+	oldest := this.recency.Front()
+	if oldest == nil {
+		return
+	}
+	ref := this.recency.Remove(oldest).(seriesRef)
+	delete(ref.family.series, ref.key)
+	this.seriesCount--
+	if len(ref.family.series) == 0 && ref.family != keep {
+		delete(this.families, ref.family.name)
+	}
+}
+
+// AddCounterMetric adds the values of all fields of metric to their running totals.
+func (this *MetricsCumulative) AddCounterMetric(metric *Metric) {
+	for fieldName, field := range metric.fields {
+		if series := this.getSeries(metric, fieldName, cumulativeCounter); series != nil {
+			series.value += metricFieldToFloat64(field)
+		}
+	}
+}
+
+// SetGaugeMetric replaces the values of all fields of metric.
+func (this *MetricsCumulative) SetGaugeMetric(metric *Metric) {
+	for fieldName, field := range metric.fields {
+		if series := this.getSeries(metric, fieldName, cumulativeGauge); series != nil {
+			series.value = metricFieldToFloat64(field)
+		}
+	}
+}
+
+// ObserveHistogramMetric records the values of all fields of metric as histogram observations.
+func (this *MetricsCumulative) ObserveHistogramMetric(metric *Metric) {
+	for fieldName, field := range metric.fields {
+		series := this.getSeries(metric, fieldName, cumulativeHistogram)
+		if series == nil {
+			continue
+		}
+		value := metricFieldToFloat64(field)
+		series.count += 1
+		series.sum += value
+		// buckets are cumulative, an observation is counted in every bucket with a bound >= value
+		for i := sort.SearchFloat64s(histogramBuckets, value); i < len(histogramBuckets); i++ {
+			series.bucketCounts[i] += 1
+		}
+	}
+}
+
+func (this *MetricsCumulative) Clear() {
+	this.hash.Reset()
+	clear(this.families)
+	this.seriesCount = 0
+	this.recency.Init()
+}
+
+// GetFamilies returns a deep copy of the current state, with families sorted by name and
+// series sorted by tags, so that it can be formatted outside the event loop.
+func (this *MetricsCumulative) GetFamilies() []cumulativeFamily {
+	result := make([]cumulativeFamily, 0, len(this.families))
+	for _, family := range this.families {
+		familyCopy := cumulativeFamily{name: family.name, kind: family.kind, series: make(map[uint64]*cumulativeSeries, len(family.series))}
+		for seriesKey, series := range family.series {
+			seriesCopy := *series
+			seriesCopy.recency = nil
+			if series.bucketCounts != nil {
+				seriesCopy.bucketCounts = make([]uint64, len(series.bucketCounts))
+				copy(seriesCopy.bucketCounts, series.bucketCounts)
+			}
+			familyCopy.series[seriesKey] = &seriesCopy
+		}
+		result = append(result, familyCopy)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result
+}
+
+func (family *cumulativeFamily) sortedSeries() []*cumulativeSeries {
+	result := make([]*cumulativeSeries, 0, len(family.series))
+	for _, series := range family.series {
+		result = append(result, series)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return tagsToString(result[i].tags) < tagsToString(result[j].tags)
+	})
+	return result
+}
+
+func tagsToString(tags TagsType) string {
+	var sb strings.Builder
+	for _, tag := range tags {
+		sb.WriteString(tag.name)
+		sb.WriteByte(0)
+		sb.WriteString(tag.value)
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
@@ -44,6 +44,8 @@ var (
 	// objects to store incoming metrics
 	metricsInternal          *MetricsAggregate         = NewMetricsAggregate()
 	metricsHistogramInternal *MetricsReservoirSampling = NewMetricsReservoirSampling()
+	// running totals and histograms of all incoming metrics, never reset by export
+	metricsCumulativeInternal *MetricsCumulative = NewMetricsCumulative()
 	// channel that is used to request end of metrics server, it sends notification when server ended.
 	// It needs to be read!!!
 	endChannel chan struct{} = make(chan struct{})
@@ -64,6 +66,12 @@ var (
 	// channel used to send metrics to be reported by http request from event loop
 	metricsExportedToRequest chan []Metric = make(chan []Metric)
 
+	// channel for requesting a copy of the cumulative metrics, sent back via cumulativeExportedToRequest
+	cumulativeRequestedChannel chan struct{} = make(chan struct{})
+
+	// channel used to send cumulative metrics to be reported by http request from event loop
+	cumulativeExportedToRequest chan []cumulativeFamily = make(chan []cumulativeFamily)
+
 	// WaitUntilRunning is waiting until this channel is closed
 	waitUntilRunningChannel chan struct{} = make(chan struct{})
 
@@ -71,12 +79,16 @@ var (
 )
 
 // this eventLoop is the main part that processes all metrics send to the package
-// 4 events can happen:
-//  1. metricsChannel receives message from Send() method. We add the new metric to metrics slice
+// 5 events can happen:
+//  1. metricsChannel receives message from Send() or SendGauge() method. We add the new metric to metrics slice
+//     and to the cumulative metrics
 //  2. metricsHistosChannel receives message from Send() method. We add the new metric to metrics slice
+//     and to the cumulative histograms
 //  3. metricsRequestChannel receives request to dump and request existing metrics. We send shallow copy of existing
 //     metrics to requestor (via metricsExportedToRequest channel) while resetting current metrics slice
-//  4. receive request to stop monitoring via endChannel. We send confirmation through endChannel to notify caller
+//  4. cumulativeRequestedChannel receives request for cumulative metrics. We send a copy to requestor (via
+//     cumulativeExportedToRequest channel) without resetting anything
+//  5. receive request to stop monitoring via endChannel. We send confirmation through endChannel to notify caller
 //     that eventLoop stopped
 func eventLoop() {
 	for {
@@ -89,10 +101,19 @@ func eventLoop() {
 
 			metricsExportedToRequest <- aggregatedMetrics
 
+		case <-cumulativeRequestedChannel:
+			cumulativeExportedToRequest <- metricsCumulativeInternal.GetFamilies()
+
 		case metric := <-metricsChannel:
+			if metric.gauge {
+				metricsCumulativeInternal.SetGaugeMetric(&metric)
+			} else {
+				metricsCumulativeInternal.AddCounterMetric(&metric)
+			}
 			metricsInternal.AddMetric(&metric)
 
 		case metric := <-metricsHistosChannel:
+			metricsCumulativeInternal.ObserveHistogramMetric(&metric)
 			metricsHistogramInternal.AddMetric(&metric)
 
 		case <-endChannel:
@@ -125,6 +146,18 @@ func Send(metric *Metric) {
 	}
 }
 
+// SendGauge sends a metric whose fields are current values rather than increments, e.g. memory usage.
+// It is exported like Send in line protocol, but as a gauge instead of a counter in Prometheus format.
+func SendGauge(metric *Metric) {
+	gauge := *metric
+	gauge.gauge = true
+	// drop overflowing messages to not slowdown processing, we don't log so we don't flood IL
+	select {
+	case metricsChannel <- gauge:
+	default:
+	}
+}
+
 func SendHistogrammable(metric *Metric) {
 	// drop overflowing messages to not slowdown processing, we don't log so we don't flood IL
 	select {
@@ -133,13 +166,13 @@ func SendHistogrammable(metric *Metric) {
 	}
 }
 
-func handleFunc(endpointName string) {
+func handleFunc(endpointName string, handler http.HandlerFunc) {
 	// recover is here to correctly allow multiple Starts and Stops of server
 	defer func() {
 		recover()
 	}()
 
-	http.HandleFunc(endpointName, exportMetricsAndReset)
+	http.HandleFunc(endpointName, handler)
 }
 
 // \param port port where the scraping endpoint will be created
@@ -147,13 +180,26 @@ func handleFunc(endpointName string) {
 //
 // If we attempt send more messages than the size of the buffer, these overflowing messages will be ignored and warning will be logged.
 func Run(port uint16, endpointName string) error {
+	return RunWithPrometheus(port, endpointName, "")
+}
+
+// \param port port where the scraping endpoints will be created
+// \param endpointName name of the line protocol endpoint, which must start with a slash eg. "/internalmetrics"
+// \param prometheusEndpointName name of the Prometheus/OpenMetrics endpoint, which must start with a slash eg. "/metrics",
+// or empty to disable it
+//
+// Unlike the line protocol endpoint, scraping the Prometheus endpoint does not reset any metric.
+func RunWithPrometheus(port uint16, endpointName string, prometheusEndpointName string) error {
 	localServer := &http.Server{Addr: fmt.Sprintf(":%d", port)}
 	// only one Run should initialize and serve
 	if !server.CompareAndSwap(nil, localServer) {
 		return nil
 	}
 	go eventLoop()
-	handleFunc(endpointName)
+	handleFunc(endpointName, exportMetricsAndReset)
+	if len(prometheusEndpointName) != 0 {
+		handleFunc(prometheusEndpointName, exportMetricsPrometheus)
+	}
 	// block until Shutdown is called
 	close(waitUntilRunningChannel)
 	return localServer.ListenAndServe()
@@ -174,6 +220,7 @@ func Stop() {
 	}
 	metricsInternal.Clear()
 	metricsHistogramInternal.Clear()
+	metricsCumulativeInternal.Clear()
 	<-endChannel
 }
 
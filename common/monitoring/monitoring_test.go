@@ -33,6 +33,7 @@ import (
 	"net/http"
 	"os"
 	"runtime/pprof"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -544,6 +545,214 @@ func TestMetricsHistogramObject(t *testing.T) {
 	}
 }
 
+func TestMetricsCumulativeObject(t *testing.T) {
+	metrics := NewMetricsCumulative()
+
+	metrics.AddCounterMetric(&Metric{name: "counter", tags: TagsType{Tag{"tag1", "1"}, Tag{"tag2", "2"}}, fields: FieldsType{"val": int64(1)}, timestamp: time.Unix(10, 0)})
+	metrics.AddCounterMetric(&Metric{name: "counter", tags: TagsType{Tag{"tag2", "2"}, Tag{"tag1", "1"}}, fields: FieldsType{"val": uint64(2)}, timestamp: time.Unix(20, 0)})
+	metrics.AddCounterMetric(&Metric{name: "counter", tags: TagsType{Tag{"tag1", "other"}}, fields: FieldsType{"val": float64(0.5)}, timestamp: time.Unix(20, 0)})
+	metrics.SetGaugeMetric(&Metric{name: "gauge", fields: FieldsType{"val": int64(5)}})
+	metrics.SetGaugeMetric(&Metric{name: "gauge", fields: FieldsType{"val": int64(3)}})
+	// a family keeps the kind it was first seen with
+	metrics.AddCounterMetric(&Metric{name: "gauge", fields: FieldsType{"val": int64(100)}})
+	for _, value := range []int64{1, 3, 3, 7000} {
+		metrics.ObserveHistogramMetric(&Metric{name: "histo", fields: FieldsType{"val": value}})
+	}
+
+	families := metrics.GetFamilies()
+	if len(families) != 3 {
+		t.Fatalf("expected 3 families, got %d", len(families))
+	}
+
+	counter := families[0]
+	if counter.name != "counter_val" || counter.kind != cumulativeCounter {
+		t.Fatalf("unexpected first family %s of kind %d", counter.name, counter.kind)
+	}
+	counterSeries := counter.sortedSeries()
+	if len(counterSeries) != 2 {
+		t.Fatalf("expected 2 counter series, got %d", len(counterSeries))
+	}
+	if counterSeries[0].value != 3 {
+		t.Errorf("expected counter value 3 for reordered tags, got %v", counterSeries[0].value)
+	}
+	if counterSeries[1].value != 0.5 {
+		t.Errorf("expected counter value 0.5, got %v", counterSeries[1].value)
+	}
+
+	gauge := families[1]
+	if gaugeValue := gauge.sortedSeries()[0].value; gauge.kind != cumulativeGauge || gaugeValue != 3 {
+		t.Errorf("expected gauge value 3, got %v", gaugeValue)
+	}
+
+	histo := families[2].sortedSeries()[0]
+	if histo.count != 4 || histo.sum != 7007 {
+		t.Errorf("wrong histogram count %d or sum %v", histo.count, histo.sum)
+	}
+	expectedBuckets := map[float64]uint64{1: 1, 2.5: 1, 5: 3, 5000: 3, 10000: 4, 1e10: 4}
+	for i, bound := range histogramBuckets {
+		if expected, ok := expectedBuckets[bound]; ok && histo.bucketCounts[i] != expected {
+			t.Errorf("wrong count %d in bucket le=%v, expected %d", histo.bucketCounts[i], bound, expected)
+		}
+	}
+
+	// the families returned are copies, further updates must not change them
+	metrics.ObserveHistogramMetric(&Metric{name: "histo", fields: FieldsType{"val": int64(1)}})
+	if histo.count != 4 || histo.bucketCounts[0] != 1 {
+		t.Error("exported histogram changed after a new observation")
+	}
+
+	metrics.Clear()
+	if f := metrics.GetFamilies(); len(f) != 0 {
+		t.Errorf("cumulative metrics should be empty after clearing, but we got: %+v", f)
+	}
+}
+
+func TestMetricsCumulativeEviction(t *testing.T) {
+	metrics := NewMetricsCumulative()
+	metrics.maxSeries = 3
+
+	for _, run := range []string{"1", "2", "3"} {
+		metrics.AddCounterMetric(&Metric{name: "counter", tags: TagsType{Tag{"run", run}}, fields: FieldsType{"val": int64(1)}})
+	}
+	// run 1 is updated again, so run 2 becomes the least recently updated series
+	metrics.AddCounterMetric(&Metric{name: "counter", tags: TagsType{Tag{"run", "1"}}, fields: FieldsType{"val": int64(1)}})
+	metrics.SetGaugeMetric(&Metric{name: "gauge", fields: FieldsType{"val": int64(5)}})
+
+	families := metrics.GetFamilies()
+	if len(families) != 2 {
+		t.Fatalf("expected 2 families, got %d", len(families))
+	}
+	counterSeries := families[0].sortedSeries()
+	if len(counterSeries) != 2 {
+		t.Fatalf("expected 2 counter series after eviction, got %d", len(counterSeries))
+	}
+	if counterSeries[0].tags[0].value != "1" || counterSeries[0].value != 2 {
+		t.Errorf("expected series of run 1 with value 2, got run %s with value %v", counterSeries[0].tags[0].value, counterSeries[0].value)
+	}
+	if counterSeries[1].tags[0].value != "3" {
+		t.Errorf("expected series of run 3 to be kept, got run %s", counterSeries[1].tags[0].value)
+	}
+
+	// evicting the last series of a family drops the family too
+	metrics.AddCounterMetric(&Metric{name: "other", fields: FieldsType{"val": int64(1)}})
+	metrics.AddCounterMetric(&Metric{name: "other", tags: TagsType{Tag{"run", "4"}}, fields: FieldsType{"val": int64(1)}})
+	metrics.AddCounterMetric(&Metric{name: "other", tags: TagsType{Tag{"run", "5"}}, fields: FieldsType{"val": int64(1)}})
+	families = metrics.GetFamilies()
+	if len(families) != 1 || families[0].name != "other_val" || len(families[0].series) != 3 {
+		t.Errorf("expected only the 3 series of other_val to be kept, got %+v", families)
+	}
+	if metrics.recency.Len() != metrics.seriesCount {
+		t.Errorf("expected the recency list to track the %d series, got %d entries", metrics.seriesCount, metrics.recency.Len())
+	}
+}
+
+func BenchmarkMetricsCumulativeEviction(b *testing.B) {
+	metrics := NewMetricsCumulative()
+	for i := 0; i < metrics.maxSeries; i++ {
+		metrics.AddCounterMetric(&Metric{name: "counter", tags: TagsType{Tag{"run", strconv.Itoa(i)}}, fields: FieldsType{"val": int64(1)}})
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// every new run evicts the least recently updated one
+		metrics.AddCounterMetric(&Metric{name: "counter", tags: TagsType{Tag{"run", strconv.Itoa(metrics.maxSeries + i)}}, fields: FieldsType{"val": int64(1)}})
+	}
+}
+
+func TestPrometheusFormat(t *testing.T) {
+	metrics := NewMetricsCumulative()
+	metrics.AddCounterMetric(&Metric{name: "kafka", tags: TagsType{Tag{"topic", "aliecs.run"}, Tag{"subsystem", "ECS"}}, fields: FieldsType{"sent_messages": uint64(42)}})
+	metrics.SetGaugeMetric(&Metric{name: "golangruntimemetrics", fields: FieldsType{"/sched/goroutines:goroutines": uint64(12)}})
+	metrics.ObserveHistogramMetric(&Metric{name: "call", tags: TagsType{Tag{"path", `a"b`}}, fields: FieldsType{"execution_time_ms": int64(2)}})
+
+	var buf bytes.Buffer
+	if err := formatPrometheus(&buf, metrics.GetFamilies(), false); err != nil {
+		t.Fatalf("failed to format metrics: %v", err)
+	}
+	output := buf.String()
+	expectedLines := []string{
+		"# TYPE call_execution_time_ms histogram",
+		`call_execution_time_ms_bucket{path="a\"b",le="1"} 0`,
+		`call_execution_time_ms_bucket{path="a\"b",le="2.5"} 1`,
+		`call_execution_time_ms_bucket{path="a\"b",le="1e+10"} 1`,
+		`call_execution_time_ms_bucket{path="a\"b",le="+Inf"} 1`,
+		`call_execution_time_ms_sum{path="a\"b"} 2`,
+		`call_execution_time_ms_count{path="a\"b"} 1`,
+		"# TYPE golangruntimemetrics_sched_goroutines_goroutines gauge",
+		"golangruntimemetrics_sched_goroutines_goroutines 12",
+		"# TYPE kafka_sent_messages_total counter",
+		`kafka_sent_messages_total{subsystem="ECS",topic="aliecs.run"} 42`,
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(output, line+"\n") {
+			t.Errorf("missing line %q in output:\n%s", line, output)
+		}
+	}
+	if strings.Contains(output, "# EOF") {
+		t.Error("unexpected EOF marker in Prometheus text format")
+	}
+
+	buf.Reset()
+	if err := formatPrometheus(&buf, metrics.GetFamilies(), true); err != nil {
+		t.Fatalf("failed to format metrics: %v", err)
+	}
+	output = buf.String()
+	if !strings.Contains(output, "# TYPE kafka_sent_messages counter\n") {
+		t.Errorf("OpenMetrics counter family should not have the _total suffix:\n%s", output)
+	}
+	if !strings.HasSuffix(output, "# EOF\n") {
+		t.Errorf("OpenMetrics output should end with an EOF marker:\n%s", output)
+	}
+}
+
+func scrapeWithTimeout(t *testing.T, url string, expectedLine string, timeout time.Duration) string {
+	timeoutChan := time.After(timeout)
+	for {
+		response, err := http.Get(url)
+		if err != nil {
+			t.Fatalf("Failed to GET metrics at %s: %v", url, err)
+		}
+		message, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			t.Fatalf("Failed to read response Body: %v", err)
+		}
+		if strings.Contains(string(message), expectedLine) {
+			return string(message)
+		}
+		select {
+		case <-timeoutChan:
+			t.Fatalf("Timeout %v triggered when waiting for %q, got:\n%s", timeout, expectedLine, string(message))
+		default:
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func TestHttpRunPrometheus(t *testing.T) {
+	port := uint16(9877)
+	go RunWithPrometheus(port, "/ecsmetricsprom", "/prometheusmetrics")
+	defer Stop()
+
+	isRunningWithTimeout(t, 5*time.Second)
+
+	url := fmt.Sprintf("http://localhost:%d/prometheusmetrics", port)
+	metric := Metric{name: "testprometheus", timestamp: time.Unix(10, 0)}
+	metric.SetFieldInt64("value", 2)
+	Send(&metric)
+	scrapeWithTimeout(t, url, "testprometheus_value_total 2\n", time.Second)
+
+	// scraping must not reset the counters, neither the Prometheus nor the line protocol endpoint
+	scrapeWithTimeout(t, url, "testprometheus_value_total 2\n", time.Second)
+	_, err := http.Get(fmt.Sprintf("http://localhost:%d/ecsmetricsprom", port))
+	if err != nil {
+		t.Fatalf("Failed to GET line protocol metrics: %v", err)
+	}
+	metric.timestamp = time.Unix(20, 0)
+	Send(&metric)
+	scrapeWithTimeout(t, url, "testprometheus_value_total 4\n", time.Second)
+}
+
 func measureFunc(metric *Metric) {
 	defer Timer(metric, Millisecond)()
 	defer Timer(metric, Nanosecond)()
@@ -0,0 +1,172 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package monitoring
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+)
+
+const (
+	prometheusContentType  = "text/plain; version=0.0.4; charset=utf-8"
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// sanitizeMetricName maps a name to the [a-zA-Z_][a-zA-Z0-9_]* charset shared by Prometheus
+// metric and label names, collapsing runs of invalid characters into a single underscore.
+func sanitizeMetricName(name string) string {
+	var sb strings.Builder
+	lastUnderscore := false
+	for _, r := range name {
+		valid := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !valid {
+			r = '_'
+		}
+		if r == '_' {
+			if lastUnderscore {
+				continue
+			}
+			lastUnderscore = true
+		} else {
+			lastUnderscore = false
+		}
+		sb.WriteRune(r)
+	}
+	sanitized := strings.Trim(sb.String(), "_")
+	if len(sanitized) == 0 || (sanitized[0] >= '0' && sanitized[0] <= '9') {
+		sanitized = "_" + sanitized
+	}
+	return sanitized
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func writeSample(writer *bufio.Writer, name string, tags TagsType, extraLabel string, extraValue string, value string) {
+	writer.WriteString(name)
+	if len(tags) != 0 || len(extraLabel) != 0 {
+		writer.WriteByte('{')
+		for i, tag := range tags {
+			if i > 0 {
+				writer.WriteByte(',')
+			}
+			writer.WriteString(sanitizeMetricName(tag.name))
+			writer.WriteString(`="`)
+			writer.WriteString(labelValueReplacer.Replace(tag.value))
+			writer.WriteByte('"')
+		}
+		if len(extraLabel) != 0 {
+			if len(tags) != 0 {
+				writer.WriteByte(',')
+			}
+			writer.WriteString(extraLabel)
+			writer.WriteString(`="`)
+			writer.WriteString(extraValue)
+			writer.WriteByte('"')
+		}
+		writer.WriteByte('}')
+	}
+	writer.WriteByte(' ')
+	writer.WriteString(value)
+	writer.WriteByte('\n')
+}
+
+// formatPrometheus writes the families in the Prometheus text exposition format, or in the
+// OpenMetrics text format if openMetrics is true.
+// Counters are exposed with a _total suffix, histograms with _bucket, _sum and _count series.
+func formatPrometheus(writer io.Writer, families []cumulativeFamily, openMetrics bool) error {
+	buffered := bufio.NewWriter(writer)
+
+	for _, family := range families {
+		name := sanitizeMetricName(family.name)
+		series := family.sortedSeries()
+
+		switch family.kind {
+		case cumulativeCounter:
+			typeName := name + "_total"
+			if openMetrics {
+				// in OpenMetrics the family is named without the suffix of its samples
+				typeName = name
+			}
+			buffered.WriteString("# TYPE " + typeName + " counter\n")
+			for _, s := range series {
+				writeSample(buffered, name+"_total", s.tags, "", "", formatFloat(s.value))
+			}
+		case cumulativeGauge:
+			buffered.WriteString("# TYPE " + name + " gauge\n")
+			for _, s := range series {
+				writeSample(buffered, name, s.tags, "", "", formatFloat(s.value))
+			}
+		case cumulativeHistogram:
+			buffered.WriteString("# TYPE " + name + " histogram\n")
+			for _, s := range series {
+				for i, bound := range histogramBuckets {
+					writeSample(buffered, name+"_bucket", s.tags, "le", formatFloat(bound), strconv.FormatUint(s.bucketCounts[i], 10))
+				}
+				writeSample(buffered, name+"_bucket", s.tags, "le", "+Inf", strconv.FormatUint(s.count, 10))
+				writeSample(buffered, name+"_sum", s.tags, "", "", formatFloat(s.sum))
+				writeSample(buffered, name+"_count", s.tags, "", "", strconv.FormatUint(s.count, 10))
+			}
+		}
+	}
+
+	if openMetrics {
+		buffered.WriteString("# EOF\n")
+	}
+	return buffered.Flush()
+}
+
+func exportMetricsPrometheus(w http.ResponseWriter, r *http.Request) {
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+	if openMetrics {
+		w.Header().Set("Content-Type", openMetricsContentType)
+	} else {
+		w.Header().Set("Content-Type", prometheusContentType)
+	}
+
+	cumulativeRequestedChannel <- struct{}{}
+	families := <-cumulativeExportedToRequest
+
+	err := formatPrometheus(w, families, openMetrics)
+	if err != nil {
+		log.WithField(infologger.Level, infologger.IL_Devel).Errorf("Failed to export Prometheus metrics: %v", err)
+	}
+}
@@ -101,6 +101,113 @@ func (OpStatus) EnumDescriptor() ([]byte, []int) {
 	return file_protos_events_proto_rawDescGZIP(), []int{0}
 }
 
+type RunEventKind int32
+
+const (
+	RunEventKind_RUN_TRANSITION       RunEventKind = 0 // start or end of a run transition, e.g. START_ACTIVITY or STOP_ACTIVITY
+	RunEventKind_RUN_NUMBER_ALLOCATED RunEventKind = 1 // a new run number was obtained for the environment
+	RunEventKind_HOOK_GROUP           RunEventKind = 2 // start or end of a group of hooks sharing the same trigger and weight
+	RunEventKind_HOOK_CALL            RunEventKind = 3 // result of a single hook call, e.g. the SOR or EOR operation of a plugin
+)
+
+// Enum value maps for RunEventKind.
+var (
+	RunEventKind_name = map[int32]string{
+		0: "RUN_TRANSITION",
+		1: "RUN_NUMBER_ALLOCATED",
+		2: "HOOK_GROUP",
+		3: "HOOK_CALL",
+	}
+	RunEventKind_value = map[string]int32{
+		"RUN_TRANSITION":       0,
+		"RUN_NUMBER_ALLOCATED": 1,
+		"HOOK_GROUP":           2,
+		"HOOK_CALL":            3,
+	}
+)
+
+func (x RunEventKind) Enum() *RunEventKind {
+	p := new(RunEventKind)
+	*p = x
+	return p
+}
+
+func (x RunEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_events_proto_enumTypes[1].Descriptor()
+}
+
+func (RunEventKind) Type() protoreflect.EnumType {
+	return &file_protos_events_proto_enumTypes[1]
+}
+
+func (x RunEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunEventKind.Descriptor instead.
+func (RunEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_protos_events_proto_rawDescGZIP(), []int{1}
+}
+
+type DetectorReservationChange int32
+
+const (
+	DetectorReservationChange_LEASE_ACQUIRED   DetectorReservationChange = 0
+	DetectorReservationChange_LEASE_RELEASED   DetectorReservationChange = 1
+	DetectorReservationChange_LEASE_EXPIRED    DetectorReservationChange = 2
+	DetectorReservationChange_REQUEST_QUEUED   DetectorReservationChange = 3 // an environment creation request started waiting for its detectors
+	DetectorReservationChange_REQUEST_DEQUEUED DetectorReservationChange = 4 // a waiting environment creation request got its detectors or gave up
+)
+
+// Enum value maps for DetectorReservationChange.
+var (
+	DetectorReservationChange_name = map[int32]string{
+		0: "LEASE_ACQUIRED",
+		1: "LEASE_RELEASED",
+		2: "LEASE_EXPIRED",
+		3: "REQUEST_QUEUED",
+		4: "REQUEST_DEQUEUED",
+	}
+	DetectorReservationChange_value = map[string]int32{
+		"LEASE_ACQUIRED":   0,
+		"LEASE_RELEASED":   1,
+		"LEASE_EXPIRED":    2,
+		"REQUEST_QUEUED":   3,
+		"REQUEST_DEQUEUED": 4,
+	}
+)
+
+func (x DetectorReservationChange) Enum() *DetectorReservationChange {
+	p := new(DetectorReservationChange)
+	*p = x
+	return p
+}
+
+func (x DetectorReservationChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DetectorReservationChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_events_proto_enumTypes[2].Descriptor()
+}
+
+func (DetectorReservationChange) Type() protoreflect.EnumType {
+	return &file_protos_events_proto_enumTypes[2]
+}
+
+func (x DetectorReservationChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DetectorReservationChange.Descriptor instead.
+func (DetectorReservationChange) EnumDescriptor() ([]byte, []int) {
+	return file_protos_events_proto_rawDescGZIP(), []int{2}
+}
+
 type Ev_MetaEvent_MesosHeartbeat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -840,13 +947,19 @@ type Ev_RunEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnvironmentId    string   `protobuf:"bytes,1,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
-	RunNumber        uint32   `protobuf:"varint,2,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
-	State            string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	Error            string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	Transition       string   `protobuf:"bytes,5,opt,name=transition,proto3" json:"transition,omitempty"`
-	TransitionStatus OpStatus `protobuf:"varint,6,opt,name=transitionStatus,proto3,enum=events.OpStatus" json:"transitionStatus,omitempty"`
-	LastRequestUser  *User    `protobuf:"bytes,8,opt,name=lastRequestUser,proto3" json:"lastRequestUser,omitempty"`
+	EnvironmentId    string       `protobuf:"bytes,1,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	RunNumber        uint32       `protobuf:"varint,2,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	State            string       `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Error            string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Transition       string       `protobuf:"bytes,5,opt,name=transition,proto3" json:"transition,omitempty"`
+	TransitionStatus OpStatus     `protobuf:"varint,6,opt,name=transitionStatus,proto3,enum=events.OpStatus" json:"transitionStatus,omitempty"` // for HOOK_GROUP and HOOK_CALL, the status of the hook group or call
+	LastRequestUser  *User        `protobuf:"bytes,8,opt,name=lastRequestUser,proto3" json:"lastRequestUser,omitempty"`
+	Kind             RunEventKind `protobuf:"varint,9,opt,name=kind,proto3,enum=events.RunEventKind" json:"kind,omitempty"`
+	Trigger          string       `protobuf:"bytes,10,opt,name=trigger,proto3" json:"trigger,omitempty"`        // hook trigger, e.g. before_START_ACTIVITY, only for HOOK_GROUP and HOOK_CALL
+	Weight           int32        `protobuf:"varint,11,opt,name=weight,proto3" json:"weight,omitempty"`         // hook weight within the trigger, only for HOOK_GROUP and HOOK_CALL
+	Plugin           string       `protobuf:"bytes,12,opt,name=plugin,proto3" json:"plugin,omitempty"`          // name of the plugin which provides the called function, only for HOOK_CALL
+	Call             string       `protobuf:"bytes,13,opt,name=call,proto3" json:"call,omitempty"`              // called function, e.g. dcs.StartOfRun(), only for HOOK_CALL
+	DurationMs       int64        `protobuf:"varint,14,opt,name=durationMs,proto3" json:"durationMs,omitempty"` // duration of the transition, hook group or call, only when it is done
 }
 
 func (x *Ev_RunEvent) Reset() {
@@ -930,6 +1043,48 @@ func (x *Ev_RunEvent) GetLastRequestUser() *User {
 	return nil
 }
 
+func (x *Ev_RunEvent) GetKind() RunEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RunEventKind_RUN_TRANSITION
+}
+
+func (x *Ev_RunEvent) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *Ev_RunEvent) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Ev_RunEvent) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *Ev_RunEvent) GetCall() string {
+	if x != nil {
+		return x.Call
+	}
+	return ""
+}
+
+func (x *Ev_RunEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
 // *
 // Beam mode changes are propagated as Kafka events and to be sent by the BKP-LHC-Client on a dedicated topic
 // e.g. dip.lhc.beam_mode
@@ -988,6 +1143,275 @@ func (x *Ev_BeamModeEvent) GetBeamInfo() *BeamInfo {
 	return nil
 }
 
+type DetectorLease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detector      string `protobuf:"bytes,1,opt,name=detector,proto3" json:"detector,omitempty"`
+	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`                 // name of the user who holds the lease
+	EnvironmentId string `protobuf:"bytes,3,opt,name=environmentId,proto3" json:"environmentId,omitempty"` // environment which holds the lease, empty for a reservation made ahead of environment creation
+	AcquiredWhen  int64  `protobuf:"varint,4,opt,name=acquiredWhen,proto3" json:"acquiredWhen,omitempty"`  // milliseconds since epoch
+	ExpiresWhen   int64  `protobuf:"varint,5,opt,name=expiresWhen,proto3" json:"expiresWhen,omitempty"`    // milliseconds since epoch, 0 if the lease is held until released
+}
+
+func (x *DetectorLease) Reset() {
+	*x = DetectorLease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectorLease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectorLease) ProtoMessage() {}
+
+func (x *DetectorLease) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectorLease.ProtoReflect.Descriptor instead.
+func (*DetectorLease) Descriptor() ([]byte, []int) {
+	return file_protos_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DetectorLease) GetDetector() string {
+	if x != nil {
+		return x.Detector
+	}
+	return ""
+}
+
+func (x *DetectorLease) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *DetectorLease) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *DetectorLease) GetAcquiredWhen() int64 {
+	if x != nil {
+		return x.AcquiredWhen
+	}
+	return 0
+}
+
+func (x *DetectorLease) GetExpiresWhen() int64 {
+	if x != nil {
+		return x.ExpiresWhen
+	}
+	return 0
+}
+
+type Ev_DetectorReservationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Change        DetectorReservationChange `protobuf:"varint,1,opt,name=change,proto3,enum=events.DetectorReservationChange" json:"change,omitempty"`
+	Leases        []*DetectorLease          `protobuf:"bytes,2,rep,name=leases,proto3" json:"leases,omitempty"` // leases acquired, released or expired, only for LEASE_* changes
+	EnvironmentId string                    `protobuf:"bytes,3,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	Detectors     []string                  `protobuf:"bytes,4,rep,name=detectors,proto3" json:"detectors,omitempty"` // detectors requested, only for REQUEST_* changes
+	Owner         string                    `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	QueueLength   int32                     `protobuf:"varint,6,opt,name=queueLength,proto3" json:"queueLength,omitempty"` // number of environment creation requests waiting for detectors after the change
+}
+
+func (x *Ev_DetectorReservationEvent) Reset() {
+	*x = Ev_DetectorReservationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_events_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ev_DetectorReservationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ev_DetectorReservationEvent) ProtoMessage() {}
+
+func (x *Ev_DetectorReservationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_events_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ev_DetectorReservationEvent.ProtoReflect.Descriptor instead.
+func (*Ev_DetectorReservationEvent) Descriptor() ([]byte, []int) {
+	return file_protos_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Ev_DetectorReservationEvent) GetChange() DetectorReservationChange {
+	if x != nil {
+		return x.Change
+	}
+	return DetectorReservationChange_LEASE_ACQUIRED
+}
+
+func (x *Ev_DetectorReservationEvent) GetLeases() []*DetectorLease {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+func (x *Ev_DetectorReservationEvent) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *Ev_DetectorReservationEvent) GetDetectors() []string {
+	if x != nil {
+		return x.Detectors
+	}
+	return nil
+}
+
+func (x *Ev_DetectorReservationEvent) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Ev_DetectorReservationEvent) GetQueueLength() int32 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+type Ev_RepositoryRefreshEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repository    string   `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`               // identifier of the refreshed workflow repository, empty if none matched the push
+	RepositoryUrl string   `protobuf:"bytes,2,opt,name=repositoryUrl,proto3" json:"repositoryUrl,omitempty"`         // repository URL as reported by the git server
+	Ref           string   `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`                             // pushed git reference, e.g. refs/heads/master
+	Commit        string   `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`                       // commit hash the reference points to after the push
+	Pusher        string   `protobuf:"bytes,5,opt,name=pusher,proto3" json:"pusher,omitempty"`                       // name of the user who pushed, as reported by the git server
+	Source        string   `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`                       // git server which sent the push webhook, github or gitlab
+	Status        OpStatus `protobuf:"varint,7,opt,name=status,proto3,enum=events.OpStatus" json:"status,omitempty"` // DONE_OK or DONE_ERROR
+	Error         string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *Ev_RepositoryRefreshEvent) Reset() {
+	*x = Ev_RepositoryRefreshEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_events_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ev_RepositoryRefreshEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ev_RepositoryRefreshEvent) ProtoMessage() {}
+
+func (x *Ev_RepositoryRefreshEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_events_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ev_RepositoryRefreshEvent.ProtoReflect.Descriptor instead.
+func (*Ev_RepositoryRefreshEvent) Descriptor() ([]byte, []int) {
+	return file_protos_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Ev_RepositoryRefreshEvent) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *Ev_RepositoryRefreshEvent) GetRepositoryUrl() string {
+	if x != nil {
+		return x.RepositoryUrl
+	}
+	return ""
+}
+
+func (x *Ev_RepositoryRefreshEvent) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Ev_RepositoryRefreshEvent) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *Ev_RepositoryRefreshEvent) GetPusher() string {
+	if x != nil {
+		return x.Pusher
+	}
+	return ""
+}
+
+func (x *Ev_RepositoryRefreshEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Ev_RepositoryRefreshEvent) GetStatus() OpStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OpStatus_NULL
+}
+
+func (x *Ev_RepositoryRefreshEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1003,6 +1427,8 @@ type Event struct {
 	//	*Event_CallEvent
 	//	*Event_IntegratedServiceEvent
 	//	*Event_RunEvent
+	//	*Event_DetectorReservationEvent
+	//	*Event_RepositoryRefreshEvent
 	//	*Event_FrameworkEvent
 	//	*Event_MesosHeartbeatEvent
 	//	*Event_CoreStartEvent
@@ -1013,7 +1439,7 @@ type Event struct {
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_events_proto_msgTypes[11]
+		mi := &file_protos_events_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1026,7 +1452,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_events_proto_msgTypes[11]
+	mi := &file_protos_events_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,7 +1465,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_protos_events_proto_rawDescGZIP(), []int{11}
+	return file_protos_events_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Event) GetTimestamp() int64 {
@@ -1105,6 +1531,20 @@ func (x *Event) GetRunEvent() *Ev_RunEvent {
 	return nil
 }
 
+func (x *Event) GetDetectorReservationEvent() *Ev_DetectorReservationEvent {
+	if x, ok := x.GetPayload().(*Event_DetectorReservationEvent); ok {
+		return x.DetectorReservationEvent
+	}
+	return nil
+}
+
+func (x *Event) GetRepositoryRefreshEvent() *Ev_RepositoryRefreshEvent {
+	if x, ok := x.GetPayload().(*Event_RepositoryRefreshEvent); ok {
+		return x.RepositoryRefreshEvent
+	}
+	return nil
+}
+
 func (x *Event) GetFrameworkEvent() *Ev_MetaEvent_FrameworkEvent {
 	if x, ok := x.GetPayload().(*Event_FrameworkEvent); ok {
 		return x.FrameworkEvent
@@ -1162,6 +1602,14 @@ type Event_RunEvent struct {
 	RunEvent *Ev_RunEvent `protobuf:"bytes,16,opt,name=runEvent,proto3,oneof"`
 }
 
+type Event_DetectorReservationEvent struct {
+	DetectorReservationEvent *Ev_DetectorReservationEvent `protobuf:"bytes,17,opt,name=detectorReservationEvent,proto3,oneof"`
+}
+
+type Event_RepositoryRefreshEvent struct {
+	RepositoryRefreshEvent *Ev_RepositoryRefreshEvent `protobuf:"bytes,18,opt,name=repositoryRefreshEvent,proto3,oneof"`
+}
+
 type Event_FrameworkEvent struct {
 	// Meta events produced by AliECS or its components
 	FrameworkEvent *Ev_MetaEvent_FrameworkEvent `protobuf:"bytes,101,opt,name=frameworkEvent,proto3,oneof"`
@@ -1192,6 +1640,10 @@ func (*Event_IntegratedServiceEvent) isEvent_Payload() {}
 
 func (*Event_RunEvent) isEvent_Payload() {}
 
+func (*Event_DetectorReservationEvent) isEvent_Payload() {}
+
+func (*Event_RepositoryRefreshEvent) isEvent_Payload() {}
+
 func (*Event_FrameworkEvent) isEvent_Payload() {}
 
 func (*Event_MesosHeartbeatEvent) isEvent_Payload() {}
@@ -1321,7 +1773,7 @@ var file_protos_events_proto_rawDesc = []byte{
 	0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
 	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x99, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xc1, 0x03,
 	0x0a, 0x0b, 0x45, 0x76, 0x5f, 0x52, 0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a,
 	0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
@@ -1339,75 +1791,155 @@ var file_protos_events_proto_rawDesc = []byte{
 	0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73,
 	0x65, 0x72, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0x5e, 0x0a, 0x10, 0x45, 0x76, 0x5f,
-	0x42, 0x65, 0x61, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x62,
-	0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x08, 0x62, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x9b, 0x06, 0x0a, 0x05, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x61,
-	0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x49, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72,
-	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45,
-	0x76, 0x5f, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x74,
-	0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34,
-	0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x43, 0x61,
-	0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
-	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76,
-	0x5f, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f,
-	0x52, 0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0e, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72,
-	0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65,
+	0x73, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x4a, 0x04, 0x08, 0x07, 0x10,
+	0x08, 0x22, 0x5e, 0x0a, 0x10, 0x45, 0x76, 0x5f, 0x42, 0x65, 0x61, 0x6d, 0x4d, 0x6f, 0x64, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x62, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x42,
+	0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x62, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xad, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x57, 0x68, 0x65, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x57, 0x68, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x57, 0x68, 0x65,
+	0x6e, 0x22, 0x83, 0x02, 0x0a, 0x1b, 0x45, 0x76, 0x5f, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xfb, 0x01, 0x0a, 0x19, 0x45, 0x76, 0x5f, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4f,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdb, 0x07, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a,
+	0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e,
+	0x61, 0x6e, 0x6f, 0x12, 0x49, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34,
+	0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x54, 0x61,
+	0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x45, 0x76, 0x5f, 0x52, 0x6f, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x09, 0x72, 0x6f, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x63, 0x61,
+	0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x5b, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a,
+	0x08, 0x72, 0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x52, 0x75, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x61, 0x0a, 0x18, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x18, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x4d, 0x0a, 0x0e, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x45, 0x76, 0x5f, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x0e, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x57, 0x0a, 0x13, 0x6d, 0x65, 0x73, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65,
 	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x13, 0x6d, 0x65, 0x73, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x4d, 0x65, 0x74,
-	0x61, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x4d, 0x65, 0x73, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x65, 0x73, 0x6f, 0x73, 0x48, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0e,
-	0x63, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x67,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76,
-	0x5f, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x43, 0x6f, 0x72, 0x65, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x6d, 0x4d, 0x6f,
-	0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x42, 0x65, 0x61, 0x6d, 0x4d,
-	0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x6d,
-	0x4d, 0x6f, 0x64, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x0b, 0x4a, 0x04, 0x08, 0x11, 0x10, 0x65,
-	0x4a, 0x05, 0x08, 0x68, 0x10, 0xc8, 0x01, 0x2a, 0x5d, 0x0a, 0x08, 0x4f, 0x70, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e,
-	0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x4f, 0x4e, 0x45, 0x5f,
-	0x4f, 0x4b, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x4f, 0x4e, 0x45, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x4f, 0x4e, 0x45, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x42, 0x53, 0x0a, 0x1f, 0x63, 0x68, 0x2e, 0x63, 0x65, 0x72,
-	0x6e, 0x2e, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6c, 0x69, 0x63, 0x65, 0x4f, 0x32, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x2f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x70, 0x62, 0x50, 0x00, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x5f, 0x4d, 0x65, 0x73, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x65, 0x73, 0x6f, 0x73, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x4d, 0x65, 0x74,
+	0x61, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x43, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x5f, 0x42, 0x65, 0x61, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x6d, 0x4d, 0x6f, 0x64, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x0b, 0x4a, 0x04, 0x08, 0x13, 0x10, 0x65, 0x4a, 0x05, 0x08, 0x68,
+	0x10, 0xc8, 0x01, 0x2a, 0x5d, 0x0a, 0x08, 0x4f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x47, 0x4f, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x4f, 0x4e, 0x45, 0x5f, 0x4f, 0x4b, 0x10, 0x03,
+	0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x4f, 0x4e, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04,
+	0x12, 0x10, 0x0a, 0x0c, 0x44, 0x4f, 0x4e, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
+	0x10, 0x05, 0x2a, 0x5b, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x55, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x55, 0x4e, 0x5f, 0x4e, 0x55,
+	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x2a,
+	0x80, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41,
+	0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44,
+	0x10, 0x04, 0x42, 0x53, 0x0a, 0x1f, 0x63, 0x68, 0x2e, 0x63, 0x65, 0x72, 0x6e, 0x2e, 0x61, 0x6c,
+	0x69, 0x63, 0x65, 0x2e, 0x6f, 0x32, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x41, 0x6c, 0x69, 0x63, 0x65, 0x4f, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x70, 0x62, 0x50, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1422,55 +1954,66 @@ func file_protos_events_proto_rawDescGZIP() []byte {
 	return file_protos_events_proto_rawDescData
 }
 
-var file_protos_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protos_events_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_protos_events_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_protos_events_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_protos_events_proto_goTypes = []interface{}{
 	(OpStatus)(0),                       // 0: events.OpStatus
-	(*Ev_MetaEvent_MesosHeartbeat)(nil), // 1: events.Ev_MetaEvent_MesosHeartbeat
-	(*Ev_MetaEvent_CoreStart)(nil),      // 2: events.Ev_MetaEvent_CoreStart
-	(*Ev_MetaEvent_FrameworkEvent)(nil), // 3: events.Ev_MetaEvent_FrameworkEvent
-	(*Ev_EnvironmentEvent)(nil),         // 4: events.Ev_EnvironmentEvent
-	(*Traits)(nil),                      // 5: events.Traits
-	(*Ev_TaskEvent)(nil),                // 6: events.Ev_TaskEvent
-	(*Ev_CallEvent)(nil),                // 7: events.Ev_CallEvent
-	(*Ev_RoleEvent)(nil),                // 8: events.Ev_RoleEvent
-	(*Ev_IntegratedServiceEvent)(nil),   // 9: events.Ev_IntegratedServiceEvent
-	(*Ev_RunEvent)(nil),                 // 10: events.Ev_RunEvent
-	(*Ev_BeamModeEvent)(nil),            // 11: events.Ev_BeamModeEvent
-	(*Event)(nil),                       // 12: events.Event
-	nil,                                 // 13: events.Ev_EnvironmentEvent.VarsEntry
-	(*User)(nil),                        // 14: common.User
-	(*WorkflowTemplateInfo)(nil),        // 15: common.WorkflowTemplateInfo
-	(*BeamInfo)(nil),                    // 16: common.BeamInfo
+	(RunEventKind)(0),                   // 1: events.RunEventKind
+	(DetectorReservationChange)(0),      // 2: events.DetectorReservationChange
+	(*Ev_MetaEvent_MesosHeartbeat)(nil), // 3: events.Ev_MetaEvent_MesosHeartbeat
+	(*Ev_MetaEvent_CoreStart)(nil),      // 4: events.Ev_MetaEvent_CoreStart
+	(*Ev_MetaEvent_FrameworkEvent)(nil), // 5: events.Ev_MetaEvent_FrameworkEvent
+	(*Ev_EnvironmentEvent)(nil),         // 6: events.Ev_EnvironmentEvent
+	(*Traits)(nil),                      // 7: events.Traits
+	(*Ev_TaskEvent)(nil),                // 8: events.Ev_TaskEvent
+	(*Ev_CallEvent)(nil),                // 9: events.Ev_CallEvent
+	(*Ev_RoleEvent)(nil),                // 10: events.Ev_RoleEvent
+	(*Ev_IntegratedServiceEvent)(nil),   // 11: events.Ev_IntegratedServiceEvent
+	(*Ev_RunEvent)(nil),                 // 12: events.Ev_RunEvent
+	(*Ev_BeamModeEvent)(nil),            // 13: events.Ev_BeamModeEvent
+	(*DetectorLease)(nil),               // 14: events.DetectorLease
+	(*Ev_DetectorReservationEvent)(nil), // 15: events.Ev_DetectorReservationEvent
+	(*Ev_RepositoryRefreshEvent)(nil),   // 16: events.Ev_RepositoryRefreshEvent
+	(*Event)(nil),                       // 17: events.Event
+	nil,                                 // 18: events.Ev_EnvironmentEvent.VarsEntry
+	(*User)(nil),                        // 19: common.User
+	(*WorkflowTemplateInfo)(nil),        // 20: common.WorkflowTemplateInfo
+	(*BeamInfo)(nil),                    // 21: common.BeamInfo
 }
 var file_protos_events_proto_depIdxs = []int32{
 	0,  // 0: events.Ev_EnvironmentEvent.transitionStatus:type_name -> events.OpStatus
-	13, // 1: events.Ev_EnvironmentEvent.vars:type_name -> events.Ev_EnvironmentEvent.VarsEntry
-	14, // 2: events.Ev_EnvironmentEvent.lastRequestUser:type_name -> common.User
-	15, // 3: events.Ev_EnvironmentEvent.workflowTemplateInfo:type_name -> common.WorkflowTemplateInfo
-	5,  // 4: events.Ev_TaskEvent.traits:type_name -> events.Traits
+	18, // 1: events.Ev_EnvironmentEvent.vars:type_name -> events.Ev_EnvironmentEvent.VarsEntry
+	19, // 2: events.Ev_EnvironmentEvent.lastRequestUser:type_name -> common.User
+	20, // 3: events.Ev_EnvironmentEvent.workflowTemplateInfo:type_name -> common.WorkflowTemplateInfo
+	7,  // 4: events.Ev_TaskEvent.traits:type_name -> events.Traits
 	0,  // 5: events.Ev_CallEvent.callStatus:type_name -> events.OpStatus
-	5,  // 6: events.Ev_CallEvent.traits:type_name -> events.Traits
+	7,  // 6: events.Ev_CallEvent.traits:type_name -> events.Traits
 	0,  // 7: events.Ev_IntegratedServiceEvent.operationStatus:type_name -> events.OpStatus
 	0,  // 8: events.Ev_IntegratedServiceEvent.operationStepStatus:type_name -> events.OpStatus
 	0,  // 9: events.Ev_RunEvent.transitionStatus:type_name -> events.OpStatus
-	14, // 10: events.Ev_RunEvent.lastRequestUser:type_name -> common.User
-	16, // 11: events.Ev_BeamModeEvent.beamInfo:type_name -> common.BeamInfo
-	4,  // 12: events.Event.environmentEvent:type_name -> events.Ev_EnvironmentEvent
-	6,  // 13: events.Event.taskEvent:type_name -> events.Ev_TaskEvent
-	8,  // 14: events.Event.roleEvent:type_name -> events.Ev_RoleEvent
-	7,  // 15: events.Event.callEvent:type_name -> events.Ev_CallEvent
-	9,  // 16: events.Event.integratedServiceEvent:type_name -> events.Ev_IntegratedServiceEvent
-	10, // 17: events.Event.runEvent:type_name -> events.Ev_RunEvent
-	3,  // 18: events.Event.frameworkEvent:type_name -> events.Ev_MetaEvent_FrameworkEvent
-	1,  // 19: events.Event.mesosHeartbeatEvent:type_name -> events.Ev_MetaEvent_MesosHeartbeat
-	2,  // 20: events.Event.coreStartEvent:type_name -> events.Ev_MetaEvent_CoreStart
-	11, // 21: events.Event.beamModeEvent:type_name -> events.Ev_BeamModeEvent
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	19, // 10: events.Ev_RunEvent.lastRequestUser:type_name -> common.User
+	1,  // 11: events.Ev_RunEvent.kind:type_name -> events.RunEventKind
+	21, // 12: events.Ev_BeamModeEvent.beamInfo:type_name -> common.BeamInfo
+	2,  // 13: events.Ev_DetectorReservationEvent.change:type_name -> events.DetectorReservationChange
+	14, // 14: events.Ev_DetectorReservationEvent.leases:type_name -> events.DetectorLease
+	0,  // 15: events.Ev_RepositoryRefreshEvent.status:type_name -> events.OpStatus
+	6,  // 16: events.Event.environmentEvent:type_name -> events.Ev_EnvironmentEvent
+	8,  // 17: events.Event.taskEvent:type_name -> events.Ev_TaskEvent
+	10, // 18: events.Event.roleEvent:type_name -> events.Ev_RoleEvent
+	9,  // 19: events.Event.callEvent:type_name -> events.Ev_CallEvent
+	11, // 20: events.Event.integratedServiceEvent:type_name -> events.Ev_IntegratedServiceEvent
+	12, // 21: events.Event.runEvent:type_name -> events.Ev_RunEvent
+	15, // 22: events.Event.detectorReservationEvent:type_name -> events.Ev_DetectorReservationEvent
+	16, // 23: events.Event.repositoryRefreshEvent:type_name -> events.Ev_RepositoryRefreshEvent
+	5,  // 24: events.Event.frameworkEvent:type_name -> events.Ev_MetaEvent_FrameworkEvent
+	3,  // 25: events.Event.mesosHeartbeatEvent:type_name -> events.Ev_MetaEvent_MesosHeartbeat
+	4,  // 26: events.Event.coreStartEvent:type_name -> events.Ev_MetaEvent_CoreStart
+	13, // 27: events.Event.beamModeEvent:type_name -> events.Ev_BeamModeEvent
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_protos_events_proto_init() }
@@ -1613,6 +2156,42 @@ func file_protos_events_proto_init() {
 			}
 		}
 		file_protos_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectorLease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ev_DetectorReservationEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ev_RepositoryRefreshEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
@@ -1625,13 +2204,15 @@ func file_protos_events_proto_init() {
 			}
 		}
 	}
-	file_protos_events_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_protos_events_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*Event_EnvironmentEvent)(nil),
 		(*Event_TaskEvent)(nil),
 		(*Event_RoleEvent)(nil),
 		(*Event_CallEvent)(nil),
 		(*Event_IntegratedServiceEvent)(nil),
 		(*Event_RunEvent)(nil),
+		(*Event_DetectorReservationEvent)(nil),
+		(*Event_RepositoryRefreshEvent)(nil),
 		(*Event_FrameworkEvent)(nil),
 		(*Event_MesosHeartbeatEvent)(nil),
 		(*Event_CoreStartEvent)(nil),
@@ -1642,8 +2223,8 @@ func file_protos_events_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_events_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -25,8 +25,10 @@
 package cfgbackend
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -158,14 +160,65 @@ func (cc *ConsulSource) Put(key string, value string) (err error) {
 	return
 }
 
-func (cc *ConsulSource) PutRecursive(string, Item) error {
-	// FIXME
-	panic("implement me")
+// PutRecursive replaces the subtree at key with value, through Consul transactions.
+// Keys under key which are not in value are deleted, and keys whose value is
+// unchanged are not written.
+// A subtree which fits in a single transaction is replaced atomically. Larger
+// subtrees are split across several transactions, with all writes before all
+// deletions, so that readers never see a key missing from either version.
+func (cc *ConsulSource) PutRecursive(key string, value Item) (err error) {
+	if value == nil {
+		return errors.New("cannot put nil item")
+	}
+	requestKey := strings.TrimSuffix(formatKey(key), "/")
+	if len(requestKey) == 0 {
+		return errors.New("cannot put recursively at the root key")
+	}
+
+	existing, _, err := cc.kv.List(requestKey, &api.QueryOptions{RequireConsistent: true})
+	if err != nil {
+		return
+	}
+
+	txns, err := planRecursivePut(requestKey, value, existing)
+	if err != nil {
+		return
+	}
+	for _, txn := range txns {
+		ok, response, _, txnErr := cc.kv.Txn(txn, nil)
+		if txnErr != nil {
+			return txnErr
+		}
+		if !ok {
+			msgs := make([]string, 0)
+			if response != nil {
+				for _, e := range response.Errors {
+					msgs = append(msgs, fmt.Sprintf("op %d: %s", e.OpIndex, e.What))
+				}
+			}
+			return fmt.Errorf("consul transaction rolled back for key %s: %s", requestKey, strings.Join(msgs, "; "))
+		}
+	}
+	return
 }
 
-func (cc *ConsulSource) PutRecursiveYaml(string, []byte) error {
-	// FIXME
-	panic("implement me")
+func (cc *ConsulSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var (
+		raw    interface{}
+		cooked Item
+	)
+	err = yaml.Unmarshal(value, &raw)
+	if err != nil {
+		return
+	}
+
+	cooked, err = intfToItem(raw)
+	if err != nil {
+		return
+	}
+
+	err = cc.PutRecursive(key, cooked)
+	return
 }
 
 func (cc *ConsulSource) Exists(key string) (exists bool, err error) {
@@ -189,6 +242,123 @@ func (cc *ConsulSource) IsDir(key string) (isDir bool) {
 	return
 }
 
+// Consul rejects transactions with more than 64 operations or a request body
+// larger than 512kB (txn_max_req_len), we stay below both. The request body
+// carries every value base64-encoded, so we budget the encoded size of each
+// operation, plus its key and a generous allowance for the JSON around them.
+const (
+	consulTxnMaxOps     = 64
+	consulTxnMaxBytes   = 480 * 1024
+	consulTxnOpOverhead = 128
+)
+
+// txnOpBytes is the share of a transaction request body taken by op.
+func txnOpBytes(op *api.KVTxnOp) int {
+	return base64.StdEncoding.EncodedLen(len(op.Value)) + len(op.Key) + consulTxnOpOverhead
+}
+
+// planRecursivePut returns the transactions which turn the existing KV pairs
+// under requestKey into the flattened value. Writes come before deletions.
+func planRecursivePut(requestKey string, value Item, existing api.KVPairs) (txns []api.KVTxnOps, err error) {
+	wanted := make(map[string][]byte)
+	if err = flattenItem(requestKey, value, wanted); err != nil {
+		return
+	}
+
+	current := make(map[string][]byte)
+	for _, kvp := range existing {
+		// kv.List matches by string prefix, so we skip e.g. "foobar" when putting "foo"
+		if kvp.Key != requestKey && !strings.HasPrefix(kvp.Key, requestKey+"/") {
+			continue
+		}
+		current[kvp.Key] = kvp.Value
+	}
+
+	sets := make(api.KVTxnOps, 0)
+	for _, k := range sortedKeys(wanted) {
+		if v, ok := current[k]; ok && string(v) == string(wanted[k]) {
+			continue
+		}
+		sets = append(sets, &api.KVTxnOp{Verb: api.KVSet, Key: k, Value: wanted[k]})
+	}
+	deletes := make(api.KVTxnOps, 0)
+	for _, k := range sortedKeys(current) {
+		if _, ok := wanted[k]; !ok {
+			deletes = append(deletes, &api.KVTxnOp{Verb: api.KVDelete, Key: k})
+		}
+	}
+
+	txns = make([]api.KVTxnOps, 0)
+	txn := make(api.KVTxnOps, 0)
+	txnBytes := 0
+	for _, op := range append(sets, deletes...) {
+		opBytes := txnOpBytes(op)
+		if opBytes > consulTxnMaxBytes {
+			return nil, fmt.Errorf("value at key %s is too large for a consul transaction (%d bytes)", op.Key, len(op.Value))
+		}
+		if len(txn) == consulTxnMaxOps || txnBytes+opBytes > consulTxnMaxBytes {
+			txns = append(txns, txn)
+			txn = make(api.KVTxnOps, 0)
+			txnBytes = 0
+		}
+		txn = append(txn, op)
+		txnBytes += opBytes
+	}
+	if len(txn) > 0 {
+		txns = append(txns, txn)
+	}
+	return
+}
+
+// flattenItem converts item into Consul keys under prefix. Array elements are
+// stored under their index, as read back by mapify, and empty maps and arrays
+// become folder keys.
+func flattenItem(prefix string, item Item, out map[string][]byte) error {
+	switch item.Type() {
+	case IT_Value:
+		out[prefix] = []byte(item.Value())
+	case IT_Map:
+		m := item.Map()
+		if len(m) == 0 {
+			out[prefix+"/"] = nil
+		}
+		for k, v := range m {
+			if len(k) == 0 || strings.Contains(k, "/") {
+				return fmt.Errorf("invalid key %q under %s", k, prefix)
+			}
+			if v == nil {
+				return fmt.Errorf("nil item at key %s/%s", prefix, k)
+			}
+			if err := flattenItem(prefix+"/"+k, v, out); err != nil {
+				return err
+			}
+		}
+	case IT_Array:
+		a := item.Array()
+		if len(a) == 0 {
+			out[prefix+"/"] = nil
+		}
+		for i, v := range a {
+			if v == nil {
+				return fmt.Errorf("nil item at key %s/%d", prefix, i)
+			}
+			if err := flattenItem(prefix+"/"+strconv.Itoa(i), v, out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func formatKey(key string) (consulKey string) {
 	// Trim leading slashes
 	consulKey = strings.TrimLeft(key, "/")
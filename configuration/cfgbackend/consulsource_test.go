@@ -0,0 +1,118 @@
+package cfgbackend
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func opsByVerb(txns []api.KVTxnOps) (sets map[string]string, deletes []string) {
+	sets = make(map[string]string)
+	deletes = make([]string, 0)
+	for _, txn := range txns {
+		for _, op := range txn {
+			switch op.Verb {
+			case api.KVSet:
+				sets[op.Key] = string(op.Value)
+			case api.KVDelete:
+				deletes = append(deletes, op.Key)
+			}
+		}
+	}
+	return
+}
+
+var _ = Describe("Consul recursive put planning", func() {
+	tree := Map{
+		"firstKey": String("one"),
+		"secondKey": Array{
+			Map{"name": String("first")},
+			Map{"name": String("second")},
+		},
+		"emptyMap": Map{},
+	}
+
+	It("flattens maps and arrays into consul keys", func() {
+		txns, err := planRecursivePut("o2/test", tree, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(txns).To(HaveLen(1))
+
+		sets, deletes := opsByVerb(txns)
+		Expect(deletes).To(BeEmpty())
+		Expect(sets).To(Equal(map[string]string{
+			"o2/test/firstKey":         "one",
+			"o2/test/secondKey/0/name": "first",
+			"o2/test/secondKey/1/name": "second",
+			"o2/test/emptyMap/":        "",
+		}))
+	})
+
+	It("reads back what it flattens", func() {
+		flat := make(map[string][]byte)
+		Expect(flattenItem("o2/test", tree, flat)).To(Succeed())
+		kvps := make(api.KVPairs, 0)
+		for k, v := range flat {
+			kvps = append(kvps, &api.KVPair{Key: stripRequestKey("o2/test", k), Value: v})
+		}
+		readBack := mapify(kvps)
+		Expect(readBack["firstKey"]).To(Equal(String("one")))
+		Expect(readBack["secondKey"].Map()["1"].Map()["name"]).To(Equal(String("second")))
+		Expect(readBack["emptyMap"]).To(Equal(Map{}))
+	})
+
+	It("deletes keys which disappear and skips unchanged ones, writing before deleting", func() {
+		existing := api.KVPairs{
+			{Key: "o2/test/firstKey", Value: []byte("one")},
+			{Key: "o2/test/secondKey/0/name", Value: []byte("old")},
+			{Key: "o2/test/stale", Value: []byte("x")},
+			{Key: "o2/testing/unrelated", Value: []byte("y")},
+		}
+		txns, err := planRecursivePut("o2/test", tree, existing)
+		Expect(err).NotTo(HaveOccurred())
+
+		sets, deletes := opsByVerb(txns)
+		Expect(sets).NotTo(HaveKey("o2/test/firstKey"))
+		Expect(sets).To(HaveKeyWithValue("o2/test/secondKey/0/name", "first"))
+		Expect(deletes).To(Equal([]string{"o2/test/stale"}))
+
+		last := txns[len(txns)-1]
+		Expect(last[len(last)-1].Verb).To(Equal(api.KVDelete))
+	})
+
+	It("splits large trees into several transactions", func() {
+		big := make(Map)
+		for i := 0; i < 2*consulTxnMaxOps+1; i++ {
+			big[fmt.Sprintf("key%03d", i)] = String("v")
+		}
+		txns, err := planRecursivePut("o2/test", big, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(txns).To(HaveLen(3))
+		for _, txn := range txns {
+			Expect(len(txn)).To(BeNumerically("<=", consulTxnMaxOps))
+		}
+	})
+
+	It("budgets values by their base64-encoded size", func() {
+		// The largest value which fits a transaction once encoded, minus its key and overhead
+		nearLimit := strings.Repeat("x", (consulTxnMaxBytes-consulTxnOpOverhead-len("o2/test/a"))/4*3)
+		txns, err := planRecursivePut("o2/test", Map{"a": String(nearLimit), "b": String(nearLimit)}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(txns).To(HaveLen(2))
+		for _, txn := range txns {
+			Expect(txn).To(HaveLen(1))
+			Expect(txnOpBytes(txn[0])).To(BeNumerically("<=", consulTxnMaxBytes))
+		}
+
+		// A raw size below the limit may still exceed it once encoded
+		_, err = planRecursivePut("o2/test", Map{"a": String(strings.Repeat("x", 370*1024))}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects keys containing separators", func() {
+		_, err := planRecursivePut("o2/test", Map{"a/b": String("c")}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
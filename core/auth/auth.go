@@ -0,0 +1,219 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package auth implements authentication of callers of the Control gRPC
+// API, either through TLS client certificates or bearer tokens, as well as
+// a per-detector authorization policy for environment operations.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+var log = logger.New(logrus.StandardLogger(), "auth")
+
+type Method string
+
+const (
+	METHOD_NONE        Method = "none"
+	METHOD_TOKEN       Method = "token"
+	METHOD_CERTIFICATE Method = "certificate"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+	healthServicePrefix = "/grpc.health.v1.Health/"
+)
+
+// Identity is the caller of an RPC as established by the Authenticator.
+type Identity struct {
+	Name   string
+	Method Method
+}
+
+func (i Identity) IsVerified() bool {
+	return i.Method != METHOD_NONE && len(i.Name) != 0
+}
+
+type identityKey struct{}
+
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the Identity attached to ctx by the Authenticator interceptors.
+func FromContext(ctx context.Context) (id Identity, ok bool) {
+	if ctx == nil {
+		return Identity{Method: METHOD_NONE}, false
+	}
+	id, ok = ctx.Value(identityKey{}).(Identity)
+	if !ok {
+		id = Identity{Method: METHOD_NONE}
+	}
+	return
+}
+
+type tokensFile struct {
+	Tokens map[string]string `yaml:"tokens"` // user name -> token
+}
+
+// Authenticator establishes the Identity of the caller of each RPC.
+// A verified TLS client certificate takes precedence over a bearer token.
+// If required is false, calls without credentials go through anonymously,
+// but calls with invalid credentials are still rejected.
+type Authenticator struct {
+	tokens   map[string]string // token -> user name
+	required bool
+}
+
+// NewAuthenticator builds an Authenticator. tokensPath is optional, and if set
+// it must point to a YAML file of the form
+//
+//	tokens:
+//	  someuser: sometoken
+func NewAuthenticator(tokensPath string, required bool) (*Authenticator, error) {
+	a := &Authenticator{
+		tokens:   make(map[string]string),
+		required: required,
+	}
+	if len(tokensPath) == 0 {
+		return a, nil
+	}
+
+	data, err := os.ReadFile(tokensPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read auth tokens file: %w", err)
+	}
+	tf := tokensFile{}
+	if err = yaml.Unmarshal(data, &tf); err != nil {
+		return nil, fmt.Errorf("cannot parse auth tokens file %s: %w", tokensPath, err)
+	}
+	for user, token := range tf.Tokens {
+		if len(user) == 0 || len(token) == 0 {
+			return nil, fmt.Errorf("auth tokens file %s contains an empty user or token", tokensPath)
+		}
+		if other, exists := a.tokens[token]; exists {
+			return nil, fmt.Errorf("auth tokens file %s: users %s and %s share the same token", tokensPath, other, user)
+		}
+		a.tokens[token] = user
+	}
+	return a, nil
+}
+
+// Authenticate returns the Identity of the caller of the RPC whose context is ctx.
+// The returned error is a gRPC status error with code Unauthenticated.
+func (a *Authenticator) Authenticate(ctx context.Context) (Identity, error) {
+	if p, ok := peer.FromContext(ctx); ok && p.AuthInfo != nil {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			for _, chain := range tlsInfo.State.VerifiedChains {
+				if len(chain) > 0 && len(chain[0].Subject.CommonName) > 0 {
+					return Identity{Name: chain[0].Subject.CommonName, Method: METHOD_CERTIFICATE}, nil
+				}
+			}
+		}
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(authorizationHeader) {
+			if len(value) < len(bearerPrefix) || !strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+				continue
+			}
+			if user, ok := a.lookupToken(strings.TrimSpace(value[len(bearerPrefix):])); ok {
+				return Identity{Name: user, Method: METHOD_TOKEN}, nil
+			}
+			return Identity{Method: METHOD_NONE}, status.Error(codes.Unauthenticated, "invalid bearer token")
+		}
+	}
+
+	if a.required {
+		return Identity{Method: METHOD_NONE}, status.Error(codes.Unauthenticated, "credentials required")
+	}
+	return Identity{Method: METHOD_NONE}, nil
+}
+
+// lookupToken compares the given token against every known token, so that the
+// time it takes does not depend on how much of the token matches.
+func (a *Authenticator) lookupToken(token string) (user string, ok bool) {
+	for known, knownUser := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(known), []byte(token)) == 1 {
+			user, ok = knownUser, true
+		}
+	}
+	return
+}
+
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+		id, err := a.Authenticate(ctx)
+		if err != nil {
+			log.WithField("method", info.FullMethod).
+				WithError(err).
+				Warn("rejected unauthenticated request")
+			return nil, err
+		}
+		return handler(NewContext(ctx, id), req)
+	}
+}
+
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(srv, ss)
+		}
+		id, err := a.Authenticate(ss.Context())
+		if err != nil {
+			log.WithField("method", info.FullMethod).
+				WithError(err).
+				Warn("rejected unauthenticated request")
+			return err
+		}
+		return handler(srv, &identityServerStream{ServerStream: ss, ctx: NewContext(ss.Context(), id)})
+	}
+}
+
+type identityServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityServerStream) Context() context.Context {
+	return s.ctx
+}
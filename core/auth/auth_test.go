@@ -0,0 +1,115 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func writeTokens(content string) string {
+	path := filepath.Join(GinkgoT().TempDir(), "tokens.yaml")
+	Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	return path
+}
+
+func withBearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+var _ = Describe("Authenticator", func() {
+	tokens := "tokens:\n  alice: t0k3n-a\n  bob: t0k3n-b\n"
+
+	It("authenticates callers by bearer token", func() {
+		a, err := NewAuthenticator(writeTokens(tokens), true)
+		Expect(err).NotTo(HaveOccurred())
+
+		id, err := a.Authenticate(withBearer("t0k3n-b"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(Equal(Identity{Name: "bob", Method: METHOD_TOKEN}))
+		Expect(id.IsVerified()).To(BeTrue())
+	})
+
+	It("rejects unknown tokens even when authentication is optional", func() {
+		a, err := NewAuthenticator(writeTokens(tokens), false)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = a.Authenticate(withBearer("wrong"))
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	})
+
+	It("lets anonymous callers through only when authentication is optional", func() {
+		optional, err := NewAuthenticator("", false)
+		Expect(err).NotTo(HaveOccurred())
+		id, err := optional.Authenticate(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id.IsVerified()).To(BeFalse())
+
+		required, err := NewAuthenticator("", true)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = required.Authenticate(context.Background())
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	})
+
+	It("prefers a verified client certificate over a token", func() {
+		a, err := NewAuthenticator(writeTokens(tokens), true)
+		Expect(err).NotTo(HaveOccurred())
+
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: "carol"}}
+		ctx := peer.NewContext(withBearer("t0k3n-a"), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+		})
+		id, err := a.Authenticate(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(Equal(Identity{Name: "carol", Method: METHOD_CERTIFICATE}))
+	})
+
+	It("refuses tokens files where users share a token", func() {
+		_, err := NewAuthenticator(writeTokens("tokens:\n  alice: same\n  bob: same\n"), true)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("round-trips identities through the context", func() {
+		ctx := NewContext(context.Background(), Identity{Name: "alice", Method: METHOD_TOKEN})
+		id, ok := FromContext(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(id.Name).To(Equal("alice"))
+
+		id, ok = FromContext(context.Background())
+		Expect(ok).To(BeFalse())
+		Expect(id.IsVerified()).To(BeFalse())
+	})
+})
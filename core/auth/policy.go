@@ -0,0 +1,113 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const ANY_USER = "*"
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// Policy restricts which users may control or destroy environments which
+// include a given detector. Detectors not listed in the policy are not
+// restricted, and admins may operate on any environment.
+//
+//	admins: [someadmin]
+//	detectors:
+//	  TPC: [tpcuser1, tpcuser2]
+//	  ITS: ["*"]   # any authenticated user
+type Policy struct {
+	Admins    []string            `yaml:"admins"`
+	Detectors map[string][]string `yaml:"detectors"`
+}
+
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read auth policy file: %w", err)
+	}
+	p := &Policy{}
+	if err = yaml.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("cannot parse auth policy file %s: %w", path, err)
+	}
+	// Detector names are case insensitive
+	detectors := make(map[string][]string, len(p.Detectors))
+	for det, users := range p.Detectors {
+		detectors[strings.ToUpper(det)] = users
+	}
+	p.Detectors = detectors
+	return p, nil
+}
+
+// Authorize returns nil if id may operate on an environment which includes
+// the given detectors, otherwise an error wrapping ErrPermissionDenied.
+// A nil Policy authorizes everything.
+func (p *Policy) Authorize(id Identity, detectors []string) error {
+	if p == nil {
+		return nil
+	}
+	if id.IsVerified() && contains(p.Admins, id.Name) {
+		return nil
+	}
+
+	denied := make([]string, 0)
+	for _, det := range detectors {
+		allowed, restricted := p.Detectors[strings.ToUpper(det)]
+		if !restricted {
+			continue
+		}
+		if !id.IsVerified() {
+			denied = append(denied, det)
+			continue
+		}
+		if !contains(allowed, id.Name) && !contains(allowed, ANY_USER) {
+			denied = append(denied, det)
+		}
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+
+	who := "anonymous user"
+	if id.IsVerified() {
+		who = fmt.Sprintf("user %s", id.Name)
+	}
+	return fmt.Errorf("%w: %s may not operate on detectors %s", ErrPermissionDenied, who, strings.Join(denied, ", "))
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
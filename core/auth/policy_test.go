@@ -0,0 +1,79 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package auth
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	var policy *Policy
+
+	BeforeEach(func() {
+		path := filepath.Join(GinkgoT().TempDir(), "policy.yaml")
+		Expect(os.WriteFile(path, []byte(`
+admins: [root]
+detectors:
+  tpc: [alice]
+  ITS: ["*"]
+`), 0o600)).To(Succeed())
+		var err error
+		policy, err = LoadPolicy(path)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	alice := Identity{Name: "alice", Method: METHOD_TOKEN}
+	bob := Identity{Name: "bob", Method: METHOD_CERTIFICATE}
+	root := Identity{Name: "root", Method: METHOD_TOKEN}
+	anonymous := Identity{Method: METHOD_NONE}
+
+	It("allows listed users on restricted detectors", func() {
+		Expect(policy.Authorize(alice, []string{"TPC", "ITS"})).To(Succeed())
+	})
+
+	It("denies other users on restricted detectors", func() {
+		err := policy.Authorize(bob, []string{"TPC", "ITS"})
+		Expect(err).To(MatchError(ErrPermissionDenied))
+		Expect(err.Error()).To(ContainSubstring("TPC"))
+		Expect(err.Error()).NotTo(ContainSubstring("ITS"))
+	})
+
+	It("denies anonymous users on restricted detectors only", func() {
+		Expect(policy.Authorize(anonymous, []string{"ITS"})).To(MatchError(ErrPermissionDenied))
+		Expect(policy.Authorize(anonymous, []string{"MFT"})).To(Succeed())
+	})
+
+	It("allows admins everywhere", func() {
+		Expect(policy.Authorize(root, []string{"TPC", "ITS", "MFT"})).To(Succeed())
+	})
+
+	It("allows everything when there is no policy", func() {
+		var none *Policy
+		Expect(none.Authorize(anonymous, []string{"TPC"})).To(Succeed())
+	})
+})
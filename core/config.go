@@ -93,6 +93,8 @@ func setDefaults() error {
 	viper.SetDefault("metrics.port", getenvInt("PORT0", "64009"))
 	viper.SetDefault("metrics.path", getenv("METRICS_API_PATH", "/metrics"))
 	viper.SetDefault("reposSshKey", "")
+	viper.SetDefault("reposWebhookEndpoint", "")
+	viper.SetDefault("reposWebhookSecretFile", "")
 	viper.SetDefault("summaryMetrics", false)
 	viper.SetDefault("verbose", false)
 	viper.SetDefault("veryVerbose", false)
@@ -114,6 +116,14 @@ func setDefaults() error {
 	viper.SetDefault("odcUseSystemProxy", false)
 	viper.SetDefault("testPluginEndpoint", "//127.0.0.1:00000")
 	viper.SetDefault("integrationPlugins", []string{})
+	viper.SetDefault("declarativePlugins", []string{})
+	viper.SetDefault("integrationBreakerThreshold", 3)
+	viper.SetDefault("bulkOperationConcurrency", 4)
+	viper.SetDefault("bulkOperationMaxConcurrency", 16)
+	viper.SetDefault("roleEventDebounce", "100ms")
+	viper.SetDefault("detectorWaitTimeout", "30m")
+	viper.SetDefault("detectorReservationMaxTtl", "12h")
+	viper.SetDefault("integrationBreakerCooldown", "10s")
 	viper.SetDefault("coreConfigEntry", "settings")
 	viper.SetDefault("fmqPlugin", "OCClite")
 	viper.SetDefault("fmqPluginSearchPath", "$CONTROL_OCCPLUGIN_ROOT/lib/")
@@ -127,8 +137,22 @@ func setDefaults() error {
 	viper.SetDefault("taskClassCacheTTL", 7*24*time.Hour)
 	viper.SetDefault("kafkaEndpoints", []string{"localhost:9092"})
 	viper.SetDefault("enableKafka", true)
+	viper.SetDefault("eventWebhookEndpoint", "")
+	viper.SetDefault("eventWebhookTopics", []string{})
+	viper.SetDefault("eventFilePath", "")
+	viper.SetDefault("eventFileTopics", []string{})
+	viper.SetDefault("environmentJournal", true)
+	viper.SetDefault("controlTlsCertFile", "")
+	viper.SetDefault("controlTlsKeyFile", "")
+	viper.SetDefault("controlTlsClientCaFile", "")
+	viper.SetDefault("controlAuthTokensFile", "")
+	viper.SetDefault("controlAuthRequired", false)
+	viper.SetDefault("controlAuthPolicyFile", "")
+	viper.SetDefault("environmentRestoreTimeout", "60s")
 	viper.SetDefault("logAllIL", false)
 	viper.SetDefault("metricsEndpoint", "8088/ecsmetrics")
+	viper.SetDefault("metricsPrometheusEndpoint", "metrics")
+	viper.SetDefault("executorCgroupRoot", "")
 	return nil
 }
 
@@ -166,6 +190,8 @@ func setFlags() error {
 	pflag.Int("metrics.port", viper.GetInt("metrics.port"), "Port of metrics server (listens on server.address)")
 	pflag.String("metrics.path", viper.GetString("metrics.path"), "URI path to metrics endpoint")
 	pflag.String("reposSshKey", viper.GetString("reposSshKey"), "Path to a readable private ssh key for repo operations")
+	pflag.String("reposWebhookEndpoint", viper.GetString("reposWebhookEndpoint"), "Http endpoint which accepts GitHub and GitLab push webhooks to refresh the pushed workflow repository branch or tag: [port/endpoint], empty to disable")
+	pflag.String("reposWebhookSecretFile", viper.GetString("reposWebhookSecretFile"), "Path to a file containing the secret shared with the git server, used to verify the push webhooks")
 	pflag.Bool("summaryMetrics", viper.GetBool("summaryMetrics"), "Collect summary metrics for tasks launched per-offer-cycle, offer processing time, etc.")
 	pflag.Bool("verbose", viper.GetBool("verbose"), "Verbose logging")
 	pflag.Bool("veryVerbose", viper.GetBool("veryVerbose"), "Very verbose logging")
@@ -185,6 +211,14 @@ func setFlags() error {
 	pflag.Bool("odcUseSystemProxy", viper.GetBool("odcUseSystemProxy"), "When true the https_proxy, http_proxy and no_proxy environment variables are obeyed")
 	pflag.String("testPluginEndpoint", viper.GetString("testPluginEndpoint"), "Endpoint of the TEST plugin, actually a NOOP")
 	pflag.StringSlice("integrationPlugins", viper.GetStringSlice("integrationPlugins"), "List of integration plugins to load (default: empty)")
+	pflag.Int("bulkOperationConcurrency", viper.GetInt("bulkOperationConcurrency"), "Default maximum number of environments acted upon in parallel by the ControlEnvironments and DestroyEnvironments requests")
+	pflag.Int("bulkOperationMaxConcurrency", viper.GetInt("bulkOperationMaxConcurrency"), "Upper bound on the number of environments acted upon in parallel by the ControlEnvironments and DestroyEnvironments requests, regardless of the requested concurrency")
+	pflag.Duration("roleEventDebounce", viper.GetDuration("roleEventDebounce"), "Window within which the state and status changes of a role are coalesced into a single role event, 0 publishes every change")
+	pflag.Duration("detectorWaitTimeout", viper.GetDuration("detectorWaitTimeout"), "How long an environment creation request with waitForDetectors set waits in the queue for its detectors to be free")
+	pflag.Duration("detectorReservationMaxTtl", viper.GetDuration("detectorReservationMaxTtl"), "Longest time for which detectors can be reserved ahead of environment creation with ReserveDetectors")
+	pflag.Int("integrationBreakerThreshold", viper.GetInt("integrationBreakerThreshold"), "Number of consecutive UNAVAILABLE call failures after which calls to an integration plugin fail fast, 0 disables the circuit breakers")
+	pflag.Duration("integrationBreakerCooldown", viper.GetDuration("integrationBreakerCooldown"), "How long calls to an integration plugin fail fast once its circuit breaker opens, before a probe call is let through")
+	pflag.StringSlice("declarativePlugins", viper.GetStringSlice("declarativePlugins"), "List of YAML or JSON service description files, each loaded as an integration plugin when `declarative` is in integrationPlugins (default: empty)")
 	pflag.String("coreConfigEntry", viper.GetString("coreConfigEntry"), "key for AliECS core configuration within the `aliecs` component [EXPERT SETTING]")
 	pflag.String("fmqPlugin", viper.GetString("fmqPlugin"), "Name of the plugin for FairMQ tasks")
 	pflag.String("fmqPluginSearchPath", viper.GetString("fmqPluginSearchPath"), "Path to the directory where the FairMQ plugins are found on controlled nodes")
@@ -198,8 +232,22 @@ func setFlags() error {
 	pflag.Duration("taskClassCacheTTL", viper.GetDuration("taskClassCacheTTL"), "TTL for task class cache entries")
 	pflag.StringSlice("kafkaEndpoints", viper.GetStringSlice("kafkaEndpoints"), "List of Kafka endpoints to connect to (default: localhost:9092)")
 	pflag.Bool("enableKafka", viper.GetBool("enableKafka"), "Turn on the kafka messaging")
+	pflag.String("eventWebhookEndpoint", viper.GetString("eventWebhookEndpoint"), "URL to which events are POSTed as newline-delimited JSON, empty to disable")
+	pflag.StringSlice("eventWebhookTopics", viper.GetStringSlice("eventWebhookTopics"), "Topics (and their subtopics) of the events sent to eventWebhookEndpoint (default: all)")
+	pflag.String("eventFilePath", viper.GetString("eventFilePath"), "Path to a local file to which events are appended as newline-delimited JSON, empty to disable")
+	pflag.StringSlice("eventFileTopics", viper.GetStringSlice("eventFileTopics"), "Topics (and their subtopics) of the events written to eventFilePath (default: all)")
+	pflag.Bool("environmentJournal", viper.GetBool("environmentJournal"), "Persist environments to coreWorkingDir and re-adopt their tasks after a core restart")
+	pflag.String("controlTlsCertFile", viper.GetString("controlTlsCertFile"), "Path to the PEM certificate of the control server, enables TLS")
+	pflag.String("controlTlsKeyFile", viper.GetString("controlTlsKeyFile"), "Path to the PEM private key of the control server")
+	pflag.String("controlTlsClientCaFile", viper.GetString("controlTlsClientCaFile"), "Path to the PEM CA bundle used to verify client certificates, enables certificate authentication")
+	pflag.String("controlAuthTokensFile", viper.GetString("controlAuthTokensFile"), "Path to a YAML file mapping user names to bearer tokens for the control server")
+	pflag.Bool("controlAuthRequired", viper.GetBool("controlAuthRequired"), "Reject control server calls without a valid client certificate or bearer token")
+	pflag.String("controlAuthPolicyFile", viper.GetString("controlAuthPolicyFile"), "Path to a YAML file restricting which users may control or destroy environments with a given detector")
+	pflag.Duration("environmentRestoreTimeout", viper.GetDuration("environmentRestoreTimeout"), "How long restored environments may take to reconcile their tasks before going to ERROR")
 	pflag.Bool("logAllIL", viper.GetBool("logAllIL"), "Send all the logs into IL, including Debug and Trace messages")
 	pflag.String("metricsEndpoint", viper.GetString("metricsEndpoint"), "Http endpoint from which metrics can be scraped: [port/endpoint]")
+	pflag.String("metricsPrometheusEndpoint", viper.GetString("metricsPrometheusEndpoint"), "Http endpoint on the metricsEndpoint port from which cumulative metrics can be scraped in Prometheus or OpenMetrics format, empty to disable")
+	pflag.String("executorCgroupRoot", viper.GetString("executorCgroupRoot"), "cgroup v2 directory on controlled nodes under which each task gets a cgroup enforcing its task class limits, empty (default) to disable")
 
 	pflag.Parse()
 	return viper.BindPFlags(pflag.CommandLine)
@@ -62,6 +62,21 @@ func NewCommandQueue(s *Servent) *CommandQueue {
 }
 
 func (m *CommandQueue) Enqueue(cmd MesosCommand, callback chan<- MesosCommandResponse) error {
+	// Commands which don't change the state of their targets don't need to wait for the
+	// ones which do, so they are committed right away.
+	if cmd != nil && !cmd.IsMutator() {
+		go func() {
+			response, err := m.commit(cmd)
+			if err != nil {
+				log.WithError(err).
+					WithField("partition", cmd.GetEnvironmentId().String()).
+					Debugf("failed to commit %s", cmd.GetName())
+			}
+			callback <- response
+		}()
+		return nil
+	}
+
 	select {
 	case m.q <- queueEntry{cmd, callback}:
 		return nil
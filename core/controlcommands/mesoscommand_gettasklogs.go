@@ -0,0 +1,78 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package controlcommands
+
+import "github.com/AliceO2Group/Control/common/utils/uid"
+
+// MesosCommand_GetTaskLogs asks the executor for the output of a task launched with stdout or
+// stderr set to "file". It does not change the state of the task, so it is not queued behind
+// pending transitions.
+type MesosCommand_GetTaskLogs struct {
+	MesosCommandBase
+	Lines int `json:"lines"`
+}
+
+func (m *MesosCommand_GetTaskLogs) MakeSingleTarget(target MesosCommandTarget) (cmd MesosCommand) {
+	if m == nil {
+		return
+	}
+	mc := m.MesosCommandBase.MakeSingleTarget(target)
+	mcb, ok := mc.(*MesosCommandBase)
+	if !ok {
+		return
+	}
+
+	cmd = &MesosCommand_GetTaskLogs{
+		MesosCommandBase: *mcb,
+		Lines:            m.Lines,
+	}
+	return
+}
+
+func (m *MesosCommand_GetTaskLogs) IsMutator() bool {
+	return false
+}
+
+func NewMesosCommand_GetTaskLogs(receiver MesosCommandTarget, lines int) *MesosCommand_GetTaskLogs {
+	return &MesosCommand_GetTaskLogs{
+		MesosCommandBase: *NewMesosCommand("MesosCommand_GetTaskLogs", uid.NilID(), []MesosCommandTarget{receiver}, PropertyMapsMap{}),
+		Lines:            lines,
+	}
+}
+
+type MesosCommandResponse_GetTaskLogs struct {
+	MesosCommandResponseBase
+	TaskId    string `json:"taskId"`
+	Logs      string `json:"logs"`
+	Truncated bool   `json:"truncated"`
+}
+
+func NewMesosCommandResponse_GetTaskLogs(mesosCommand *MesosCommand_GetTaskLogs, err error, taskId string, logs string, truncated bool) *MesosCommandResponse_GetTaskLogs {
+	return &MesosCommandResponse_GetTaskLogs{
+		MesosCommandResponseBase: *NewMesosCommandResponse(mesosCommand, err),
+		TaskId:                   taskId,
+		Logs:                     logs,
+		Truncated:                truncated,
+	}
+}
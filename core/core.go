@@ -34,6 +34,7 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -82,11 +83,19 @@ func runMetrics() {
 		return
 	}
 
+	prometheusEndpoint := strings.Trim(viper.GetString("metricsPrometheusEndpoint"), "/")
+	if len(prometheusEndpoint) != 0 {
+		prometheusEndpoint = "/" + prometheusEndpoint
+	}
+
 	go func() {
 		log.Infof("Starting to listen on endpoint %s:%d for metrics", endpoint, port)
-		if err := monitoring.Run(port, fmt.Sprintf("/%s", endpoint)); err != nil && err != http.ErrServerClosed {
+		if len(prometheusEndpoint) != 0 {
+			log.Infof("Prometheus metrics available on endpoint %s:%d", prometheusEndpoint, port)
+		}
+		if err := monitoring.RunWithPrometheus(port, fmt.Sprintf("/%s", endpoint), prometheusEndpoint); err != nil && err != http.ErrServerClosed {
 			golangmetrics.Stop()
-			log.Errorf("failed to run metrics on port %d and endpoint: %s")
+			log.Errorf("failed to run metrics on port %d and endpoint %s: %v", port, endpoint, err)
 		}
 	}()
 
@@ -139,7 +148,14 @@ func Run() error {
 	_ = the.RepoManager()
 
 	// We now build the Control server
-	s := NewServer(state)
+	s, err := NewServer(state)
+	if err != nil {
+		return err
+	}
+
+	// Restored environments must be in place before the first Mesos reconciliation,
+	// otherwise their tasks are killed as unknown
+	state.environments.RestoreEnvironments()
 
 	state.taskman.Start(ctx)
 
@@ -151,6 +167,7 @@ func Run() error {
 	// Plugins need to start after taskman is running, because taskman provides the FID
 	integration.PluginsInstance().InitAll(state.taskman.GetFrameworkID())
 	runMetrics()
+	runRepoWebhook()
 	defer golangmetrics.Stop()
 	defer monitoring.Stop()
 
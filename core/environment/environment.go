@@ -31,10 +31,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/event"
@@ -61,9 +64,21 @@ import (
 
 var log = logger.New(logrus.StandardLogger(), "env")
 
+var stateMachineEvents = fsm.Events{
+	{Name: "DEPLOY", Src: []string{"STANDBY"}, Dst: "DEPLOYED"},
+	{Name: "CONFIGURE", Src: []string{"DEPLOYED"}, Dst: "CONFIGURED"},
+	{Name: "RESET", Src: []string{"CONFIGURED"}, Dst: "DEPLOYED"},
+	{Name: "START_ACTIVITY", Src: []string{"CONFIGURED"}, Dst: "RUNNING"},
+	{Name: "STOP_ACTIVITY", Src: []string{"RUNNING"}, Dst: "CONFIGURED"},
+	{Name: "EXIT", Src: []string{"CONFIGURED", "DEPLOYED", "STANDBY"}, Dst: "DONE"},
+	{Name: "GO_ERROR", Src: []string{"STANDBY", "CONFIGURED", "DEPLOYED", "RUNNING"}, Dst: "ERROR"},
+	{Name: "RECOVER", Src: []string{"ERROR"}, Dst: "DEPLOYED"},
+}
+
 type Environment struct {
 	Mu               sync.RWMutex
 	once             sync.Once
+	watchingWfState  atomic.Bool
 	transitionMutex  sync.RWMutex
 	Sm               *fsm.FSM
 	name             string
@@ -92,6 +107,12 @@ type Environment struct {
 
 	autoStopTimer     *time.Timer
 	autoStopCancelFcn context.CancelFunc
+
+	journal          *journal             // nil if the environment journal is disabled
+	scheduler        *transitionScheduler // pending scheduled transitions, persisted with the environment
+	workflowUserVars map[string]string    // role-targeted user vars as passed at creation time
+	modifications    []roleModification   // ModifyEnvironment history, replayed on restore
+	labels           map[string]string    // free-form key/value labels, from user input
 }
 
 func (env *Environment) NotifyEvent(e event.DeviceEvent) {
@@ -118,6 +139,7 @@ func newEnvironment(userVars map[string]string, newId uid.ID) (env *Environment,
 		stateChangedCh: make(chan *event.TasksStateChangedEvent),
 
 		callsPendingAwait: make(map[string]callable.CallsMap),
+		labels:            make(map[string]string),
 	}
 
 	// Make the KVs accessible to the workflow via ParentAdapter
@@ -149,16 +171,7 @@ func newEnvironment(userVars map[string]string, newId uid.ID) (env *Environment,
 
 	env.Sm = fsm.NewFSM(
 		"STANDBY",
-		fsm.Events{
-			{Name: "DEPLOY", Src: []string{"STANDBY"}, Dst: "DEPLOYED"},
-			{Name: "CONFIGURE", Src: []string{"DEPLOYED"}, Dst: "CONFIGURED"},
-			{Name: "RESET", Src: []string{"CONFIGURED"}, Dst: "DEPLOYED"},
-			{Name: "START_ACTIVITY", Src: []string{"CONFIGURED"}, Dst: "RUNNING"},
-			{Name: "STOP_ACTIVITY", Src: []string{"RUNNING"}, Dst: "CONFIGURED"},
-			{Name: "EXIT", Src: []string{"CONFIGURED", "DEPLOYED", "STANDBY"}, Dst: "DONE"},
-			{Name: "GO_ERROR", Src: []string{"STANDBY", "CONFIGURED", "DEPLOYED", "RUNNING"}, Dst: "ERROR"},
-			{Name: "RECOVER", Src: []string{"ERROR"}, Dst: "DEPLOYED"},
-		},
+		stateMachineEvents,
 		fsm.Callbacks{
 			"before_event": func(_ context.Context, e *fsm.Event) {
 				env.Mu.Lock()
@@ -213,6 +226,7 @@ func newEnvironment(userVars map[string]string, newId uid.ID) (env *Environment,
 					env.workflow.GetVars().Set("runNumber", rnString)
 
 					runStartTime := time.Now()
+					env.publishRunNumberAllocated(runNumber, runStartTime)
 					runStartTimeStr := strconv.FormatInt(runStartTime.UnixMilli(), 10)
 					env.workflow.SetRuntimeVar("run_start_time_ms", runStartTimeStr)
 					env.workflow.SetRuntimeVar("run_start_completion_time_ms", "") // we delete previous EOSOR
@@ -561,6 +575,7 @@ func newEnvironment(userVars map[string]string, newId uid.ID) (env *Environment,
 						Transition:       e.Event,
 						TransitionStatus: pb.OpStatus_DONE_OK,
 						LastRequestUser:  env.GetLastRequestUser(),
+						DurationMs:       env.runTransitionDurationMs("run_start_time_ms", runStartCompletionTime),
 					}
 					if e.Err != nil {
 						runEvent.Error = e.Err.Error()
@@ -582,6 +597,7 @@ func newEnvironment(userVars map[string]string, newId uid.ID) (env *Environment,
 						Transition:       e.Event,
 						TransitionStatus: pb.OpStatus_DONE_OK,
 						LastRequestUser:  env.GetLastRequestUser(),
+						DurationMs:       env.runTransitionDurationMs("run_end_time_ms", runEndCompletionTime),
 					}
 					if e.Err != nil {
 						runEvent.Error = e.Err.Error()
@@ -607,6 +623,7 @@ func newEnvironment(userVars map[string]string, newId uid.ID) (env *Environment,
 							Transition:       e.Event,
 							TransitionStatus: pb.OpStatus_DONE_OK,
 							LastRequestUser:  env.GetLastRequestUser(),
+							DurationMs:       env.runTransitionDurationMs("run_end_time_ms", runEndCompletionTime),
 						}, runEndCompletionTime)
 
 					} else {
@@ -660,7 +677,9 @@ func newEnvironment(userVars map[string]string, newId uid.ID) (env *Environment,
 func (env *Environment) handleHooks(workflow workflow.Role, trigger string, weightPredicate func(callable.HookWeight) bool) (err error) {
 	// Starting point: get all hooks to be started for the current trigger
 	hooksMapForTrigger := workflow.GetHooksMapForTrigger(trigger)
-	callsMapForAwait := env.callsPendingAwait[trigger]
+	env.Mu.RLock()
+	callsMapForAwait := maps.Clone(env.callsPendingAwait[trigger])
+	env.Mu.RUnlock()
 
 	metric := monitoring.NewMetric("hooks")
 	metric.AddTag("trigger", trigger)
@@ -693,6 +712,9 @@ func (env *Environment) handleHooks(workflow workflow.Role, trigger string, weig
 	for _, weight := range filteredWeights {
 		hooksForWeight, thereAreHooksToStartForTheCurrentTriggerAndWeight := hooksMapForTrigger[weight]
 
+		weightStartTime := time.Now()
+		env.publishHookGroupStarted(trigger, weight)
+
 		// PHASE 1: start asynchronously any call hooks and add them to the pending await map
 
 		if thereAreHooksToStartForTheCurrentTriggerAndWeight {
@@ -701,6 +723,7 @@ func (env *Environment) handleHooks(workflow workflow.Role, trigger string, weig
 			if len(callsToStart) != 0 {
 				// Before we run anything asynchronously we must associate each call we're about
 				// to start with its corresponding await expression
+				env.Mu.Lock()
 				for _, call := range callsToStart {
 					awaitExpr := call.GetTraits().Await
 
@@ -718,6 +741,7 @@ func (env *Environment) handleHooks(workflow workflow.Role, trigger string, weig
 					env.callsPendingAwait[awaitName][awaitWeight] = append(
 						env.callsPendingAwait[awaitName][awaitWeight], call)
 				}
+				env.Mu.Unlock()
 				callsToStart.StartAll() // returns immediately (async)
 			}
 		}
@@ -727,19 +751,23 @@ func (env *Environment) handleHooks(workflow workflow.Role, trigger string, weig
 		// We take care of any pending hooks whose await expression corresponds to the current trigger,
 		// including any calls that have just been started (for which trigger == call.Trigger == call.Await).
 		callErrors := make(map[*callable.Call]error)
-		if _, ok := env.callsPendingAwait[trigger]; ok {
-			pendingCalls, ok := env.callsPendingAwait[trigger][weight]
-			if ok && len(pendingCalls) != 0 { // meaning there are hook calls to take care of
-				// AwaitAll blocks with no global timeout - it is up to the specific called function to implement
-				// a timeout internally.
-				// The Call instance pushes to the call's varStack some special values including the timeout
-				// (provided by the workflow template). At that point the integration plugin must acquire the
-				// timeout value and use the Context mechanism or some other approach to ensure the timeouts are
-				// respected.
-
-				callErrors = pendingCalls.AwaitAll()
-				delete(env.callsPendingAwait[trigger], weight)
-			}
+		awaitedCalls := make(callable.Calls, 0)
+		env.Mu.RLock()
+		pendingCalls := slices.Clone(env.callsPendingAwait[trigger][weight])
+		env.Mu.RUnlock()
+		if len(pendingCalls) != 0 { // meaning there are hook calls to take care of
+			// AwaitAll blocks with no global timeout - it is up to the specific called function to implement
+			// a timeout internally.
+			// The Call instance pushes to the call's varStack some special values including the timeout
+			// (provided by the workflow template). At that point the integration plugin must acquire the
+			// timeout value and use the Context mechanism or some other approach to ensure the timeouts are
+			// respected.
+
+			callErrors = pendingCalls.AwaitAll()
+			awaitedCalls = pendingCalls
+			env.Mu.Lock()
+			delete(env.callsPendingAwait[trigger], weight)
+			env.Mu.Unlock()
 		}
 
 		// PHASE 3: start and finish any task hooks (synchronous!)
@@ -757,18 +785,24 @@ func (env *Environment) handleHooks(workflow workflow.Role, trigger string, weig
 		thereAreCriticalErrors := false
 		// We merge hook call errors and hook task errors into a single map for
 		// critical trait processing
+		weightErrors := make(map[callable.Hook]error)
 		for hook, err := range callErrors {
-			allErrors[hook] = err
+			weightErrors[hook] = err
 			if hook.GetTraits().Critical {
 				thereAreCriticalErrors = true
 			}
 		}
 		for hook, err := range taskErrors {
-			allErrors[hook] = err
+			weightErrors[hook] = err
 			if hook.GetTraits().Critical {
 				thereAreCriticalErrors = true
 			}
 		}
+		for hook, err := range weightErrors {
+			allErrors[hook] = err
+		}
+
+		env.publishHookGroupDone(trigger, weight, awaitedCalls, weightErrors, time.Since(weightStartTime))
 
 		if thereAreCriticalErrors {
 			break
@@ -1053,6 +1087,7 @@ func (env *Environment) TryTransition(t Transition) (err error) {
 		})
 	}
 	metric.AddError(err)
+	env.persist()
 	return
 }
 
@@ -1208,8 +1243,14 @@ func (env *Environment) setState(state string) {
 	env.Sm.SetState(state)
 }
 
+// subscribeToWfState transitions the environment to ERROR as soon as its workflow goes to ERROR.
+// It does nothing if the environment is already watching its workflow state.
 func (env *Environment) subscribeToWfState(taskman *task.Manager) {
+	if !env.watchingWfState.CompareAndSwap(false, true) {
+		return
+	}
 	go func() {
+		defer env.watchingWfState.Store(false)
 		wf := env.Workflow()
 		notify := make(chan sm.State)
 		subscriptionId := uuid.NewUUID().String()
@@ -91,10 +91,11 @@ func (s *eventSub) Send(ev event.Event) {
 	case *event.RoleEvent:
 		re := pb.Event_RoleEvent{
 			RoleEvent: &pb.Ev_RoleEvent{
-				Name:     typedEvent.GetName(),
-				State:    typedEvent.GetState(),
-				Status:   typedEvent.GetStatus(),
-				RolePath: typedEvent.GetRolePath(),
+				Name:          typedEvent.GetName(),
+				State:         typedEvent.GetState(),
+				Status:        typedEvent.GetStatus(),
+				RolePath:      typedEvent.GetRolePath(),
+				EnvironmentId: typedEvent.GetEnvironmentId(),
 			},
 		}
 		data = pb.WrapEvent(&re)
@@ -2,6 +2,7 @@ package environment
 
 import (
 	"github.com/AliceO2Group/Control/common/utils/uid"
+	pb "github.com/AliceO2Group/Control/core/protos"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -93,6 +94,13 @@ var _ = Describe("allowed states and transitions in the environment FSM", func()
 			// There is no known issue which could forbid us from that.
 			// TEARDOWN and DESTROY are the artificial transitions which correspond to that.
 		})
+		It("should map RECOVER requests to the RECOVER transition", func() {
+			env.Sm.SetState("ERROR")
+			trans := MakeTransition(nil, pb.ControlEnvironmentRequest_RECOVER)
+			Expect(trans).NotTo(BeNil())
+			Expect(trans.eventName()).To(Equal("RECOVER"))
+			Expect(env.Sm.Can(trans.eventName())).To(BeTrue())
+		})
 		It("should not allow for other transitions", func() {
 			env.Sm.SetState("ERROR")
 			Expect(env.Sm.Cannot("GO_ERROR")).To(BeTrue())
@@ -3,6 +3,9 @@ package environment
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/workflow"
@@ -431,6 +434,22 @@ var _ = Describe("calling hooks on FSM events", func() {
 				Expect(v).NotTo(BeEmpty())
 			})
 		})
+		It("should measure the run transition durations from the run timestamps", func() {
+			env.workflow = workflow.NewAggregatorRole("root", []workflow.Role{})
+			workflow.LinkChildrenToParents(env.workflow)
+			env.Sm.SetState("CONFIGURED")
+
+			Expect(env.runTransitionDurationMs("run_start_time_ms", time.Now())).To(BeZero())
+
+			err := env.Sm.Event(context.Background(), "START_ACTIVITY", NewDummyTransition("START_ACTIVITY", false))
+			Expect(err).NotTo(HaveOccurred())
+
+			startS, ok := env.workflow.GetUserVars().Get("run_start_time_ms")
+			Expect(ok).To(BeTrue())
+			startMs, err := strconv.ParseInt(startS, 10, 64)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(env.runTransitionDurationMs("run_start_time_ms", time.UnixMilli(startMs+1500))).To(BeEquivalentTo(1500))
+		})
 	})
 
 	It("should allow to arrange multiple calls in order", func() {
@@ -0,0 +1,268 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/task"
+)
+
+const journalFileSuffix = ".json"
+
+// journal persists one JSON file per environment, so that the environments
+// which are still alive can be re-adopted by a restarted core.
+type journal struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// roleModification records a successful ModifyEnvironment operation, so that
+// it can be replayed on top of the workflow template when restoring.
+type roleModification struct {
+	Added            bool   `json:"added"`
+	RolePath         string `json:"rolePath"`
+	WorkflowTemplate string `json:"workflowTemplate,omitempty"`
+}
+
+// journalSchedule records a pending TransitionSchedule, so that it can be
+// restored together with its environment.
+type journalSchedule struct {
+	Id               string        `json:"id"`
+	Optype           string        `json:"optype"`
+	At               time.Time     `json:"at,omitempty"`
+	AfterRunDuration time.Duration `json:"afterRunDuration,omitempty"`
+	Condition        string        `json:"condition,omitempty"`
+	CreatedWhen      time.Time     `json:"createdWhen"`
+	RequestUser      *evpb.User    `json:"requestUser,omitempty"`
+}
+
+type journalEntry struct {
+	Id               string             `json:"id"`
+	WorkflowPath     string             `json:"workflowPath"`
+	Public           bool               `json:"public"`
+	CreatedWhen      time.Time          `json:"createdWhen"`
+	State            string             `json:"state"`
+	RunNumber        uint32             `json:"runNumber"`
+	UserVars         map[string]string  `json:"userVars"`
+	WorkflowUserVars map[string]string  `json:"workflowUserVars"`
+	RuntimeVars      map[string]string  `json:"runtimeVars"`
+	Modifications    []roleModification `json:"modifications,omitempty"`
+	Labels           map[string]string  `json:"labels,omitempty"`
+	Schedules        []journalSchedule  `json:"schedules,omitempty"`
+	Tasks            []task.Snapshot    `json:"tasks"`
+}
+
+func newJournal(dir string) (*journal, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("cannot create environment journal directory %s: %w", dir, err)
+	}
+	return &journal{dir: dir}, nil
+}
+
+func (j *journal) path(id string) string {
+	return filepath.Join(j.dir, id+journalFileSuffix)
+}
+
+// write atomically replaces the journal entry for entry.Id.
+func (j *journal) write(entry *journalEntry) error {
+	if j == nil || entry == nil {
+		return nil
+	}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	tmp, err := os.CreateTemp(j.dir, entry.Id+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), j.path(entry.Id))
+}
+
+func (j *journal) remove(id uid.ID) error {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	err := os.Remove(j.path(id.String()))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// readAll returns all the entries in the journal. Entries which cannot be
+// parsed are skipped and reported in the returned error.
+func (j *journal) readAll() (entries []*journalEntry, err error) {
+	entries = make([]*journalEntry, 0)
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	files, err := os.ReadDir(j.dir)
+	if err != nil {
+		return nil, err
+	}
+	badFiles := make([]string, 0)
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), journalFileSuffix) {
+			continue
+		}
+		data, readErr := os.ReadFile(filepath.Join(j.dir, f.Name()))
+		if readErr != nil {
+			badFiles = append(badFiles, f.Name())
+			continue
+		}
+		entry := &journalEntry{}
+		if unmarshalErr := json.Unmarshal(data, entry); unmarshalErr != nil || len(entry.Id) == 0 {
+			badFiles = append(badFiles, f.Name())
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if len(badFiles) > 0 {
+		err = fmt.Errorf("cannot read environment journal entries: %s", strings.Join(badFiles, ", "))
+	}
+	return
+}
+
+// persist writes the current state of env to the environment journal, if the
+// journal is enabled and env is registered with the environment manager.
+func (env *Environment) persist() {
+	if env == nil {
+		return
+	}
+	env.Mu.RLock()
+	j := env.journal
+	env.Mu.RUnlock()
+	if j == nil {
+		return
+	}
+
+	if err := j.write(env.journalEntry()); err != nil {
+		log.WithField("partition", env.Id().String()).
+			WithError(err).
+			Warn("cannot write environment to journal")
+	}
+}
+
+func (env *Environment) journalEntry() *journalEntry {
+	entry := &journalEntry{
+		Id:          env.Id().String(),
+		CreatedWhen: env.CreatedWhen(),
+		State:       env.CurrentState(),
+		RunNumber:   env.GetCurrentRunNumber(),
+		UserVars:    env.UserVars.RawCopy(),
+		Tasks:       make([]task.Snapshot, 0),
+	}
+
+	env.Mu.RLock()
+	entry.WorkflowPath = env.WorkflowPath
+	entry.Public = env.Public
+	entry.WorkflowUserVars = make(map[string]string, len(env.workflowUserVars))
+	for k, v := range env.workflowUserVars {
+		entry.WorkflowUserVars[k] = v
+	}
+	entry.Modifications = append(entry.Modifications, env.modifications...)
+	entry.Labels = make(map[string]string, len(env.labels))
+	for k, v := range env.labels {
+		entry.Labels[k] = v
+	}
+	wf := env.workflow
+	scheduler := env.scheduler
+	env.Mu.RUnlock()
+
+	for _, s := range scheduler.list(uid.ID(entry.Id)) {
+		entry.Schedules = append(entry.Schedules, journalSchedule{
+			Id:               s.Id,
+			Optype:           s.Optype.String(),
+			At:               s.At,
+			AfterRunDuration: s.AfterRunDuration,
+			Condition:        s.Condition,
+			CreatedWhen:      s.CreatedWhen,
+			RequestUser:      s.RequestUser,
+		})
+	}
+
+	if wf != nil {
+		entry.RuntimeVars = wf.GetUserVars().RawCopy()
+		for _, t := range wf.GetTasks() {
+			entry.Tasks = append(entry.Tasks, t.Snapshot())
+		}
+	}
+	return entry
+}
+
+func (js journalSchedule) transitionSchedule(envId uid.ID) (*TransitionSchedule, error) {
+	optype, ok := pb.ControlEnvironmentRequest_Optype_value[js.Optype]
+	if !ok {
+		return nil, fmt.Errorf("unknown transition %s", js.Optype)
+	}
+	return &TransitionSchedule{
+		Id:               js.Id,
+		EnvironmentId:    envId,
+		Optype:           pb.ControlEnvironmentRequest_Optype(optype),
+		At:               js.At,
+		AfterRunDuration: js.AfterRunDuration,
+		Condition:        js.Condition,
+		CreatedWhen:      js.CreatedWhen,
+		RequestUser:      js.RequestUser,
+	}, nil
+}
+
+func (env *Environment) recordModification(mod roleModification) {
+	env.Mu.Lock()
+	env.modifications = append(env.modifications, mod)
+	env.Mu.Unlock()
+}
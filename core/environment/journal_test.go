@@ -0,0 +1,144 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("environment journal", func() {
+	var (
+		j   *journal
+		dir string
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		var err error
+		j, err = newJournal(filepath.Join(dir, "environments"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reads back the entries it wrote", func() {
+		entry := &journalEntry{
+			Id:               "2oDvieFrVTi",
+			WorkflowPath:     "github.com/AliceO2Group/ControlWorkflows/workflows/readout-dataflow@master",
+			State:            "RUNNING",
+			RunNumber:        123,
+			UserVars:         map[string]string{"detectors": "[\"TST\"]"},
+			WorkflowUserVars: map[string]string{"readout:cru_enabled": "true"},
+			Modifications:    []roleModification{{Added: true, RolePath: "root.qc", WorkflowTemplate: "qc-lite"}},
+			Labels:           map[string]string{"owner": "tpc-test"},
+			Tasks: []task.Snapshot{{
+				TaskId:   "2oDvieFrVTj",
+				RolePath: "root.readout",
+				State:    "RUNNING",
+				LocalBindMap: map[string]task.SnapshotEndpoint{
+					"readout": {Tcp: &channel.TcpEndpoint{Host: "*", Port: 47100, Transport: channel.ZEROMQ}},
+				},
+			}},
+		}
+		Expect(j.write(entry)).To(Succeed())
+
+		entries, err := j.readAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0]).To(Equal(entry))
+	})
+
+	It("keeps the pending scheduled transitions", func() {
+		entry := &journalEntry{
+			Id: "2oDvieFrVTi",
+			Schedules: []journalSchedule{{
+				Id:          "2oDvieFrVTj",
+				Optype:      pb.ControlEnvironmentRequest_STOP_ACTIVITY.String(),
+				At:          time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC),
+				CreatedWhen: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+			}},
+		}
+		Expect(j.write(entry)).To(Succeed())
+
+		entries, err := j.readAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Schedules).To(Equal(entry.Schedules))
+
+		s, err := entries[0].Schedules[0].transitionSchedule(uid.ID(entry.Id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.Id).To(Equal("2oDvieFrVTj"))
+		Expect(s.EnvironmentId).To(Equal(uid.ID("2oDvieFrVTi")))
+		Expect(s.Optype).To(Equal(pb.ControlEnvironmentRequest_STOP_ACTIVITY))
+		Expect(s.At).To(Equal(entry.Schedules[0].At))
+
+		_, err = journalSchedule{Id: "2oDvieFrVTj", Optype: "NAP"}.transitionSchedule(uid.ID(entry.Id))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("overwrites the previous entry for the same environment", func() {
+		Expect(j.write(&journalEntry{Id: "2oDvieFrVTi", State: "CONFIGURED"})).To(Succeed())
+		Expect(j.write(&journalEntry{Id: "2oDvieFrVTi", State: "RUNNING"})).To(Succeed())
+
+		entries, err := j.readAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].State).To(Equal("RUNNING"))
+	})
+
+	It("removes entries", func() {
+		Expect(j.write(&journalEntry{Id: "2oDvieFrVTi"})).To(Succeed())
+		Expect(j.remove(uid.ID("2oDvieFrVTi"))).To(Succeed())
+		Expect(j.remove(uid.ID("2oDvieFrVTi"))).To(Succeed())
+
+		entries, err := j.readAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("skips unreadable entries and reports them", func() {
+		Expect(j.write(&journalEntry{Id: "2oDvieFrVTi"})).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(j.dir, "broken.json"), []byte("{"), 0o644)).To(Succeed())
+
+		entries, err := j.readAll()
+		Expect(err).To(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("does nothing when disabled", func() {
+		var disabled *journal
+		Expect(disabled.write(&journalEntry{Id: "2oDvieFrVTi"})).To(Succeed())
+		Expect(disabled.remove(uid.ID("2oDvieFrVTi"))).To(Succeed())
+		entries, err := disabled.readAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+})
@@ -0,0 +1,104 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const maxLabelValueLength = 256
+
+var labelKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.\-/]{0,62}[a-zA-Z0-9])?$`)
+
+// ValidateLabels checks that every label key is at most 64 characters long,
+// made of alphanumerics, '_', '.', '-' and '/', and starts and ends with an
+// alphanumeric character, and that no value is longer than 256 characters.
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if !labelKeyRegexp.MatchString(k) {
+			return fmt.Errorf("invalid label key '%s'", k)
+		}
+		if len(v) > maxLabelValueLength {
+			return fmt.Errorf("value of label '%s' is longer than %d characters", k, maxLabelValueLength)
+		}
+	}
+	return nil
+}
+
+// GetLabels returns a copy of the labels of the environment.
+func (env *Environment) GetLabels() map[string]string {
+	if env == nil {
+		return nil
+	}
+	env.Mu.RLock()
+	defer env.Mu.RUnlock()
+
+	labels := make(map[string]string, len(env.labels))
+	for k, v := range env.labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// HasLabels returns true if the environment has all the given labels. An
+// empty value in selector matches any value of that label.
+func (env *Environment) HasLabels(selector map[string]string) bool {
+	if env == nil {
+		return len(selector) == 0
+	}
+	env.Mu.RLock()
+	defer env.Mu.RUnlock()
+
+	for k, v := range selector {
+		actual, ok := env.labels[k]
+		if !ok || (len(v) > 0 && actual != v) {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateLabels sets the labels in set and then removes the labels in remove,
+// and returns the resulting labels. Nothing is changed if set is invalid.
+func (env *Environment) UpdateLabels(set map[string]string, remove []string) (map[string]string, error) {
+	if env == nil {
+		return nil, fmt.Errorf("cannot update labels of nil environment")
+	}
+	if err := ValidateLabels(set); err != nil {
+		return nil, err
+	}
+
+	env.Mu.Lock()
+	for k, v := range set {
+		env.labels[k] = v
+	}
+	for _, k := range remove {
+		delete(env.labels, k)
+	}
+	env.Mu.Unlock()
+
+	env.persist()
+	return env.GetLabels(), nil
+}
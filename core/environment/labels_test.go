@@ -0,0 +1,74 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("environment labels", func() {
+	It("accepts well-formed labels", func() {
+		Expect(ValidateLabels(map[string]string{
+			"owner":                 "tpc-test",
+			"alice.cern.ch/purpose": "calibration",
+			"x":                     "",
+			strings.Repeat("k", 64): strings.Repeat("v", 256),
+		})).To(Succeed())
+	})
+
+	It("rejects malformed keys and long values", func() {
+		Expect(ValidateLabels(map[string]string{"": "value"})).NotTo(Succeed())
+		Expect(ValidateLabels(map[string]string{"-owner": "value"})).NotTo(Succeed())
+		Expect(ValidateLabels(map[string]string{"owner name": "value"})).NotTo(Succeed())
+		Expect(ValidateLabels(map[string]string{strings.Repeat("k", 65): "value"})).NotTo(Succeed())
+		Expect(ValidateLabels(map[string]string{"owner": strings.Repeat("v", 257)})).NotTo(Succeed())
+	})
+
+	It("updates and matches the labels of an environment", func() {
+		env, err := newEnvironment(map[string]string{}, uid.New())
+		Expect(err).NotTo(HaveOccurred())
+
+		labels, err := env.UpdateLabels(map[string]string{"owner": "tpc-test", "purpose": "calibration"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"owner": "tpc-test", "purpose": "calibration"}))
+
+		Expect(env.HasLabels(nil)).To(BeTrue())
+		Expect(env.HasLabels(map[string]string{"owner": "tpc-test"})).To(BeTrue())
+		Expect(env.HasLabels(map[string]string{"purpose": ""})).To(BeTrue())
+		Expect(env.HasLabels(map[string]string{"owner": "its-test"})).To(BeFalse())
+		Expect(env.HasLabels(map[string]string{"shift": ""})).To(BeFalse())
+
+		labels, err = env.UpdateLabels(map[string]string{"owner": "its-test"}, []string{"purpose"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"owner": "its-test"}))
+
+		_, err = env.UpdateLabels(map[string]string{"bad key": "value"}, []string{"owner"})
+		Expect(err).To(HaveOccurred())
+		Expect(env.GetLabels()).To(Equal(map[string]string{"owner": "its-test"}))
+	})
+})
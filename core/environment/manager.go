@@ -25,9 +25,11 @@
 package environment
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -51,6 +53,7 @@ import (
 	"github.com/AliceO2Group/Control/core/workflow"
 	pb "github.com/AliceO2Group/Control/executor/protos"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 )
 
 type Manager struct {
@@ -60,6 +63,9 @@ type Manager struct {
 	incomingEventCh      chan event.Event
 	pendingTeardownsCh   map[uid.ID]chan *event.TasksReleasedEvent
 	pendingStateChangeCh map[uid.ID]chan *event.TasksStateChangedEvent
+	journal              *journal
+	scheduler            *transitionScheduler
+	reservations         *detectorReservations
 }
 
 var instance *Manager
@@ -76,6 +82,22 @@ func NewEnvManager(tm *task.Manager, incomingEventCh chan event.Event) *Manager
 		pendingTeardownsCh:   make(map[uid.ID]chan *event.TasksReleasedEvent),
 		pendingStateChangeCh: make(map[uid.ID]chan *event.TasksStateChangedEvent),
 	}
+	instance.scheduler = newTransitionScheduler(instance.fireScheduledTransition, instance.currentRunStart)
+	instance.scheduler.changed = instance.persistSchedules
+	instance.scheduler.start()
+	instance.reservations = newDetectorReservations()
+
+	if viper.GetBool("environmentJournal") {
+		journalDir := filepath.Join(viper.GetString("coreWorkingDir"), "environments")
+		j, err := newJournal(journalDir)
+		if err != nil {
+			log.WithError(err).
+				WithField("level", infologger.IL_Support).
+				Error("environment journal disabled")
+		} else {
+			instance.journal = j
+		}
+	}
 
 	go func() {
 		for {
@@ -163,6 +185,9 @@ func NewEnvManager(tm *task.Manager, incomingEventCh chan event.Event) *Manager
 						}
 					}
 
+				case *event.TaskRestartRequestedEvent:
+					go instance.handleTaskRestartRequest(typedEvent)
+
 				case *event.TasksStateChangedEvent:
 					// If we got a TasksStateChangedEvent, it must be matched with a pending
 					// environment transition.
@@ -203,12 +228,7 @@ func (envs *Manager) GetActiveDetectors() system.IDMap {
 	return response
 }
 
-func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]string, public bool, newId uid.ID, autoTransition bool) (resultEnvId uid.ID, resultErr error) {
-	// Before we load the workflow, we get the list of currently active detectors. This query must be performed before
-	// loading the workflow in order to compare the currently used detectors with the detectors required by the newly
-	// created environment.
-	alreadyActiveDetectors := envs.GetActiveDetectors()
-
+func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]string, labels map[string]string, public bool, newId uid.ID, autoTransition bool, waitForDetectors bool) (resultEnvId uid.ID, resultErr error) {
 	lastRequestUser := &evpb.User{}
 	lastRequestUserJ, ok := userVars["last_request_user"]
 	if ok {
@@ -315,6 +335,11 @@ func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]
 	if public {
 		env.Public = true
 	}
+	if env != nil {
+		for k, v := range labels {
+			env.labels[k] = v
+		}
+	}
 
 	gotEnvId := uid.NilID()
 	if err == nil {
@@ -411,16 +436,20 @@ func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]
 		"partition": gotEnvId.String(),
 	}).Infof("detectors in environment: %s", strings.Join(detectors, " "))
 
-	// env.GetActiveDetectors() is valid starting now, so we can check for detector exclusion
+	// env.GetActiveDetectors() is valid starting now, so we can lease the detectors to the environment, which
+	// fails if any of them is in use, or waits in the queue until they are free if so requested
 	neededDetectors := env.GetActiveDetectors()
-	for det := range neededDetectors {
-		if _, contains := alreadyActiveDetectors[det]; contains {
-			// required detector det is already active in some other environment
-
-			resultEnvId = env.id
-			resultErr = fmt.Errorf("detector %s is already in use", det.String())
-			return
-		}
+	if waitForDetectors {
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("detectorWaitTimeout"))
+		_, err = envs.reservations.wait(ctx, lastRequestUser.GetName(), env.id, neededDetectors)
+		cancel()
+	} else {
+		_, err = envs.reservations.reserve(lastRequestUser.GetName(), env.id, neededDetectors, 0)
+	}
+	if err != nil {
+		resultEnvId = env.id
+		resultErr = err
+		return
 	}
 
 	cvs, _ := env.Workflow().ConsolidatedVarStack()
@@ -456,6 +485,13 @@ func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]
 		WithField("level", infologger.IL_Devel).
 		Debug("envman write unlock")
 
+	env.Mu.Lock()
+	env.journal = envs.journal
+	env.scheduler = envs.scheduler
+	env.workflowUserVars = workflowUserVars
+	env.Mu.Unlock()
+	env.persist()
+
 	err = env.TryTransition(NewDeployTransition(
 		envs.taskman,
 		nil, // roles,
@@ -721,6 +757,7 @@ func (envs *Manager) TeardownEnvironment(environmentId uid.ID, force bool) error
 				Error:            "",
 				Transition:       "TEARDOWN",
 				TransitionStatus: evpb.OpStatus_STARTED,
+				DurationMs:       env.runTransitionDurationMs("run_end_time_ms", runEndCompletionTime),
 			}, runEndCompletionTime)
 		} else {
 			log.WithField("partition", environmentId.String()).
@@ -935,6 +972,13 @@ func (envs *Manager) TeardownEnvironment(environmentId uid.ID, force bool) error
 	defer envs.mu.Unlock()
 	delete(envs.m, environmentId)
 	env.unsubscribeFromWfState()
+	envs.scheduler.cancelForEnvironment(environmentId)
+	envs.reservations.releaseEnvironment(environmentId)
+	if err := envs.journal.remove(environmentId); err != nil {
+		log.WithField("partition", environmentId.String()).
+			WithError(err).
+			Warn("cannot remove environment from journal")
+	}
 	log.WithField("method", "TeardownEnvironment").
 		WithField("level", infologger.IL_Devel).
 		Debug("envman write lock")
@@ -962,6 +1006,8 @@ func (envs *Manager) cancelCallsPendingAwait(env *Environment) {
 	if env == nil {
 		return
 	}
+	env.Mu.RLock()
+	defer env.Mu.RUnlock()
 	for _, callMapForAwait := range env.callsPendingAwait {
 		for _, callsForWeight := range callMapForAwait {
 			for _, call := range callsForWeight {
@@ -1021,6 +1067,8 @@ func (envs *Manager) handleIntegratedServiceEvent(evt event.IntegratedServiceEve
 		return
 	}
 
+	envs.scheduler.onIntegratedServiceEvent(evt)
+
 	// for now we only handle ODC events
 	if evt.GetServiceName() == "ODC" {
 		if odcEvent, ok := evt.(*event2.OdcPartitionStateChangeEvent); ok && odcEvent.GetState() == "ERROR" {
@@ -1283,6 +1331,33 @@ func (envs *Manager) handleDeviceEvent(evt event.DeviceEvent) {
 			}
 		}
 
+	case pb.DeviceEventType_TASK_OOM_KILLED:
+		// the executor reports this before the usual termination update, which takes care of the task state
+		taskId := evt.GetOrigin().TaskId
+		fields := logrus.Fields{
+			"partition":      envId.String(),
+			"taskId":         taskId.Value,
+			infologger.Level: infologger.IL_Ops,
+		}
+		if oom, ok := evt.(*event.TaskOOMKilled); ok {
+			fields["oomKillCount"] = oom.OomKillCount
+			fields["memoryLimit"] = oom.MemoryLimit
+		}
+		t := envs.taskman.GetTask(taskId.Value)
+		if t == nil {
+			log.WithPrefix("scheduler").
+				WithFields(fields).
+				Error("task was killed for exceeding its memory limit")
+			return
+		}
+		if parentRole, ok := t.GetParentRole().(workflow.Role); ok {
+			parentRole.SetRuntimeVars(map[string]string{
+				"taskResult.oomKilled": "true",
+			})
+		}
+		log.WithPrefix("scheduler").
+			WithFields(fields).
+			Errorf("task %s on %s was killed for exceeding its memory limit", t.GetClassName(), t.GetHostname())
 	}
 }
 
@@ -0,0 +1,380 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/system"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/task/taskop"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/sirupsen/logrus"
+)
+
+// DetectorAuthorizer checks whether the requester of an environment modification may control all
+// of the given detectors.
+type DetectorAuthorizer func(detectors []string) error
+
+// ModifyEnvironment adds roles to and removes roles from an environment in the CONFIGURED state,
+// without tearing it down.
+// REMOVE_ROLE detaches the role at the given path from the workflow, then releases and kills its tasks.
+// ADD_ROLE grafts a workflow template at the given role path and deploys the tasks of the new sub-tree.
+// Once all operations are done, the new tasks are configured against the channels of the tasks already
+// in the environment. If reconfigureAll is set, all the tasks in the environment are reset and
+// configured again instead.
+// If authorize is not nil, each ADD_ROLE is checked against it with the detectors of the environment
+// together with those of the new sub-tree, before any task is deployed.
+// The detectors which an ADD_ROLE brings into the environment are leased to it, and the operation
+// fails if any of them is in use. Those which a REMOVE_ROLE leaves unused are released, except the
+// detectors the environment was created for.
+// The operations which could not be applied are returned. A non-nil error means the environment
+// could not be modified or was left in an inconsistent state.
+func (envs *Manager) ModifyEnvironment(environmentId uid.ID, operations []*pb.EnvironmentOperation, reconfigureAll bool, authorize DetectorAuthorizer) (failedOperations []*pb.EnvironmentOperation, err error) {
+	env, err := envs.environment(environmentId)
+	if err != nil {
+		return operations, err
+	}
+
+	if !env.transitionMutex.TryLock() {
+		return operations, fmt.Errorf("cannot modify environment %s: transition '%s' in progress", environmentId.String(), env.currentTransition)
+	}
+	defer env.transitionMutex.Unlock()
+
+	if env.CurrentState() != "CONFIGURED" {
+		return operations, fmt.Errorf("cannot modify environment in state %s", env.CurrentState())
+	}
+
+	the.EventWriterWithTopic(topic.Environment).WriteEvent(&evpb.Ev_EnvironmentEvent{
+		EnvironmentId:        environmentId.String(),
+		State:                env.CurrentState(),
+		Transition:           "MODIFY",
+		TransitionStatus:     evpb.OpStatus_STARTED,
+		Message:              "environment modification started",
+		LastRequestUser:      env.GetLastRequestUser(),
+		WorkflowTemplateInfo: env.GetWorkflowInfo(),
+	})
+
+	failedOperations = make([]*pb.EnvironmentOperation, 0)
+	addedRoles := make(map[*pb.EnvironmentOperation]workflow.Role)
+	for _, op := range operations {
+		var opErr error
+		switch op.GetType() {
+		case pb.EnvironmentOperation_NOOP:
+			continue
+		case pb.EnvironmentOperation_REMOVE_ROLE:
+			opErr = envs.removeRole(env, op.GetRoleName())
+			if opErr == nil {
+				env.recordModification(roleModification{RolePath: op.GetRoleName()})
+			}
+		case pb.EnvironmentOperation_ADD_ROLE:
+			var added workflow.Role
+			added, opErr = envs.addRole(env, op.GetRoleName(), op.GetWorkflowTemplate(), authorize)
+			if opErr == nil {
+				addedRoles[op] = added
+				env.recordModification(roleModification{
+					Added:            true,
+					RolePath:         op.GetRoleName(),
+					WorkflowTemplate: op.GetWorkflowTemplate(),
+				})
+			}
+		default:
+			opErr = fmt.Errorf("unknown operation type %s", op.GetType().String())
+		}
+
+		if opErr != nil {
+			log.WithFields(logrus.Fields{
+				"partition": environmentId.String(),
+				"operation": op.GetType().String(),
+				"role":      op.GetRoleName(),
+				"level":     infologger.IL_Ops,
+			}).
+				WithError(opErr).
+				Error("environment modification operation failed")
+			failedOperations = append(failedOperations, op)
+		}
+	}
+
+	if len(addedRoles) > 0 || reconfigureAll {
+		err = envs.configureModifiedEnvironment(env, reconfigureAll)
+		if err != nil && !reconfigureAll {
+			// Only the new tasks were pushed a CONFIGURE, so we can roll back by removing the
+			// added roles and keep the rest of the environment as it was.
+			for op, added := range addedRoles {
+				if rmErr := envs.removeRole(env, added.GetPath()); rmErr != nil {
+					log.WithField("partition", environmentId.String()).
+						WithField("role", added.GetPath()).
+						WithError(rmErr).
+						Warn("could not remove role after failed configuration")
+				} else {
+					env.recordModification(roleModification{RolePath: added.GetPath()})
+				}
+				failedOperations = append(failedOperations, op)
+			}
+			log.WithField("partition", environmentId.String()).
+				WithField("level", infologger.IL_Ops).
+				WithError(err).
+				Error("configuration of added roles failed, roles removed")
+			err = nil
+		}
+	}
+
+	env.persist()
+
+	transitionStatus := evpb.OpStatus_DONE_OK
+	message := "environment modification finished"
+	errMsg := ""
+	if err != nil {
+		transitionStatus = evpb.OpStatus_DONE_ERROR
+		message = "environment modification finished with error"
+		errMsg = err.Error()
+	}
+	the.EventWriterWithTopic(topic.Environment).WriteEvent(&evpb.Ev_EnvironmentEvent{
+		EnvironmentId:        environmentId.String(),
+		State:                env.CurrentState(),
+		Transition:           "MODIFY",
+		TransitionStatus:     transitionStatus,
+		Message:              message,
+		Error:                errMsg,
+		LastRequestUser:      env.GetLastRequestUser(),
+		WorkflowTemplateInfo: env.GetWorkflowInfo(),
+	})
+
+	return failedOperations, err
+}
+
+// addRole grafts workflowTemplate at rolePath, leases the detectors which the new sub-tree adds to
+// the environment and waits for all the tasks of the new sub-tree to become ACTIVE. If
+// authorization, leasing or deployment fails, the new role is removed again.
+func (envs *Manager) addRole(env *Environment, rolePath string, workflowTemplate string, authorize DetectorAuthorizer) (added workflow.Role, err error) {
+	if len(workflowTemplate) == 0 {
+		return nil, fmt.Errorf("no workflow template provided for role %s", rolePath)
+	}
+	sepIdx := strings.LastIndex(rolePath, workflow.PATH_SEPARATOR)
+	if sepIdx <= 0 || sepIdx == len(rolePath)-1 {
+		return nil, fmt.Errorf("invalid role path %s", rolePath)
+	}
+
+	wf := env.Workflow()
+	heldDetectors := environmentDetectors(env)
+	added, err = workflow.GraftRole(wf, rolePath[:sepIdx], rolePath[sepIdx+1:], workflowTemplate, envs.taskman, env.BaseConfigStack)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorize != nil {
+		detectors := detectorIDs(workflow.GetDetectors(added))
+		for det := range heldDetectors {
+			detectors[det] = struct{}{}
+		}
+		if err = authorize(detectors.StringList()); err != nil {
+			_, _ = workflow.DetachRole(wf, added.GetPath())
+			return nil, err
+		}
+	}
+
+	newDetectors := detectorIDs(workflow.GetDetectors(added))
+	for det := range heldDetectors {
+		delete(newDetectors, det)
+	}
+	if len(newDetectors) != 0 {
+		_, err = envs.reservations.reserve(env.GetLastRequestUser().GetName(), env.Id(), newDetectors, 0)
+		if err != nil {
+			_, _ = workflow.DetachRole(wf, added.GetPath())
+			return nil, err
+		}
+	}
+
+	log.WithField("partition", env.Id().String()).
+		WithField("role", added.GetPath()).
+		WithField("workflow", workflowTemplate).
+		Info("role added to environment, deploying")
+
+	taskDescriptors := added.GenerateTaskDescriptors()
+	if len(taskDescriptors) != 0 {
+		envs.taskman.MessageChannel <- task.NewEnvironmentMessage(taskop.AcquireTasks, env.Id(), nil, taskDescriptors)
+	}
+
+	// As in DeployTransition, callRoles are ACTIVE right away since there's no task activation for them.
+	for _, h := range added.GetAllHooks().FilterCalls() {
+		pr, ok := h.GetParentRole().(workflow.PublicUpdatable)
+		if !ok {
+			continue
+		}
+		go pr.UpdateStatus(task.ACTIVE)
+	}
+
+	deploymentTimeout := acquireDeploymentTimeout(wf)
+	deadline := time.After(deploymentTimeout)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+ROLE_ACTIVE_LOOP:
+	for {
+		switch added.GetStatus() {
+		case task.ACTIVE:
+			break ROLE_ACTIVE_LOOP
+		case task.UNDEPLOYABLE:
+			err = fmt.Errorf("role %s is undeployable", added.GetPath())
+			break ROLE_ACTIVE_LOOP
+		}
+
+		select {
+		case <-ticker.C:
+			continue
+		case <-deadline:
+			err = fmt.Errorf("role %s deployment timed out (%s)", added.GetPath(), deploymentTimeout.String())
+			break ROLE_ACTIVE_LOOP
+		}
+	}
+
+	if err != nil {
+		if rmErr := envs.removeRole(env, added.GetPath()); rmErr != nil {
+			log.WithField("partition", env.Id().String()).
+				WithField("role", added.GetPath()).
+				WithError(rmErr).
+				Warn("could not remove role after failed deployment")
+		}
+		return nil, err
+	}
+	return added, nil
+}
+
+// removeRole detaches the role at rolePath from the workflow, releases the detectors which no
+// other role uses, then releases and kills its tasks.
+func (envs *Manager) removeRole(env *Environment, rolePath string) error {
+	removed, err := workflow.DetachRole(env.Workflow(), rolePath)
+	if err != nil {
+		return err
+	}
+
+	droppedDetectors := detectorIDs(workflow.GetDetectors(removed))
+	for det := range environmentDetectors(env) {
+		delete(droppedDetectors, det)
+	}
+	if len(droppedDetectors) != 0 {
+		envs.reservations.releaseEnvironmentDetectors(env.Id(), droppedDetectors)
+	}
+
+	log.WithField("partition", env.Id().String()).
+		WithField("role", rolePath).
+		Info("role removed from environment, releasing tasks")
+
+	for _, h := range removed.GetAllHooks().FilterCalls() {
+		pr, ok := h.GetParentRole().(workflow.PublicUpdatable)
+		if !ok {
+			continue
+		}
+		go pr.UpdateStatus(task.INACTIVE)
+	}
+
+	tasksToRelease := removed.GetTasks()
+	if len(tasksToRelease) == 0 {
+		return nil
+	}
+
+	pendingCh := make(chan *event.TasksReleasedEvent)
+	envs.mu.Lock()
+	envs.pendingTeardownsCh[env.Id()] = pendingCh
+	envs.mu.Unlock()
+
+	envs.taskman.MessageChannel <- task.NewEnvironmentMessage(taskop.ReleaseTasks, env.Id(), tasksToRelease, nil)
+
+	incomingEv := <-pendingCh
+	if taskReleaseErrors := incomingEv.GetTaskReleaseErrors(); len(taskReleaseErrors) > 0 {
+		for taskId, err := range taskReleaseErrors {
+			log.WithFields(logrus.Fields{
+				"taskId":    taskId,
+				"partition": env.Id().String(),
+			}).
+				WithError(err).
+				Warn("task failed to release")
+		}
+		return fmt.Errorf("%d tasks failed to release for role %s", len(taskReleaseErrors), rolePath)
+	}
+
+	_, _, err = envs.taskman.KillTasks(tasksToRelease.GetTaskIds())
+	return err
+}
+
+// environmentDetectors returns the detectors which env holds: those it was created for, which it
+// keeps until it is destroyed, and those of the roles currently in its workflow.
+func environmentDetectors(env *Environment) system.IDMap {
+	detectors := detectorIDs(workflow.GetDetectors(env.Workflow()))
+	for det := range env.GetActiveDetectors() {
+		detectors[det] = struct{}{}
+	}
+	return detectors
+}
+
+func detectorIDs(names []string) system.IDMap {
+	detectors := make(system.IDMap, len(names))
+	for _, name := range names {
+		if sid, err := system.IDString(name); err == nil {
+			detectors[sid] = struct{}{}
+		}
+	}
+	return detectors
+}
+
+// configureModifiedEnvironment pushes CONFIGURE to the active tasks of env which are not CONFIGURED yet.
+// The tasks which are already CONFIGURED still contribute their inbound channels, so that new tasks can
+// connect to them. If reconfigureAll is set, the CONFIGURED tasks are reset first.
+func (envs *Manager) configureModifiedEnvironment(env *Environment, reconfigureAll bool) error {
+	activeTasks := workflow.GetActiveTasks(env.Workflow())
+
+	if reconfigureAll {
+		configuredTasks := activeTasks.Filtered(func(t *task.Task) bool {
+			return t.GetState() == sm.CONFIGURED
+		})
+		if len(configuredTasks) > 0 {
+			envs.taskman.MessageChannel <- task.NewTransitionTaskMessage(
+				configuredTasks,
+				sm.CONFIGURED.String(),
+				sm.RESET.String(),
+				sm.STANDBY.String(),
+				nil,
+				env.Id(),
+			)
+			incomingEv := <-env.stateChangedCh
+			if tasksStateErrors := incomingEv.GetTasksStateChangedError(); tasksStateErrors != nil {
+				return tasksStateErrors
+			}
+		}
+	}
+
+	envs.taskman.MessageChannel <- task.NewConfigureAddedTasksMessage(env.Id(), activeTasks)
+	incomingEv := <-env.stateChangedCh
+	return incomingEv.GetTasksStateChangedError()
+}
@@ -0,0 +1,227 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/AliceO2Group/Control/core/workflow/callable"
+)
+
+// PlanWorkflow loads and processes the workflow template at workflowPath with the given user variables,
+// the same way CreateEnvironment does, but it neither registers an environment nor deploys any task.
+// The returned role tree belongs to a throwaway environment, so it must only be inspected.
+func (envs *Manager) PlanWorkflow(workflowPath string, userVars map[string]string) (root workflow.Role, err error) {
+	if _, err = parseWorkflowPublicInfo(workflowPath); err != nil {
+		return nil, fmt.Errorf("workflow public info parsing failed: %w", err)
+	}
+
+	return planWorkflow(workflowPath, userVars, envs.loadWorkflow)
+}
+
+// workflowLoader loads and processes a workflow template, as Manager.loadWorkflow does.
+type workflowLoader func(workflowPath string, parent workflow.Updatable, workflowUserVars map[string]string, baseConfigStack map[string]string) (workflow.Role, error)
+
+func planWorkflow(workflowPath string, userVars map[string]string, loadWorkflow workflowLoader) (root workflow.Role, err error) {
+	// Same split as in CreateEnvironment: "path.to.some.role:someKey" vars are injected in the workflow.
+	envUserVars := make(map[string]string)
+	workflowUserVars := make(map[string]string)
+	for k, v := range userVars {
+		if strings.ContainsRune(k, task.TARGET_SEPARATOR_RUNE) {
+			workflowUserVars[k] = v
+		} else {
+			envUserVars[k] = v
+		}
+	}
+
+	env, err := newEnvironment(envUserVars, uid.New())
+	if err != nil {
+		return nil, err
+	}
+	if env == nil {
+		return nil, errors.New("newEnvironment returned nil environment")
+	}
+	env.WorkflowPath = workflowPath
+	env.UserVars.Set("environment_id", env.id.String())
+
+	env.workflow, err = loadWorkflow(workflowPath, env.wfAdapter, workflowUserVars, env.BaseConfigStack)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load workflow template: %w", err)
+	}
+
+	detectors, err := the.ConfSvc().GetDetectorsForHosts(env.GetFLPs())
+	if err != nil {
+		return nil, fmt.Errorf("cannot acquire detectors in loaded workflow template: %w", err)
+	}
+	detectorsStr, err := SliceToJSONSlice(detectors)
+	if err != nil {
+		return nil, fmt.Errorf("cannot process detectors in loaded workflow template: %w", err)
+	}
+	env.GlobalDefaults.Set("detectors", detectorsStr)
+
+	return env.workflow, nil
+}
+
+// HookGroupPlan holds the hooks handled for one trigger and weight, in the order the environment
+// handles them: calls are started, then the calls which await this trigger and weight are waited
+// for, and finally hook tasks are run.
+type HookGroupPlan struct {
+	Trigger string
+	Weight  callable.HookWeight
+	Calls   callable.Calls // started asynchronously
+	Awaited callable.Calls // including calls started at earlier hook groups or transitions
+	Tasks   task.Tasks     // run synchronously
+}
+
+// TransitionPlan is the sequence of steps and hook groups which a transition would go through.
+type TransitionPlan struct {
+	Transition string
+	Src        string
+	Dst        string
+	Steps      []string // e.g. before_CONFIGURE, leave_DEPLOYED, tasks_CONFIGURE, enter_CONFIGURED, after_CONFIGURE
+	HookGroups []HookGroupPlan
+	Pending    callable.Calls // started during the transition, but awaited at a later one
+}
+
+// PlanTransition computes the hook groups which the given transition would handle, in order,
+// if it were triggered now, without running any of them. The plan assumes that no critical
+// hook fails, as a critical failure stops the handling of any later weight for a trigger.
+func (env *Environment) PlanTransition(transition string) (plan *TransitionPlan, err error) {
+	if env == nil {
+		return nil, errors.New("cannot plan transition for nil environment")
+	}
+
+	// We work on a copy of the calls pending await, taken under the lock since handleHooks
+	// modifies them. While a transition is in progress they belong to that transition, so
+	// they are left out.
+	pending := make(map[string]callable.CallsMap)
+	env.Mu.RLock()
+	src := env.Sm.Current()
+	wf := env.workflow
+	if env.currentTransition == "" {
+		for awaitName, callsMap := range env.callsPendingAwait {
+			pending[awaitName] = make(callable.CallsMap)
+			for weight, calls := range callsMap {
+				pending[awaitName][weight] = slices.Clone(calls)
+			}
+		}
+	}
+	env.Mu.RUnlock()
+
+	if wf == nil {
+		return nil, errors.New("environment has no workflow")
+	}
+
+	dst := ""
+	for _, ev := range stateMachineEvents {
+		if ev.Name == transition && slices.Contains(ev.Src, src) {
+			dst = ev.Dst
+			break
+		}
+	}
+	if dst == "" {
+		return nil, fmt.Errorf("transition %s is not possible in state %s", transition, src)
+	}
+
+	plan = &TransitionPlan{
+		Transition: transition,
+		Src:        src,
+		Dst:        dst,
+		Steps: []string{
+			"before_" + transition,
+			"leave_" + src,
+			"tasks_" + transition,
+			"enter_" + dst,
+			"after_" + transition,
+		},
+		HookGroups: make([]HookGroupPlan, 0),
+		Pending:    make(callable.Calls, 0),
+	}
+
+	for _, trigger := range plan.Steps {
+		if strings.HasPrefix(trigger, "tasks_") {
+			continue
+		}
+
+		hooksMapForTrigger := wf.GetHooksMapForTrigger(trigger)
+		allWeightsSet := make(callable.HooksMap)
+		for k := range hooksMapForTrigger {
+			allWeightsSet[k] = callable.Hooks{}
+		}
+		for k := range pending[trigger] {
+			allWeightsSet[k] = callable.Hooks{}
+		}
+
+		for _, weight := range allWeightsSet.GetWeights() {
+			group := HookGroupPlan{
+				Trigger: trigger,
+				Weight:  weight,
+				Calls:   hooksMapForTrigger[weight].FilterCalls(),
+				Awaited: make(callable.Calls, 0),
+				Tasks:   hooksMapForTrigger[weight].FilterTasks(),
+			}
+
+			for _, call := range group.Calls {
+				awaitName, awaitWeight := callable.ParseTriggerExpression(call.GetTraits().Await)
+				if _, ok := pending[awaitName]; !ok {
+					pending[awaitName] = make(callable.CallsMap)
+				}
+				pending[awaitName][awaitWeight] = append(pending[awaitName][awaitWeight], call)
+			}
+
+			if calls, ok := pending[trigger][weight]; ok {
+				group.Awaited = calls
+				delete(pending[trigger], weight)
+			}
+
+			plan.HookGroups = append(plan.HookGroups, group)
+		}
+	}
+
+	// Calls started during this transition but awaited at some other trigger remain pending
+	for _, callsMap := range pending {
+		for _, calls := range callsMap {
+			for _, call := range calls {
+				if slices.ContainsFunc(plan.HookGroups, func(group HookGroupPlan) bool {
+					return slices.Contains(group.Calls, call)
+				}) {
+					plan.Pending = append(plan.Pending, call)
+				}
+			}
+		}
+	}
+	sort.SliceStable(plan.Pending, func(i, j int) bool {
+		return plan.Pending[i].GetParentRolePath() < plan.Pending[j].GetParentRolePath()
+	})
+
+	return plan, nil
+}
@@ -0,0 +1,209 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"errors"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/AliceO2Group/Control/core/workflow/callable"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func planTestCall(name string, trigger string, await string) workflow.Role {
+	return workflow.NewCallRole(name,
+		task.Traits{Trigger: trigger, Await: await, Timeout: "5s", Critical: true},
+		"testplugin.Noop()",
+		"")
+}
+
+func rolePathsOf(calls callable.Calls) []string {
+	paths := make([]string, len(calls))
+	for i, call := range calls {
+		paths[i] = call.GetParentRolePath()
+	}
+	return paths
+}
+
+var _ = Describe("planning transitions", func() {
+	var env *Environment
+	BeforeEach(func() {
+		envId, err := uid.FromString("2oDvieFrVTi")
+		Expect(err).NotTo(HaveOccurred())
+
+		env, err = newEnvironment(map[string]string{}, envId)
+		Expect(err).NotTo(HaveOccurred())
+
+		env.workflow = workflow.NewAggregatorRole("root", []workflow.Role{
+			planTestCall("a", "before_CONFIGURE", "after_CONFIGURE"),
+			planTestCall("b", "leave_DEPLOYED-5", "leave_DEPLOYED-5"),
+			planTestCall("c", "enter_CONFIGURED", "after_CONFIGURE+10"),
+			planTestCall("d", "before_START_ACTIVITY", "before_START_ACTIVITY"),
+			planTestCall("e", "before_CONFIGURE", "after_START_ACTIVITY"),
+			planTestCall("f", "after_CONFIGURE+10", "after_CONFIGURE+10"),
+		})
+		workflow.LinkChildrenToParents(env.workflow)
+		env.Sm.SetState("DEPLOYED")
+	})
+
+	It("should order hook groups by transition step and weight", func() {
+		plan, err := env.PlanTransition("CONFIGURE")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Src).To(Equal("DEPLOYED"))
+		Expect(plan.Dst).To(Equal("CONFIGURED"))
+		Expect(plan.Steps).To(Equal([]string{
+			"before_CONFIGURE", "leave_DEPLOYED", "tasks_CONFIGURE", "enter_CONFIGURED", "after_CONFIGURE",
+		}))
+
+		Expect(plan.HookGroups).To(HaveLen(5))
+		expected := []struct {
+			trigger string
+			weight  callable.HookWeight
+			calls   []string
+			awaited []string
+		}{
+			{"before_CONFIGURE", 0, []string{"root.a", "root.e"}, []string{}},
+			{"leave_DEPLOYED", -5, []string{"root.b"}, []string{"root.b"}},
+			{"enter_CONFIGURED", 0, []string{"root.c"}, []string{}},
+			{"after_CONFIGURE", 0, []string{}, []string{"root.a"}},
+			{"after_CONFIGURE", 10, []string{"root.f"}, []string{"root.c", "root.f"}},
+		}
+		for i, group := range plan.HookGroups {
+			Expect(group.Trigger).To(Equal(expected[i].trigger))
+			Expect(group.Weight).To(Equal(expected[i].weight))
+			Expect(rolePathsOf(group.Calls)).To(Equal(expected[i].calls))
+			Expect(rolePathsOf(group.Awaited)).To(Equal(expected[i].awaited))
+			Expect(group.Tasks).To(BeEmpty())
+		}
+
+		Expect(rolePathsOf(plan.Pending)).To(Equal([]string{"root.e"}))
+	})
+
+	It("should include calls started at earlier transitions among the awaited ones", func() {
+		env.callsPendingAwait["after_CONFIGURE"] = callable.CallsMap{
+			5: callable.Calls{callable.NewCall("testplugin.Noop()", "", env.workflow.GetRoles()[3].(callable.ParentRole))},
+		}
+
+		plan, err := env.PlanTransition("CONFIGURE")
+		Expect(err).NotTo(HaveOccurred())
+
+		last := plan.HookGroups[len(plan.HookGroups)-1]
+		Expect(last.Trigger).To(Equal("after_CONFIGURE"))
+		Expect(last.Weight).To(Equal(callable.HookWeight(10)))
+		Expect(rolePathsOf(last.Awaited)).To(Equal([]string{"root.c", "root.f"}))
+
+		awaitedAtFive := plan.HookGroups[len(plan.HookGroups)-2]
+		Expect(awaitedAtFive.Trigger).To(Equal("after_CONFIGURE"))
+		Expect(awaitedAtFive.Weight).To(Equal(callable.HookWeight(5)))
+		Expect(rolePathsOf(awaitedAtFive.Awaited)).To(Equal([]string{"root.d"}))
+		Expect(env.callsPendingAwait["after_CONFIGURE"][5]).To(HaveLen(1))
+	})
+
+	It("should not change the state of the environment", func() {
+		_, err := env.PlanTransition("CONFIGURE")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env.CurrentState()).To(Equal("DEPLOYED"))
+		Expect(env.callsPendingAwait).To(BeEmpty())
+	})
+
+	It("should reject transitions which are not possible in the current state", func() {
+		_, err := env.PlanTransition("START_ACTIVITY")
+		Expect(err).To(HaveOccurred())
+		_, err = env.PlanTransition("NOT_A_TRANSITION")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+const planTestWorkflow = `
+name: plan-test
+defaults:
+  sor_timeout: 5s
+  qc_enabled: "true"
+roles:
+  - name: dcs
+    roles:
+      - name: pfr
+        call:
+          func: testplugin.Noop()
+          trigger: before_CONFIGURE-10
+          await: after_CONFIGURE
+          timeout: 10s
+          critical: false
+      - name: sor
+        call:
+          func: testplugin.Noop()
+          trigger: before_START_ACTIVITY+10
+          timeout: "{{ sor_timeout }}"
+          critical: true
+  - name: qc
+    enabled: "{{ qc_enabled }}"
+    call:
+      func: testplugin.Noop()
+      trigger: before_START_ACTIVITY
+      timeout: 1s
+      critical: false
+`
+
+func loadPlanTestWorkflow(workflowPath string, parent workflow.Updatable, _ map[string]string, baseConfigStack map[string]string) (workflow.Role, error) {
+	if workflowPath != "plan-test" {
+		return nil, errors.New("workflow not found")
+	}
+	root, err := workflow.ParseTemplate([]byte(planTestWorkflow), parent)
+	if err != nil {
+		return nil, err
+	}
+	err = root.ProcessTemplates(nil, nil, baseConfigStack)
+	return root, err
+}
+
+var _ = Describe("planning workflows", func() {
+	It("should load and process the template with the user variables", func() {
+		root, err := planWorkflow("plan-test", map[string]string{
+			"sor_timeout": "30s",
+			"qc_enabled":  "false",
+		}, loadPlanTestWorkflow)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(root.GetPath()).To(Equal("plan-test"))
+		Expect(root.GetRoles()).To(HaveLen(1)) // GetRoles excludes disabled roles
+		Expect(workflow.FindRole(root, "plan-test.dcs.pfr")).NotTo(BeNil())
+
+		hooks := root.GetHooksMapForTrigger("before_START_ACTIVITY")
+		Expect(hooks.GetWeights()).To(Equal([]callable.HookWeight{10})) // the qc call is disabled
+		Expect(hooks[10]).To(HaveLen(1))
+		Expect(hooks[10][0].GetTraits().Timeout).To(Equal("30s"))
+
+		hooks = root.GetHooksMapForTrigger("before_CONFIGURE")
+		Expect(hooks.GetWeights()).To(Equal([]callable.HookWeight{-10}))
+		Expect(hooks[-10][0].GetTraits().Await).To(Equal("after_CONFIGURE"))
+	})
+
+	It("should fail if the template cannot be loaded", func() {
+		_, err := planWorkflow("no-such-workflow", map[string]string{}, loadPlanTestWorkflow)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,114 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/sirupsen/logrus"
+)
+
+// handleTaskRestartRequest waits for the backoff delay of a task restart request, then asks the
+// task manager to restart the task if its environment is DEPLOYED, CONFIGURED or RUNNING.
+// The new task is driven to the state of the environment, but not beyond the target state of
+// its restart policy. The environment does not transition while the restart is in progress.
+func (envs *Manager) handleTaskRestartRequest(ev *event.TaskRestartRequestedEvent) {
+	defer envs.taskman.RestartRequestHandled(ev.GetTaskId())
+
+	time.Sleep(ev.GetDelay())
+
+	envId := ev.GetEnvironmentId()
+	env, err := envs.environment(envId)
+	if err != nil {
+		log.WithField("partition", envId.String()).
+			WithField("taskId", ev.GetTaskId()).
+			WithError(err).
+			Warn("cannot restart task: environment not found")
+		return
+	}
+
+	env.transitionMutex.Lock()
+	defer env.transitionMutex.Unlock()
+
+	t := envs.taskman.GetTask(ev.GetTaskId())
+	if t == nil {
+		log.WithField("partition", envId.String()).
+			WithField("taskId", ev.GetTaskId()).
+			Warn("cannot restart task: task not found")
+		return
+	}
+	rolePath := t.GetParentRolePath()
+	hostname := t.GetHostname()
+
+	// The environment might have transitioned or been torn down during the backoff delay
+	var targetState sm.State
+	var args controlcommands.PropertyMap
+	switch env.CurrentState() {
+	case "DEPLOYED":
+		targetState = sm.STANDBY
+	case "CONFIGURED":
+		targetState = sm.CONFIGURED
+	case "RUNNING":
+		targetState = sm.RUNNING
+		args = startActivityArgs(env, env.GetCurrentRunNumber())
+	default:
+		log.WithField("partition", envId.String()).
+			WithField("role", rolePath).
+			WithField(infologger.Level, infologger.IL_Ops).
+			Warnf("task will not be restarted: environment in state %s", env.CurrentState())
+		return
+	}
+	if policyState := sm.StateFromString(ev.GetTargetState()); policyState != sm.UNKNOWN && policyState < targetState {
+		targetState = policyState
+	}
+
+	logFields := logrus.Fields{
+		"partition":   envId.String(),
+		"role":        rolePath,
+		"taskHost":    hostname,
+		"attempt":     ev.GetAttempt(),
+		"targetState": targetState.String(),
+	}
+	log.WithFields(logFields).
+		WithField(infologger.Level, infologger.IL_Support).
+		Info("restarting task")
+
+	err = envs.taskman.RestartTask(envId, ev.GetTaskId(), targetState, args)
+	env.persist()
+	if err != nil {
+		log.WithFields(logFields).
+			WithField(infologger.Level, infologger.IL_Ops).
+			WithError(err).
+			Errorf("task restart failed for role %s on host %s", rolePath, hostname)
+		return
+	}
+
+	log.WithFields(logFields).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Infof("task for role %s restarted on host %s and brought to %s", rolePath, hostname, targetState.String())
+}
@@ -0,0 +1,276 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// RestoreEnvironments rebuilds the environments found in the environment journal, and adopts
+// their tasks into the task manager.
+// It must be called before the task manager subscribes to Mesos, so that the tasks reported by
+// the initial reconciliation are matched against the adopted tasks instead of being killed.
+// Once environmentRestoreTimeout has elapsed, any restored environment whose tasks did not all
+// come back as expected is moved to ERROR.
+// The state of integration plugins is not restored, and workflow templates are resolved again
+// at the current revision of their repository.
+func (envs *Manager) RestoreEnvironments() (restored []uid.ID) {
+	restored = make([]uid.ID, 0)
+	if envs.journal == nil {
+		return
+	}
+
+	entries, err := envs.journal.readAll()
+	if err != nil {
+		log.WithError(err).
+			WithField("level", infologger.IL_Support).
+			Warn("some environments could not be read from the journal")
+	}
+
+	for _, entry := range entries {
+		env, restoreErr := envs.restoreEnvironment(entry)
+		if restoreErr != nil {
+			log.WithField("partition", entry.Id).
+				WithField("level", infologger.IL_Ops).
+				WithError(restoreErr).
+				Error("cannot restore environment, its tasks will be killed")
+			_ = envs.journal.remove(uid.ID(entry.Id))
+			continue
+		}
+		restored = append(restored, env.Id())
+	}
+
+	if len(restored) > 0 {
+		log.WithField("level", infologger.IL_Ops).
+			Infof("%d environments restored from journal, waiting for task reconciliation", len(restored))
+		time.AfterFunc(viper.GetDuration("environmentRestoreTimeout"), func() {
+			envs.verifyRestoredEnvironments(restored)
+		})
+	}
+	return
+}
+
+func (envs *Manager) restoreEnvironment(entry *journalEntry) (env *Environment, err error) {
+	id, err := uid.FromString(entry.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err = newEnvironment(entry.UserVars, id)
+	if err != nil {
+		return nil, err
+	}
+	if enterStateTime, ok := entry.UserVars["enter_state_time_ms"]; ok {
+		env.UserVars.Set("enter_state_time_ms", enterStateTime)
+	}
+
+	workflowPublicInfo, err := parseWorkflowPublicInfo(entry.WorkflowPath)
+	if err != nil {
+		return nil, fmt.Errorf("workflow public info parsing failed: %w", err)
+	}
+	env.ts = entry.CreatedWhen
+	env.Public = entry.Public
+	env.name = workflowPublicInfo.Name
+	env.Description = workflowPublicInfo.Description
+	env.WorkflowPath = entry.WorkflowPath
+	env.workflowUserVars = entry.WorkflowUserVars
+	env.modifications = entry.Modifications
+	if entry.Labels != nil {
+		env.labels = entry.Labels
+	}
+
+	env.hookHandlerF = func(hooks task.Tasks) error {
+		return envs.taskman.TriggerHooks(id, hooks)
+	}
+	env.UserVars.Set("environment_id", env.id.String())
+
+	env.workflow, err = envs.loadWorkflow(entry.WorkflowPath, env.wfAdapter, entry.WorkflowUserVars, env.BaseConfigStack)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load workflow template: %w", err)
+	}
+
+	for _, mod := range entry.Modifications {
+		if !mod.Added {
+			if _, err = workflow.DetachRole(env.workflow, mod.RolePath); err != nil {
+				return nil, fmt.Errorf("cannot replay removal of role %s: %w", mod.RolePath, err)
+			}
+			continue
+		}
+		sepIdx := strings.LastIndex(mod.RolePath, workflow.PATH_SEPARATOR)
+		if sepIdx <= 0 {
+			return nil, fmt.Errorf("cannot replay addition of role %s: invalid role path", mod.RolePath)
+		}
+		_, err = workflow.GraftRole(env.workflow, mod.RolePath[:sepIdx], mod.RolePath[sepIdx+1:], mod.WorkflowTemplate, envs.taskman, env.BaseConfigStack)
+		if err != nil {
+			return nil, fmt.Errorf("cannot replay addition of role %s: %w", mod.RolePath, err)
+		}
+	}
+
+	env.workflow.SetRuntimeVars(entry.RuntimeVars)
+	if entry.RunNumber != 0 {
+		env.currentRunNumber = entry.RunNumber
+		rnString := strconv.FormatUint(uint64(entry.RunNumber), 10)
+		env.workflow.GetVars().Set("run_number", rnString)
+		env.workflow.GetVars().Set("runNumber", rnString)
+	}
+
+	detectors, err := the.ConfSvc().GetDetectorsForHosts(env.GetFLPs())
+	if err != nil {
+		return nil, fmt.Errorf("cannot acquire detectors in loaded workflow template: %w", err)
+	}
+	detectorsStr, err := SliceToJSONSlice(detectors)
+	if err != nil {
+		return nil, fmt.Errorf("cannot process detectors in loaded workflow template: %w", err)
+	}
+	env.GlobalDefaults.Set("detectors", detectorsStr)
+
+	adoptedCount := 0
+	for _, snapshot := range entry.Tasks {
+		role := workflow.FindRole(env.workflow, snapshot.RolePath)
+		if role == nil {
+			log.WithField("partition", entry.Id).
+				WithField("taskId", snapshot.TaskId).
+				WithField("role", snapshot.RolePath).
+				Warn("no role for persisted task in restored workflow, task will be killed")
+			continue
+		}
+		if _, adoptErr := envs.taskman.AdoptTask(snapshot, role); adoptErr != nil {
+			log.WithField("partition", entry.Id).
+				WithError(adoptErr).
+				Warn("cannot adopt persisted task, task will be killed")
+			continue
+		}
+		adoptedCount++
+	}
+
+	// As in DeployTransition, callRoles are ACTIVE right away since there's no task activation for them.
+	if entry.State != "STANDBY" {
+		for _, h := range env.workflow.GetAllHooks().FilterCalls() {
+			if pr, ok := h.GetParentRole().(workflow.PublicUpdatable); ok {
+				pr.UpdateStatus(task.ACTIVE)
+			}
+		}
+	}
+
+	env.setState(entry.State)
+	env.journal = envs.journal
+	env.scheduler = envs.scheduler
+
+	envs.mu.Lock()
+	envs.m[env.id] = env
+	envs.pendingStateChangeCh[env.id] = env.stateChangedCh
+	envs.mu.Unlock()
+	envs.reservations.restore(env.GetLastRequestUser().GetName(), env.id, environmentDetectors(env))
+
+	// schedules whose time passed while the core was down are not fired late
+	for _, js := range entry.Schedules {
+		s, scheduleErr := js.transitionSchedule(env.id)
+		if scheduleErr == nil {
+			scheduleErr = envs.scheduler.restore(s)
+		}
+		if scheduleErr != nil {
+			log.WithField("partition", entry.Id).
+				WithField("schedule", js.Id).
+				WithField("level", infologger.IL_Ops).
+				WithError(scheduleErr).
+				Warn("cannot restore scheduled transition, schedule dropped")
+		}
+	}
+
+	if entry.State != "ERROR" {
+		env.subscribeToWfState(envs.taskman)
+	}
+
+	log.WithFields(logrus.Fields{
+		"partition": entry.Id,
+		"state":     entry.State,
+		"workflow":  entry.WorkflowPath,
+		"level":     infologger.IL_Ops,
+	}).Infof("environment restored from journal with %d/%d tasks adopted", adoptedCount, len(entry.Tasks))
+
+	the.EventWriterWithTopic(topic.Environment).WriteEvent(&evpb.Ev_EnvironmentEvent{
+		EnvironmentId:        entry.Id,
+		State:                entry.State,
+		RunNumber:            entry.RunNumber,
+		Transition:           "RESTORE",
+		TransitionStatus:     evpb.OpStatus_DONE_OK,
+		Message:              "environment restored from journal",
+		LastRequestUser:      env.GetLastRequestUser(),
+		WorkflowTemplateInfo: env.GetWorkflowInfo(),
+	})
+	return env, nil
+}
+
+// verifyRestoredEnvironments moves to ERROR each restored environment whose tasks are not all
+// ACTIVE and in the task state matching the environment state.
+func (envs *Manager) verifyRestoredEnvironments(ids []uid.ID) {
+	expectedTaskState := map[string]sm.State{
+		"DEPLOYED":   sm.STANDBY,
+		"CONFIGURED": sm.CONFIGURED,
+		"RUNNING":    sm.RUNNING,
+	}
+
+	for _, id := range ids {
+		env, err := envs.environment(id)
+		if err != nil {
+			continue // already torn down
+		}
+		expected, ok := expectedTaskState[env.CurrentState()]
+		if !ok {
+			continue
+		}
+		wf := env.Workflow()
+		if len(wf.GetTasks()) == 0 {
+			continue
+		}
+		if wf.GetStatus() == task.ACTIVE && wf.GetState() == expected {
+			continue
+		}
+
+		log.WithField("partition", id.String()).
+			WithField("level", infologger.IL_Ops).
+			Errorf("restored environment did not reconcile (status %s, state %s), transitioning the environment into ERROR", wf.GetStatus().String(), wf.GetState().String())
+		the.EventWriterWithTopic(topic.Environment).WriteEvent(
+			NewEnvGoErrorEvent(env, "tasks of restored environment did not reconcile"),
+		)
+		err = env.TryTransition(NewGoErrorTransition(envs.taskman))
+		if err != nil {
+			HandleFailedGoError(err, env)
+		}
+	}
+}
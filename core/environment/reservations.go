@@ -0,0 +1,432 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/system"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/spf13/viper"
+)
+
+// DetectorLease grants the exclusive use of a detector to its owner, until it
+// is released or it expires.
+type DetectorLease struct {
+	Detector      system.ID
+	Owner         string
+	EnvironmentId uid.ID // NilID for a reservation made ahead of environment creation
+	AcquiredWhen  time.Time
+	ExpiresWhen   time.Time // zero if the lease is held until released
+}
+
+func (l DetectorLease) expired(now time.Time) bool {
+	return !l.ExpiresWhen.IsZero() && !now.Before(l.ExpiresWhen)
+}
+
+// ToPb converts the lease to its event message.
+func (l DetectorLease) ToPb() *evpb.DetectorLease {
+	lease := &evpb.DetectorLease{
+		Detector:      l.Detector.String(),
+		Owner:         l.Owner,
+		EnvironmentId: l.EnvironmentId.String(),
+		AcquiredWhen:  l.AcquiredWhen.UnixMilli(),
+	}
+	if !l.ExpiresWhen.IsZero() {
+		lease.ExpiresWhen = l.ExpiresWhen.UnixMilli()
+	}
+	return lease
+}
+
+// QueuedRequest is an environment creation request waiting for its detectors.
+type QueuedRequest struct {
+	EnvironmentId uid.ID
+	Owner         string
+	Detectors     system.IDMap
+	QueuedWhen    time.Time
+}
+
+// DetectorsInUseError is returned when a detector cannot be leased because it
+// is leased to somebody else, or awaited by an earlier queued request.
+type DetectorsInUseError struct {
+	Detector system.ID
+	Lease    *DetectorLease // nil if the detector is awaited by a queued request
+}
+
+func (e *DetectorsInUseError) Error() string {
+	switch {
+	case e.Lease == nil:
+		return fmt.Sprintf("detector %s is awaited by a queued environment", e.Detector.String())
+	case !e.Lease.EnvironmentId.IsNil():
+		return fmt.Sprintf("detector %s is already in use by environment %s", e.Detector.String(), e.Lease.EnvironmentId.String())
+	default:
+		return fmt.Sprintf("detector %s is reserved by %s until %s", e.Detector.String(), e.Lease.Owner, e.Lease.ExpiresWhen.Format(time.RFC3339))
+	}
+}
+
+// detectorReservations holds the detector leases and the queue of environment
+// creation requests waiting for their detectors. Queued requests are served in
+// order: a request cannot take a detector which an earlier one awaits.
+type detectorReservations struct {
+	mu      sync.Mutex
+	leases  map[system.ID]DetectorLease
+	queue   []*QueuedRequest
+	changed chan struct{} // closed and replaced whenever a lease or the queue changes
+	now     func() time.Time
+	publish func(*evpb.Ev_DetectorReservationEvent)
+}
+
+func newDetectorReservations() *detectorReservations {
+	return &detectorReservations{
+		leases:  make(map[system.ID]DetectorLease),
+		queue:   make([]*QueuedRequest, 0),
+		changed: make(chan struct{}),
+		now:     time.Now,
+		publish: func(ev *evpb.Ev_DetectorReservationEvent) {
+			the.EventWriterWithTopic(topic.Reservation).WriteEvent(ev)
+		},
+	}
+}
+
+func sortedDetectors(detectors system.IDMap) []system.ID {
+	sorted := make([]system.ID, 0, len(detectors))
+	for det := range detectors {
+		sorted = append(sorted, det)
+	}
+	slices.Sort(sorted)
+	return sorted
+}
+
+// The following methods must be called with mu held.
+
+func (r *detectorReservations) broadcast() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func (r *detectorReservations) publishLeases(change evpb.DetectorReservationChange, leases []DetectorLease, envId uid.ID, owner string) {
+	if len(leases) == 0 || r.publish == nil {
+		return
+	}
+	ev := &evpb.Ev_DetectorReservationEvent{
+		Change:        change,
+		Leases:        make([]*evpb.DetectorLease, len(leases)),
+		EnvironmentId: envId.String(),
+		Owner:         owner,
+		QueueLength:   int32(len(r.queue)),
+	}
+	for i, l := range leases {
+		ev.Leases[i] = l.ToPb()
+	}
+	r.publish(ev)
+}
+
+func (r *detectorReservations) publishRequest(change evpb.DetectorReservationChange, req *QueuedRequest) {
+	if r.publish == nil {
+		return
+	}
+	r.publish(&evpb.Ev_DetectorReservationEvent{
+		Change:        change,
+		EnvironmentId: req.EnvironmentId.String(),
+		Detectors:     req.Detectors.StringList(),
+		Owner:         req.Owner,
+		QueueLength:   int32(len(r.queue)),
+	})
+}
+
+func (r *detectorReservations) expire() {
+	now := r.now()
+	expired := make([]DetectorLease, 0)
+	for _, det := range sortedDetectors(r.leaseDetectors()) {
+		if lease := r.leases[det]; lease.expired(now) {
+			delete(r.leases, det)
+			expired = append(expired, lease)
+		}
+	}
+	if len(expired) != 0 {
+		r.publishLeases(evpb.DetectorReservationChange_LEASE_EXPIRED, expired, uid.NilID(), "")
+		r.broadcast()
+	}
+}
+
+func (r *detectorReservations) leaseDetectors() system.IDMap {
+	detectors := make(system.IDMap, len(r.leases))
+	for det := range r.leases {
+		detectors[det] = struct{}{}
+	}
+	return detectors
+}
+
+// nextExpiry returns when the first lease with an expiry expires, or the zero
+// time if none does.
+func (r *detectorReservations) nextExpiry() (next time.Time) {
+	for _, lease := range r.leases {
+		if !lease.ExpiresWhen.IsZero() && (next.IsZero() || lease.ExpiresWhen.Before(next)) {
+			next = lease.ExpiresWhen
+		}
+	}
+	return
+}
+
+// conflict returns why the detectors cannot be leased by owner for envId, or
+// nil if they can. A lease can be taken over by the environment which holds it,
+// and a reservation made ahead of environment creation by its owner. Only the
+// queued requests before req, or all of them if req is nil, are considered.
+func (r *detectorReservations) conflict(owner string, envId uid.ID, detectors system.IDMap, req *QueuedRequest) error {
+	sorted := sortedDetectors(detectors)
+	for _, det := range sorted {
+		lease, ok := r.leases[det]
+		if !ok {
+			continue
+		}
+		if !envId.IsNil() && lease.EnvironmentId == envId {
+			continue
+		}
+		if owner != "" && lease.Owner == owner && lease.EnvironmentId.IsNil() {
+			continue
+		}
+		return &DetectorsInUseError{Detector: det, Lease: &lease}
+	}
+	for _, queued := range r.queue {
+		if queued == req {
+			break
+		}
+		for _, det := range sorted {
+			if _, ok := queued.Detectors[det]; ok {
+				return &DetectorsInUseError{Detector: det}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *detectorReservations) grant(owner string, envId uid.ID, detectors system.IDMap, ttl time.Duration) []DetectorLease {
+	now := r.now()
+	leases := make([]DetectorLease, 0, len(detectors))
+	for _, det := range sortedDetectors(detectors) {
+		lease := DetectorLease{
+			Detector:      det,
+			Owner:         owner,
+			EnvironmentId: envId,
+			AcquiredWhen:  now,
+		}
+		if ttl > 0 {
+			lease.ExpiresWhen = now.Add(ttl)
+		}
+		r.leases[det] = lease
+		leases = append(leases, lease)
+	}
+	r.publishLeases(evpb.DetectorReservationChange_LEASE_ACQUIRED, leases, envId, owner)
+	return leases
+}
+
+func (r *detectorReservations) dequeue(req *QueuedRequest) {
+	r.queue = slices.DeleteFunc(r.queue, func(queued *QueuedRequest) bool { return queued == req })
+	r.publishRequest(evpb.DetectorReservationChange_REQUEST_DEQUEUED, req)
+	r.broadcast()
+}
+
+// reserve leases the detectors at once, or fails with a *DetectorsInUseError
+// if any of them is in use. A ttl of 0 means the leases do not expire.
+func (r *detectorReservations) reserve(owner string, envId uid.ID, detectors system.IDMap, ttl time.Duration) ([]DetectorLease, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire()
+	if err := r.conflict(owner, envId, detectors, nil); err != nil {
+		return nil, err
+	}
+	return r.grant(owner, envId, detectors, ttl), nil
+}
+
+// wait leases the detectors for envId as soon as all of them can be leased,
+// queueing the request until then, or fails once ctx is done.
+func (r *detectorReservations) wait(ctx context.Context, owner string, envId uid.ID, detectors system.IDMap) ([]DetectorLease, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire()
+	if r.conflict(owner, envId, detectors, nil) == nil {
+		return r.grant(owner, envId, detectors, 0), nil
+	}
+
+	req := &QueuedRequest{
+		EnvironmentId: envId,
+		Owner:         owner,
+		Detectors:     detectors,
+		QueuedWhen:    r.now(),
+	}
+	r.queue = append(r.queue, req)
+	r.publishRequest(evpb.DetectorReservationChange_REQUEST_QUEUED, req)
+
+	for {
+		changed := r.changed
+		var expiry *time.Timer
+		var expiryC <-chan time.Time
+		if next := r.nextExpiry(); !next.IsZero() {
+			expiry = time.NewTimer(next.Sub(r.now()))
+			expiryC = expiry.C
+		}
+
+		r.mu.Unlock()
+		select {
+		case <-changed:
+		case <-expiryC:
+		case <-ctx.Done():
+		}
+		if expiry != nil {
+			expiry.Stop()
+		}
+		r.mu.Lock()
+
+		if ctx.Err() != nil {
+			r.dequeue(req)
+			err := r.conflict(owner, envId, detectors, nil)
+			if err == nil {
+				err = ctx.Err()
+			}
+			return nil, fmt.Errorf("gave up waiting for detectors: %w", err)
+		}
+
+		r.expire()
+		if r.conflict(owner, envId, detectors, req) == nil {
+			r.dequeue(req)
+			return r.grant(owner, envId, detectors, 0), nil
+		}
+	}
+}
+
+// restore leases the detectors to a restored environment, regardless of any
+// other lease or queued request.
+func (r *detectorReservations) restore(owner string, envId uid.ID, detectors system.IDMap) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.grant(owner, envId, detectors, 0)
+}
+
+// release drops the leases for which drop returns true.
+func (r *detectorReservations) release(drop func(DetectorLease) bool) []DetectorLease {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	released := make([]DetectorLease, 0)
+	for _, det := range sortedDetectors(r.leaseDetectors()) {
+		if lease := r.leases[det]; drop(lease) {
+			delete(r.leases, det)
+			released = append(released, lease)
+		}
+	}
+	if len(released) != 0 {
+		r.publishLeases(evpb.DetectorReservationChange_LEASE_RELEASED, released, released[0].EnvironmentId, released[0].Owner)
+		r.broadcast()
+	}
+	return released
+}
+
+// releaseEnvironment drops all the leases held by the environment.
+func (r *detectorReservations) releaseEnvironment(envId uid.ID) []DetectorLease {
+	return r.release(func(lease DetectorLease) bool {
+		return lease.EnvironmentId == envId
+	})
+}
+
+// releaseEnvironmentDetectors drops the leases held by the environment for the
+// given detectors.
+func (r *detectorReservations) releaseEnvironmentDetectors(envId uid.ID, detectors system.IDMap) []DetectorLease {
+	return r.release(func(lease DetectorLease) bool {
+		_, ok := detectors[lease.Detector]
+		return ok && lease.EnvironmentId == envId
+	})
+}
+
+// releaseReservations drops the leases of owner which are not held by an
+// environment, only for the given detectors unless there are none.
+func (r *detectorReservations) releaseReservations(owner string, detectors system.IDMap) []DetectorLease {
+	return r.release(func(lease DetectorLease) bool {
+		if lease.Owner != owner || !lease.EnvironmentId.IsNil() {
+			return false
+		}
+		_, ok := detectors[lease.Detector]
+		return len(detectors) == 0 || ok
+	})
+}
+
+// list returns the current leases sorted by detector, and the queued requests
+// in the order in which they are served.
+func (r *detectorReservations) list() ([]DetectorLease, []QueuedRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire()
+	leases := make([]DetectorLease, 0, len(r.leases))
+	for _, det := range sortedDetectors(r.leaseDetectors()) {
+		leases = append(leases, r.leases[det])
+	}
+	queue := make([]QueuedRequest, len(r.queue))
+	for i, req := range r.queue {
+		queue[i] = *req
+	}
+	return leases, queue
+}
+
+// DetectorReservations returns the current detector leases, sorted by detector,
+// and the environment creation requests waiting for detectors, in the order in
+// which they are served.
+func (envs *Manager) DetectorReservations() ([]DetectorLease, []QueuedRequest) {
+	return envs.reservations.list()
+}
+
+// ReserveDetectors reserves the detectors to owner ahead of environment
+// creation, for ttl. The reservation is taken over by the next environment
+// created by owner which uses the detectors.
+func (envs *Manager) ReserveDetectors(owner string, detectors system.IDMap, ttl time.Duration) ([]DetectorLease, error) {
+	if owner == "" {
+		return nil, errors.New("detector reservations require a user name")
+	}
+	if len(detectors) == 0 {
+		return nil, errors.New("no detectors to reserve")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("reservation TTL must be positive")
+	}
+	if maxTtl := viper.GetDuration("detectorReservationMaxTtl"); maxTtl > 0 && ttl > maxTtl {
+		return nil, fmt.Errorf("reservation TTL %s exceeds the maximum of %s", ttl.String(), maxTtl.String())
+	}
+	return envs.reservations.reserve(owner, uid.NilID(), detectors, ttl)
+}
+
+// ReleaseDetectors releases the reservations of owner which are not held by an
+// environment, only for the given detectors unless there are none.
+func (envs *Manager) ReleaseDetectors(owner string, detectors system.IDMap) []DetectorLease {
+	return envs.reservations.releaseReservations(owner, detectors)
+}
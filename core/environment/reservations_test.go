@@ -0,0 +1,230 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/system"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("detector reservations", func() {
+	var (
+		r      *detectorReservations
+		now    time.Time
+		events []*evpb.Ev_DetectorReservationEvent
+		envA   uid.ID
+		envB   uid.ID
+	)
+
+	detectors := func(ids ...system.ID) system.IDMap {
+		m := make(system.IDMap)
+		for _, id := range ids {
+			m[id] = struct{}{}
+		}
+		return m
+	}
+	changes := func() []evpb.DetectorReservationChange {
+		out := make([]evpb.DetectorReservationChange, len(events))
+		for i, ev := range events {
+			out[i] = ev.GetChange()
+		}
+		return out
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+		events = nil
+		envA = uid.New()
+		envB = uid.New()
+		r = newDetectorReservations()
+		r.now = func() time.Time { return now }
+		r.publish = func(ev *evpb.Ev_DetectorReservationEvent) { events = append(events, ev) }
+	})
+
+	When("leasing detectors to environments", func() {
+		It("should refuse detectors already in use by another environment", func() {
+			_, err := r.reserve("alice", envA, detectors(system.TPC, system.ITS), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = r.reserve("bob", envB, detectors(system.ITS, system.MFT), 0)
+			var inUse *DetectorsInUseError
+			Expect(errors.As(err, &inUse)).To(BeTrue())
+			Expect(inUse.Detector).To(Equal(system.ITS))
+			Expect(inUse.Lease.EnvironmentId).To(Equal(envA))
+
+			leases, _ := r.list()
+			Expect(leases).To(HaveLen(2))
+		})
+
+		It("should free the detectors when the environment releases them", func() {
+			_, err := r.reserve("alice", envA, detectors(system.TPC), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.releaseEnvironment(envA)).To(HaveLen(1))
+
+			_, err = r.reserve("bob", envB, detectors(system.TPC), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes()).To(Equal([]evpb.DetectorReservationChange{
+				evpb.DetectorReservationChange_LEASE_ACQUIRED,
+				evpb.DetectorReservationChange_LEASE_RELEASED,
+				evpb.DetectorReservationChange_LEASE_ACQUIRED,
+			}))
+		})
+
+		It("should free only the given detectors of the environment", func() {
+			_, err := r.reserve("alice", envA, detectors(system.TPC, system.ITS), 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = r.reserve("bob", envB, detectors(system.MFT), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			released := r.releaseEnvironmentDetectors(envA, detectors(system.ITS, system.MFT))
+			Expect(released).To(HaveLen(1))
+			Expect(released[0].Detector).To(Equal(system.ITS))
+
+			leases, _ := r.list()
+			Expect(leases).To(HaveLen(2))
+			_, err = r.reserve("bob", envB, detectors(system.ITS), 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("reserving detectors ahead of environment creation", func() {
+		It("should let the owner's environment take over the reservation", func() {
+			_, err := r.reserve("alice", uid.NilID(), detectors(system.TPC), time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = r.reserve("bob", envB, detectors(system.TPC), 0)
+			Expect(err).To(HaveOccurred())
+
+			leases, err := r.reserve("alice", envA, detectors(system.TPC), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leases).To(HaveLen(1))
+			Expect(leases[0].EnvironmentId).To(Equal(envA))
+			Expect(leases[0].ExpiresWhen.IsZero()).To(BeTrue())
+
+			// the reservation is gone, so the owner cannot release it anymore
+			Expect(r.releaseReservations("alice", nil)).To(BeEmpty())
+		})
+
+		It("should expire reservations after their TTL", func() {
+			_, err := r.reserve("alice", uid.NilID(), detectors(system.TPC), time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			now = now.Add(time.Hour)
+			leases, _ := r.list()
+			Expect(leases).To(BeEmpty())
+			Expect(events[len(events)-1].GetChange()).To(Equal(evpb.DetectorReservationChange_LEASE_EXPIRED))
+
+			_, err = r.reserve("bob", envB, detectors(system.TPC), 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should release only the owner's reservations", func() {
+			_, err := r.reserve("alice", uid.NilID(), detectors(system.TPC, system.ITS), time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(r.releaseReservations("bob", nil)).To(BeEmpty())
+			released := r.releaseReservations("alice", detectors(system.ITS))
+			Expect(released).To(HaveLen(1))
+			Expect(released[0].Detector).To(Equal(system.ITS))
+
+			leases, _ := r.list()
+			Expect(leases).To(HaveLen(1))
+			Expect(leases[0].Detector).To(Equal(system.TPC))
+		})
+	})
+
+	When("waiting for detectors", func() {
+		It("should lease the detectors at once if they are free", func() {
+			leases, err := r.wait(context.Background(), "alice", envA, detectors(system.TPC))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leases).To(HaveLen(1))
+			_, queue := r.list()
+			Expect(queue).To(BeEmpty())
+		})
+
+		It("should serve the queued requests in order once the detectors are released", func() {
+			envC := uid.New()
+			_, err := r.reserve("alice", envA, detectors(system.TPC, system.ITS), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			doneB := make(chan error, 1)
+			go func() {
+				_, err := r.wait(context.Background(), "bob", envB, detectors(system.TPC))
+				doneB <- err
+			}()
+			Eventually(func() int { _, queue := r.list(); return len(queue) }).Should(Equal(1))
+
+			doneC := make(chan error, 1)
+			go func() {
+				_, err := r.wait(context.Background(), "carol", envC, detectors(system.TPC, system.MFT))
+				doneC <- err
+			}()
+			Eventually(func() int { _, queue := r.list(); return len(queue) }).Should(Equal(2))
+
+			// a request which does not wait cannot jump the queue either
+			_, err = r.reserve("dave", uid.New(), detectors(system.MFT), 0)
+			Expect(err).To(HaveOccurred())
+
+			r.releaseEnvironment(envA)
+			Eventually(doneB).Should(Receive(BeNil()))
+			Consistently(doneC, 100*time.Millisecond).ShouldNot(Receive())
+
+			r.releaseEnvironment(envB)
+			Eventually(doneC).Should(Receive(BeNil()))
+
+			leases, queue := r.list()
+			Expect(queue).To(BeEmpty())
+			Expect(leases).To(HaveLen(2))
+			for _, lease := range leases {
+				Expect(lease.EnvironmentId).To(Equal(envC))
+			}
+		})
+
+		It("should give up and leave the queue once the context is done", func() {
+			_, err := r.reserve("alice", envA, detectors(system.TPC), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			_, err = r.wait(ctx, "bob", envB, detectors(system.TPC))
+			Expect(err).To(MatchError(ContainSubstring("gave up waiting for detectors")))
+			var inUse *DetectorsInUseError
+			Expect(errors.As(err, &inUse)).To(BeTrue())
+
+			_, queue := r.list()
+			Expect(queue).To(BeEmpty())
+			Expect(changes()).To(ContainElements(
+				evpb.DetectorReservationChange_REQUEST_QUEUED,
+				evpb.DetectorReservationChange_REQUEST_DEQUEUED,
+			))
+		})
+	})
+})
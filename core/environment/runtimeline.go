@@ -0,0 +1,120 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	pb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow/callable"
+)
+
+// The run timeline complements the RUN_TRANSITION events published on topic.Run
+// with the allocation of the run number, the start and end of each hook group
+// and the result of each hook call, for as long as the environment has a run
+// number.
+
+func (env *Environment) newRunEvent(kind pb.RunEventKind, runNumber uint32) *pb.Ev_RunEvent {
+	return &pb.Ev_RunEvent{
+		EnvironmentId:   env.id.String(),
+		RunNumber:       runNumber,
+		State:           env.Sm.Current(),
+		Transition:      env.CurrentTransition(),
+		LastRequestUser: env.GetLastRequestUser(),
+		Kind:            kind,
+	}
+}
+
+func (env *Environment) publishRunNumberAllocated(runNumber uint32, when time.Time) {
+	runEvent := env.newRunEvent(pb.RunEventKind_RUN_NUMBER_ALLOCATED, runNumber)
+	runEvent.TransitionStatus = pb.OpStatus_DONE_OK
+	the.EventWriterWithTopic(topic.Run).WriteEventWithTimestamp(runEvent, when)
+}
+
+func (env *Environment) publishHookGroupStarted(trigger string, weight callable.HookWeight) {
+	runNumber := env.GetCurrentRunNumber()
+	if runNumber == 0 {
+		return
+	}
+	runEvent := env.newRunEvent(pb.RunEventKind_HOOK_GROUP, runNumber)
+	runEvent.Trigger = trigger
+	runEvent.Weight = int32(weight)
+	runEvent.TransitionStatus = pb.OpStatus_STARTED
+	the.EventWriterWithTopic(topic.Run).WriteEvent(runEvent)
+}
+
+// publishHookGroupDone publishes the result of each call awaited by the hook
+// group, followed by the end of the group itself.
+func (env *Environment) publishHookGroupDone(trigger string, weight callable.HookWeight, calls callable.Calls, errs map[callable.Hook]error, duration time.Duration) {
+	runNumber := env.GetCurrentRunNumber()
+	if runNumber == 0 {
+		return
+	}
+
+	for _, call := range calls {
+		runEvent := env.newRunEvent(pb.RunEventKind_HOOK_CALL, runNumber)
+		runEvent.Trigger = trigger
+		runEvent.Weight = int32(weight)
+		runEvent.Plugin = call.GetPluginName()
+		runEvent.Call = call.Func
+		runEvent.DurationMs = call.GetDuration().Milliseconds()
+		runEvent.TransitionStatus = pb.OpStatus_DONE_OK
+		if err := errs[call]; err != nil {
+			runEvent.Error = err.Error()
+			runEvent.TransitionStatus = pb.OpStatus_DONE_ERROR
+		}
+		the.EventWriterWithTopic(topic.Run).WriteEvent(runEvent)
+	}
+
+	runEvent := env.newRunEvent(pb.RunEventKind_HOOK_GROUP, runNumber)
+	runEvent.Trigger = trigger
+	runEvent.Weight = int32(weight)
+	runEvent.DurationMs = duration.Milliseconds()
+	runEvent.TransitionStatus = pb.OpStatus_DONE_OK
+	for _, err := range errs {
+		if err != nil {
+			runEvent.Error = err.Error()
+			runEvent.TransitionStatus = pb.OpStatus_DONE_ERROR
+			break
+		}
+	}
+	the.EventWriterWithTopic(topic.Run).WriteEvent(runEvent)
+}
+
+// runTransitionDurationMs returns the milliseconds elapsed between the time
+// stored in the given runtime variable, e.g. run_start_time_ms, and end.
+func (env *Environment) runTransitionDurationMs(startVar string, end time.Time) int64 {
+	startS, ok := env.workflow.GetUserVars().Get(startVar)
+	if !ok || startS == "" {
+		return 0
+	}
+	startMs, err := strconv.ParseInt(startS, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return end.UnixMilli() - startMs
+}
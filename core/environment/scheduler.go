@@ -0,0 +1,474 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	lhcevent "github.com/AliceO2Group/Control/core/integration/lhc/event"
+	odcevent "github.com/AliceO2Group/Control/core/integration/odc/event"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+)
+
+const scheduleTickInterval = time.Second
+
+// TransitionSchedule is a transition which is triggered automatically, either
+// at an absolute time, after the current (or next) run has lasted for a given
+// duration, or when an integrated service event satisfies a condition.
+// Exactly one of At, AfterRunDuration and Condition is set.
+type TransitionSchedule struct {
+	Id               string
+	EnvironmentId    uid.ID
+	Optype           pb.ControlEnvironmentRequest_Optype
+	At               time.Time
+	AfterRunDuration time.Duration
+	Condition        string // expr expression, see conditionEnv for the variables
+	CreatedWhen      time.Time
+	RequestUser      *evpb.User
+
+	program     *vm.Program
+	triggeredBy string // service name of the event which satisfied Condition
+}
+
+// transitionScheduler keeps the pending schedules and decides when they are
+// due. fire is called for every due schedule, and returns false if the
+// transition could not be triggered in the current environment state, in
+// which case a conditional schedule stays pending until the next matching
+// event. runStart returns the start time of the current run, if any.
+// changed, if set, is called whenever schedules of an environment were
+// removed because they fired, so that they can be persisted.
+type transitionScheduler struct {
+	mu        sync.Mutex
+	schedules map[string]*TransitionSchedule
+	firing    map[string]struct{} // conditional schedules being fired, they stay in schedules meanwhile
+	fire      func(s *TransitionSchedule) bool
+	runStart  func(envId uid.ID) (time.Time, bool)
+	changed   func(envId uid.ID)
+	now       func() time.Time
+}
+
+func newTransitionScheduler(fire func(s *TransitionSchedule) bool, runStart func(envId uid.ID) (time.Time, bool)) *transitionScheduler {
+	return &transitionScheduler{
+		schedules: make(map[string]*TransitionSchedule),
+		firing:    make(map[string]struct{}),
+		fire:      fire,
+		runStart:  runStart,
+		now:       time.Now,
+	}
+}
+
+func (ts *transitionScheduler) start() {
+	go func() {
+		ticker := time.NewTicker(scheduleTickInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			ts.tick()
+		}
+	}()
+}
+
+func (ts *transitionScheduler) add(s *TransitionSchedule) error {
+	if err := ts.prepare(s); err != nil {
+		return err
+	}
+
+	s.Id = uid.New().String()
+	s.CreatedWhen = ts.now()
+
+	ts.mu.Lock()
+	ts.schedules[s.Id] = s
+	ts.mu.Unlock()
+	return nil
+}
+
+// restore adds back a schedule read from the environment journal, keeping its
+// id and creation time.
+func (ts *transitionScheduler) restore(s *TransitionSchedule) error {
+	if len(s.Id) == 0 {
+		return errors.New("schedule has no id")
+	}
+	if err := ts.prepare(s); err != nil {
+		return err
+	}
+
+	ts.mu.Lock()
+	ts.schedules[s.Id] = s
+	ts.mu.Unlock()
+	return nil
+}
+
+// prepare validates the trigger of s and compiles its condition, if any.
+func (ts *transitionScheduler) prepare(s *TransitionSchedule) error {
+	triggers := 0
+	if !s.At.IsZero() {
+		triggers++
+		if !s.At.After(ts.now()) {
+			return fmt.Errorf("scheduled time %s is in the past", s.At.Format(time.RFC3339))
+		}
+	}
+	if s.AfterRunDuration != 0 {
+		triggers++
+		if s.AfterRunDuration < 0 {
+			return errors.New("run duration must be positive")
+		}
+	}
+	if len(s.Condition) > 0 {
+		triggers++
+		program, err := expr.Compile(s.Condition, expr.AsBool(), expr.AllowUndefinedVariables())
+		if err != nil {
+			return fmt.Errorf("invalid condition: %w", err)
+		}
+		s.program = program
+	}
+	if triggers != 1 {
+		return errors.New("exactly one of time, run duration or condition must be set")
+	}
+	return nil
+}
+
+func (ts *transitionScheduler) cancel(id string) (*TransitionSchedule, bool) {
+	if ts == nil {
+		return nil, false
+	}
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	s, ok := ts.schedules[id]
+	delete(ts.schedules, id)
+	return s, ok
+}
+
+func (ts *transitionScheduler) cancelForEnvironment(envId uid.ID) {
+	if ts == nil {
+		return
+	}
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for id, s := range ts.schedules {
+		if s.EnvironmentId == envId {
+			delete(ts.schedules, id)
+		}
+	}
+}
+
+// list returns the pending schedules of envId, or all of them if envId is
+// empty, in order of creation.
+func (ts *transitionScheduler) list(envId uid.ID) []*TransitionSchedule {
+	if ts == nil {
+		return nil
+	}
+	ts.mu.Lock()
+	out := make([]*TransitionSchedule, 0, len(ts.schedules))
+	for _, s := range ts.schedules {
+		if envId == uid.NilID() || s.EnvironmentId == envId {
+			out = append(out, s)
+		}
+	}
+	ts.mu.Unlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].CreatedWhen.Equal(out[j].CreatedWhen) {
+			return out[i].Id < out[j].Id
+		}
+		return out[i].CreatedWhen.Before(out[j].CreatedWhen)
+	})
+	return out
+}
+
+// tick fires the time-based schedules which are due.
+func (ts *transitionScheduler) tick() {
+	now := ts.now()
+	for _, s := range ts.list(uid.NilID()) {
+		due := false
+		switch {
+		case !s.At.IsZero():
+			due = !now.Before(s.At)
+		case s.AfterRunDuration > 0:
+			if runStart, running := ts.runStart(s.EnvironmentId); running {
+				due = !now.Before(runStart.Add(s.AfterRunDuration))
+			}
+		}
+		if !due {
+			continue
+		}
+		// time-based schedules are dropped even if they could not be fired,
+		// the moment they were meant for is gone, fire reports the failure
+		if _, ok := ts.cancel(s.Id); ok {
+			ts.fire(s)
+			ts.notifyChanged(s.EnvironmentId)
+		}
+	}
+}
+
+func (ts *transitionScheduler) notifyChanged(envId uid.ID) {
+	if ts.changed != nil {
+		ts.changed(envId)
+	}
+}
+
+// onIntegratedServiceEvent fires the conditional schedules which evt satisfies.
+// Events which concern a single environment are only matched against the
+// schedules of that environment.
+func (ts *transitionScheduler) onIntegratedServiceEvent(evt event.IntegratedServiceEvent) {
+	if ts == nil {
+		return
+	}
+	env := conditionEnv(evt)
+	evtEnvId, envScoped := eventEnvironmentId(evt)
+	for _, s := range ts.list(uid.NilID()) {
+		if s.program == nil {
+			continue
+		}
+		if envScoped && s.EnvironmentId != evtEnvId {
+			continue
+		}
+		output, err := expr.Run(s.program, env)
+		if err != nil {
+			log.WithPrefix("scheduler").
+				WithField("partition", s.EnvironmentId.String()).
+				WithField("schedule", s.Id).
+				WithError(err).
+				Warn("cannot evaluate scheduled transition condition")
+			continue
+		}
+		if matched, _ := output.(bool); !matched {
+			continue
+		}
+		if !ts.claim(s.Id) {
+			continue
+		}
+		s.triggeredBy = evt.GetServiceName()
+		fired := ts.fire(s)
+		if ts.release(s.Id, fired) {
+			ts.notifyChanged(s.EnvironmentId)
+		}
+	}
+}
+
+// claim marks a pending conditional schedule as being fired, it returns false
+// if the schedule was cancelled or is already being fired. The schedule is
+// kept meanwhile, so that a cancellation is not undone if it cannot be fired.
+func (ts *transitionScheduler) claim(id string) bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if _, pending := ts.schedules[id]; !pending {
+		return false
+	}
+	if _, busy := ts.firing[id]; busy {
+		return false
+	}
+	ts.firing[id] = struct{}{}
+	return true
+}
+
+// release ends the firing of a claimed schedule, and removes it if it fired.
+// It returns true if the schedule was removed, i.e. it was not cancelled in
+// the meantime.
+func (ts *transitionScheduler) release(id string, fired bool) bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	delete(ts.firing, id)
+	if _, pending := ts.schedules[id]; !fired || !pending {
+		return false
+	}
+	delete(ts.schedules, id)
+	return true
+}
+
+// eventEnvironmentId returns the environment which evt concerns, if any.
+func eventEnvironmentId(evt event.IntegratedServiceEvent) (uid.ID, bool) {
+	switch typed := evt.(type) {
+	case *odcevent.OdcPartitionStateChangeEvent:
+		return typed.GetEnvironmentId(), true
+	}
+	return uid.NilID(), false
+}
+
+// conditionEnv exposes an integrated service event to schedule conditions.
+// All events provide `service` and `event`, LHC events add `beamMode`,
+// `beamType`, `fillNumber` and `fillingSchemeName`, and ODC partition state
+// changes add `state` and `environmentId`. The latter are only evaluated for
+// the schedules of the environment they concern.
+func conditionEnv(evt event.IntegratedServiceEvent) map[string]interface{} {
+	env := map[string]interface{}{
+		"service": evt.GetServiceName(),
+		"event":   evt.GetName(),
+	}
+	switch typed := evt.(type) {
+	case *lhcevent.LhcStateChangeEvent:
+		beamInfo := typed.GetBeamInfo()
+		env["beamMode"] = beamInfo.BeamMode.String()
+		env["beamType"] = beamInfo.BeamType
+		env["fillNumber"] = int(beamInfo.FillNumber)
+		env["fillingSchemeName"] = beamInfo.FillingSchemeName
+	case *odcevent.OdcPartitionStateChangeEvent:
+		env["state"] = typed.GetState()
+		env["environmentId"] = typed.GetEnvironmentId().String()
+	}
+	return env
+}
+
+func (envs *Manager) ScheduleTransition(s *TransitionSchedule) error {
+	if s == nil {
+		return errors.New("cannot schedule nil transition")
+	}
+	if MakeTransition(envs.taskman, s.Optype) == nil {
+		return fmt.Errorf("transition %s cannot be scheduled", s.Optype.String())
+	}
+	env, err := envs.environment(s.EnvironmentId)
+	if err != nil {
+		return err
+	}
+	if envs.scheduler == nil {
+		return errors.New("transition scheduler not running")
+	}
+	if err = envs.scheduler.add(s); err != nil {
+		return err
+	}
+	env.persist()
+
+	log.WithPrefix("scheduler").
+		WithField("partition", s.EnvironmentId.String()).
+		WithField("schedule", s.Id).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Infof("transition %s scheduled", s.Optype.String())
+	return nil
+}
+
+func (envs *Manager) ScheduledTransitions(envId uid.ID) []*TransitionSchedule {
+	return envs.scheduler.list(envId)
+}
+
+func (envs *Manager) CancelScheduledTransition(id string) (*TransitionSchedule, error) {
+	s, ok := envs.scheduler.cancel(id)
+	if !ok {
+		return nil, fmt.Errorf("no scheduled transition with id %s", id)
+	}
+	envs.persistSchedules(s.EnvironmentId)
+	log.WithPrefix("scheduler").
+		WithField("partition", s.EnvironmentId.String()).
+		WithField("schedule", s.Id).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Infof("scheduled transition %s cancelled", s.Optype.String())
+	return s, nil
+}
+
+// persistSchedules writes the environment to the journal after its pending
+// schedules changed.
+func (envs *Manager) persistSchedules(envId uid.ID) {
+	if env, err := envs.environment(envId); err == nil {
+		env.persist()
+	}
+}
+
+func (envs *Manager) currentRunStart(envId uid.ID) (time.Time, bool) {
+	env, err := envs.environment(envId)
+	if err != nil || env.CurrentState() != "RUNNING" {
+		return time.Time{}, false
+	}
+	startMs, err := strconv.ParseInt(env.GetKV("", "run_start_completion_time_ms"), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(startMs), true
+}
+
+func (envs *Manager) fireScheduledTransition(s *TransitionSchedule) bool {
+	logger := log.WithPrefix("scheduler").
+		WithField("partition", s.EnvironmentId.String()).
+		WithField("schedule", s.Id)
+
+	env, err := envs.environment(s.EnvironmentId)
+	if err != nil {
+		logger.WithError(err).Warn("environment of scheduled transition not found")
+		return true
+	}
+	trans := MakeTransition(envs.taskman, s.Optype)
+	env.Mu.RLock()
+	possible := env.Sm.Can(trans.eventName()) && env.currentTransition == ""
+	env.Mu.RUnlock()
+	if !possible && s.program == nil {
+		// a time-based schedule is dropped by the scheduler, so this is its outcome
+		msg := fmt.Sprintf("scheduled transition %s not possible in state %s, schedule dropped", trans.eventName(), env.CurrentState())
+		logger.WithField(infologger.Level, infologger.IL_Ops).
+			Error(msg)
+		the.EventWriterWithTopic(topic.Environment).WriteEvent(&evpb.Ev_EnvironmentEvent{
+			EnvironmentId:        env.Id().String(),
+			State:                env.CurrentState(),
+			RunNumber:            env.GetCurrentRunNumber(),
+			Transition:           trans.eventName(),
+			TransitionStatus:     evpb.OpStatus_DONE_ERROR,
+			Error:                msg,
+			Message:              "scheduled transition dropped",
+			LastRequestUser:      s.RequestUser,
+			WorkflowTemplateInfo: env.GetWorkflowInfo(),
+		})
+		return false
+	}
+	if !possible {
+		logger.WithField("envState", env.CurrentState()).
+			Warnf("scheduled transition %s not possible in the current state", trans.eventName())
+		return false
+	}
+
+	specialUser := evpb.SpecialUserId_TIMER
+	if s.triggeredBy == "LHC" {
+		specialUser = evpb.SpecialUserId_LHC
+	}
+	go func() {
+		logger.WithField(infologger.Level, infologger.IL_Ops).
+			WithField("run", env.GetCurrentRunNumber()).
+			Infof("executing scheduled transition %s", trans.eventName())
+
+		env.SetLastRequestUser(evpb.SpecialUser(specialUser))
+		err := env.TryTransition(trans)
+		if err == nil {
+			return
+		}
+		logger.WithError(err).
+			Errorf("scheduled transition %s failed, transitioning into ERROR", trans.eventName())
+		if env.CurrentState() != "ERROR" {
+			the.EventWriterWithTopic(topic.Environment).WriteEvent(
+				NewEnvGoErrorEvent(env, fmt.Sprintf("scheduled transition %s failed: %s", trans.eventName(), err.Error())),
+			)
+			err = env.TryTransition(NewGoErrorTransition(envs.taskman))
+			if err != nil {
+				HandleFailedGoError(err, env)
+			}
+		}
+	}()
+	return true
+}
@@ -0,0 +1,238 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	lhcevent "github.com/AliceO2Group/Control/core/integration/lhc/event"
+	odcevent "github.com/AliceO2Group/Control/core/integration/odc/event"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("transition scheduler", func() {
+	var (
+		ts        *transitionScheduler
+		now       time.Time
+		fired     []string
+		canFire   bool
+		runStarts map[uid.ID]time.Time
+		envId     uid.ID
+	)
+
+	lhcEvent := func(mode evpb.BeamMode) event.IntegratedServiceEvent {
+		return &lhcevent.LhcStateChangeEvent{
+			IntegratedServiceEventBase: event.IntegratedServiceEventBase{ServiceName: "LHC"},
+			BeamInfo:                   lhcevent.BeamInfo{BeamMode: mode, FillNumber: 9000},
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+		fired = nil
+		canFire = true
+		runStarts = make(map[uid.ID]time.Time)
+		envId = uid.New()
+		ts = newTransitionScheduler(
+			func(s *TransitionSchedule) bool {
+				if canFire {
+					fired = append(fired, s.Id)
+				}
+				return canFire
+			},
+			func(id uid.ID) (time.Time, bool) {
+				start, ok := runStarts[id]
+				return start, ok
+			},
+		)
+		ts.now = func() time.Time { return now }
+	})
+
+	It("requires exactly one valid trigger", func() {
+		Expect(ts.add(&TransitionSchedule{EnvironmentId: envId})).NotTo(Succeed())
+		Expect(ts.add(&TransitionSchedule{EnvironmentId: envId, At: now.Add(time.Hour), AfterRunDuration: time.Hour})).NotTo(Succeed())
+		Expect(ts.add(&TransitionSchedule{EnvironmentId: envId, At: now.Add(-time.Hour)})).NotTo(Succeed())
+		Expect(ts.add(&TransitionSchedule{EnvironmentId: envId, AfterRunDuration: -time.Hour})).NotTo(Succeed())
+		Expect(ts.add(&TransitionSchedule{EnvironmentId: envId, Condition: `beamMode ==`})).NotTo(Succeed())
+		Expect(ts.add(&TransitionSchedule{EnvironmentId: envId, Condition: `"STABLE_BEAMS"`})).NotTo(Succeed())
+		Expect(ts.list(uid.NilID())).To(BeEmpty())
+	})
+
+	It("fires at the scheduled time", func() {
+		s := &TransitionSchedule{EnvironmentId: envId, Optype: pb.ControlEnvironmentRequest_STOP_ACTIVITY, At: now.Add(time.Minute)}
+		Expect(ts.add(s)).To(Succeed())
+
+		ts.tick()
+		Expect(fired).To(BeEmpty())
+
+		now = now.Add(time.Minute)
+		ts.tick()
+		Expect(fired).To(Equal([]string{s.Id}))
+		Expect(ts.list(uid.NilID())).To(BeEmpty())
+	})
+
+	It("fires once the run has lasted long enough", func() {
+		s := &TransitionSchedule{EnvironmentId: envId, Optype: pb.ControlEnvironmentRequest_STOP_ACTIVITY, AfterRunDuration: time.Hour}
+		Expect(ts.add(s)).To(Succeed())
+
+		now = now.Add(2 * time.Hour)
+		ts.tick()
+		Expect(fired).To(BeEmpty())
+
+		runStarts[envId] = now.Add(-30 * time.Minute)
+		ts.tick()
+		Expect(fired).To(BeEmpty())
+
+		now = now.Add(30 * time.Minute)
+		ts.tick()
+		Expect(fired).To(Equal([]string{s.Id}))
+	})
+
+	It("fires when an integrated service event satisfies the condition", func() {
+		s := &TransitionSchedule{
+			EnvironmentId: envId,
+			Optype:        pb.ControlEnvironmentRequest_START_ACTIVITY,
+			Condition:     `service == "LHC" && beamMode == "STABLE_BEAMS"`,
+		}
+		Expect(ts.add(s)).To(Succeed())
+
+		ts.onIntegratedServiceEvent(lhcEvent(evpb.BeamMode_SETUP))
+		ts.onIntegratedServiceEvent(&odcevent.OdcPartitionStateChangeEvent{
+			IntegratedServiceEventBase: event.IntegratedServiceEventBase{ServiceName: "ODC"},
+			EnvironmentId:              envId,
+			State:                      "RUNNING",
+		})
+		ts.tick()
+		Expect(fired).To(BeEmpty())
+
+		ts.onIntegratedServiceEvent(lhcEvent(evpb.BeamMode_STABLE_BEAMS))
+		Expect(fired).To(Equal([]string{s.Id}))
+		Expect(s.triggeredBy).To(Equal("LHC"))
+		Expect(ts.list(uid.NilID())).To(BeEmpty())
+	})
+
+	It("keeps a conditional schedule pending if the transition is not possible yet", func() {
+		s := &TransitionSchedule{EnvironmentId: envId, Condition: `beamMode == "STABLE_BEAMS"`}
+		Expect(ts.add(s)).To(Succeed())
+
+		canFire = false
+		ts.onIntegratedServiceEvent(lhcEvent(evpb.BeamMode_STABLE_BEAMS))
+		Expect(ts.list(uid.NilID())).To(HaveLen(1))
+
+		canFire = true
+		ts.onIntegratedServiceEvent(lhcEvent(evpb.BeamMode_STABLE_BEAMS))
+		Expect(fired).To(Equal([]string{s.Id}))
+	})
+
+	It("does not bring back a schedule cancelled while it was being fired", func() {
+		s := &TransitionSchedule{EnvironmentId: envId, Condition: `beamMode == "STABLE_BEAMS"`}
+		Expect(ts.add(s)).To(Succeed())
+		other := &TransitionSchedule{EnvironmentId: envId, Condition: `beamMode == "STABLE_BEAMS"`}
+		Expect(ts.add(other)).To(Succeed())
+
+		// e.g. the environment is destroyed while the transition is found not possible
+		ts.fire = func(s *TransitionSchedule) bool {
+			ts.cancelForEnvironment(s.EnvironmentId)
+			return false
+		}
+		ts.onIntegratedServiceEvent(lhcEvent(evpb.BeamMode_STABLE_BEAMS))
+		Expect(ts.list(uid.NilID())).To(BeEmpty())
+	})
+
+	It("matches environment events only against the schedules of their environment", func() {
+		otherEnvId := uid.New()
+		s := &TransitionSchedule{EnvironmentId: envId, Condition: `service == "ODC" && state == "ERROR"`}
+		Expect(ts.add(s)).To(Succeed())
+
+		ts.onIntegratedServiceEvent(&odcevent.OdcPartitionStateChangeEvent{
+			IntegratedServiceEventBase: event.IntegratedServiceEventBase{ServiceName: "ODC"},
+			EnvironmentId:              otherEnvId,
+			State:                      "ERROR",
+		})
+		Expect(fired).To(BeEmpty())
+
+		ts.onIntegratedServiceEvent(&odcevent.OdcPartitionStateChangeEvent{
+			IntegratedServiceEventBase: event.IntegratedServiceEventBase{ServiceName: "ODC"},
+			EnvironmentId:              envId,
+			State:                      "ERROR",
+		})
+		Expect(fired).To(Equal([]string{s.Id}))
+	})
+
+	It("reports the environments whose schedules fired", func() {
+		var changed []uid.ID
+		ts.changed = func(id uid.ID) { changed = append(changed, id) }
+		s := &TransitionSchedule{EnvironmentId: envId, At: now.Add(time.Minute)}
+		Expect(ts.add(s)).To(Succeed())
+
+		canFire = false
+		now = now.Add(time.Minute)
+		ts.tick()
+		Expect(fired).To(BeEmpty())
+		Expect(ts.list(uid.NilID())).To(BeEmpty())
+		Expect(changed).To(Equal([]uid.ID{envId}))
+	})
+
+	It("restores schedules with their id", func() {
+		createdWhen := now.Add(-time.Hour)
+		s := &TransitionSchedule{Id: "2oDvieFrVTi", EnvironmentId: envId, Condition: `beamMode == "STABLE_BEAMS"`, CreatedWhen: createdWhen}
+		Expect(ts.restore(s)).To(Succeed())
+		Expect(ts.list(envId)).To(Equal([]*TransitionSchedule{s}))
+		Expect(s.CreatedWhen).To(Equal(createdWhen))
+
+		ts.onIntegratedServiceEvent(lhcEvent(evpb.BeamMode_STABLE_BEAMS))
+		Expect(fired).To(Equal([]string{"2oDvieFrVTi"}))
+
+		Expect(ts.restore(&TransitionSchedule{EnvironmentId: envId, At: now.Add(time.Hour)})).NotTo(Succeed())
+		Expect(ts.restore(&TransitionSchedule{Id: "2oDvieFrVTj", EnvironmentId: envId, At: now.Add(-time.Hour)})).NotTo(Succeed())
+	})
+
+	It("lists and cancels schedules", func() {
+		otherEnvId := uid.New()
+		first := &TransitionSchedule{EnvironmentId: envId, At: now.Add(time.Hour)}
+		Expect(ts.add(first)).To(Succeed())
+		now = now.Add(time.Second)
+		second := &TransitionSchedule{EnvironmentId: envId, AfterRunDuration: time.Hour}
+		Expect(ts.add(second)).To(Succeed())
+		other := &TransitionSchedule{EnvironmentId: otherEnvId, At: now.Add(time.Hour)}
+		Expect(ts.add(other)).To(Succeed())
+
+		Expect(ts.list(envId)).To(Equal([]*TransitionSchedule{first, second}))
+		Expect(ts.list(uid.NilID())).To(HaveLen(3))
+
+		cancelled, ok := ts.cancel(first.Id)
+		Expect(ok).To(BeTrue())
+		Expect(cancelled).To(Equal(first))
+		_, ok = ts.cancel(first.Id)
+		Expect(ok).To(BeFalse())
+
+		ts.cancelForEnvironment(envId)
+		Expect(ts.list(uid.NilID())).To(Equal([]*TransitionSchedule{other}))
+	})
+})
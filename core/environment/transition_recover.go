@@ -0,0 +1,273 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/common/monitoring"
+	"github.com/AliceO2Group/Control/core/integration"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/task/taskop"
+	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/AliceO2Group/Control/core/workflow/callable"
+)
+
+// recoverCleanupCalls are the integration plugin calls which release what an environment holds in
+// external systems, in the order in which RECOVER runs them. Only the calls of enabled plugins are run.
+var recoverCleanupCalls = []struct {
+	plugin string
+	fn     string
+}{
+	{"trg", "EnsureRunStop"},
+	{"trg", "EnsureRunUnload"},
+	{"trg", "Cleanup"},
+	{"dcs", "Cleanup"},
+	{"odc", "EnsureCleanup"},
+}
+
+func NewRecoverTransition(taskman *task.Manager) Transition {
+	return &RecoverTransition{
+		baseTransition: baseTransition{
+			name:    "RECOVER",
+			taskman: taskman,
+		},
+	}
+}
+
+// RecoverTransition brings an environment from ERROR back to DEPLOYED, keeping its ID.
+// Healthy tasks are stopped and reset to STANDBY, failed tasks are killed and redeployed,
+// and the cleanup calls of the TRG, DCS and ODC plugins are run.
+type RecoverTransition struct {
+	baseTransition
+}
+
+func (t RecoverTransition) do(env *Environment) (err error) {
+	if env == nil {
+		return errors.New("cannot transition in NIL environment")
+	}
+
+	metric := t.transitionDoMetric(env)
+	defer monitoring.TimerSendSingle(&metric, monitoring.Millisecond)()
+
+	wf := env.Workflow()
+
+	// Hooks are one-shot tasks, they are left alone
+	tasks := wf.GetTasks().Filtered(func(t *task.Task) bool {
+		return t.GetControlMode() != controlmode.HOOK
+	})
+	isHealthy := func(t *task.Task) bool {
+		if t.GetStatus() != task.ACTIVE {
+			return false
+		}
+		switch t.GetState() {
+		case sm.STANDBY, sm.CONFIGURED, sm.RUNNING:
+			return true
+		}
+		return false
+	}
+	failedTasks := tasks.Filtered(func(t *task.Task) bool {
+		return !isHealthy(t)
+	})
+	toStop := tasks.Filtered(func(t *task.Task) bool {
+		return isHealthy(t) && t.GetState() == sm.RUNNING
+	})
+	toReset := tasks.Filtered(func(t *task.Task) bool {
+		return isHealthy(t) && t.GetState() != sm.STANDBY
+	})
+
+	log.WithField("partition", env.Id().String()).
+		WithField(infologger.Level, infologger.IL_Support).
+		Infof("recovering environment: %d tasks to stop, %d tasks to reset, %d failed tasks to redeploy", len(toStop), len(toReset), len(failedTasks))
+
+	if len(toStop) > 0 {
+		t.taskman.MessageChannel <- task.NewTransitionTaskMessage(
+			toStop,
+			sm.RUNNING.String(),
+			sm.STOP.String(),
+			sm.CONFIGURED.String(),
+			nil,
+			env.Id(),
+		)
+		incomingEv := <-env.stateChangedCh
+		if tasksStateErrors := incomingEv.GetTasksStateChangedError(); tasksStateErrors != nil {
+			metric.AddResult(monitoring.ERROR)
+			return tasksStateErrors
+		}
+	}
+
+	if len(toReset) > 0 {
+		t.taskman.MessageChannel <- task.NewTransitionTaskMessage(
+			toReset,
+			sm.CONFIGURED.String(),
+			sm.RESET.String(),
+			sm.STANDBY.String(),
+			nil,
+			env.Id(),
+		)
+		incomingEv := <-env.stateChangedCh
+		if tasksStateErrors := incomingEv.GetTasksStateChangedError(); tasksStateErrors != nil {
+			metric.AddResult(monitoring.ERROR)
+			return tasksStateErrors
+		}
+	}
+
+	if len(failedTasks) > 0 {
+		// Failed tasks are detached from their roles, so that new descriptors are generated for them
+		for _, failedTask := range failedTasks {
+			if parent := failedTask.GetParent(); parent != nil {
+				parent.SetTask(nil)
+			}
+			failedTask.SetParent(nil)
+		}
+		_, _, killErr := t.taskman.KillTasks(failedTasks.GetTaskIds())
+		if killErr != nil {
+			log.WithField("partition", env.Id().String()).
+				WithField(infologger.Level, infologger.IL_Support).
+				WithError(killErr).
+				Warn("some failed tasks could not be killed during recovery")
+		}
+	}
+
+	runRecoverCleanupCalls(wf)
+
+	taskDescriptors := wf.GenerateTaskDescriptors()
+	if len(taskDescriptors) != 0 {
+		t.taskman.MessageChannel <- task.NewEnvironmentMessage(taskop.AcquireTasks, env.Id(), nil, taskDescriptors)
+	}
+
+	deploymentTimeout := acquireDeploymentTimeout(wf)
+	deadline := time.After(deploymentTimeout)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+WORKFLOW_ACTIVE_LOOP:
+	for {
+		switch wf.GetStatus() {
+		case task.ACTIVE:
+			break WORKFLOW_ACTIVE_LOOP
+		case task.UNDEPLOYABLE:
+			err = errors.New("workflow is undeployable, recovery failed")
+			break WORKFLOW_ACTIVE_LOOP
+		}
+
+		select {
+		case <-ticker.C:
+			continue
+		case <-deadline:
+			err = fmt.Errorf("workflow redeployment timed out (%s), recovery failed", deploymentTimeout.String())
+			break WORKFLOW_ACTIVE_LOOP
+		}
+	}
+	if err != nil {
+		metric.AddResult(monitoring.ERROR)
+		return
+	}
+
+	// Roles keep the ERROR state of their former task until told otherwise
+	workflow.LeafWalk(wf, func(role workflow.Role) {
+		if role.GetState() != sm.ERROR {
+			return
+		}
+		if pu, ok := role.(workflow.PublicUpdatable); ok {
+			pu.UpdateState(sm.STANDBY)
+		}
+	})
+
+	if wfState := wf.GetState(); wfState == sm.ERROR {
+		failedRoles := make([]string, 0)
+		workflow.LeafWalk(wf, func(role workflow.Role) {
+			if role.GetState() == sm.ERROR {
+				failedRoles = append(failedRoles, role.GetPath())
+			}
+		})
+		sort.Strings(failedRoles)
+		metric.AddResult(monitoring.ERROR)
+		return fmt.Errorf("workflow still in ERROR after recovery [failed roles: %s]", strings.Join(failedRoles, ", "))
+	}
+
+	env.subscribeToWfState(t.taskman)
+
+	env.sendEnvironmentEvent(&event.EnvironmentEvent{EnvironmentID: env.Id().String(), State: "DEPLOYED"})
+	metric.AddResult(monitoring.SUCCESS)
+	return
+}
+
+type rootCallRole interface {
+	workflow.Role
+	SendEvent(event.Event)
+	SetGlobalRuntimeVar(key string, value string)
+	SetGlobalRuntimeVars(kv map[string]string)
+	DeleteGlobalRuntimeVar(key string)
+	DeleteGlobalRuntimeVars(keys []string)
+}
+
+// recoverCallParent runs the RECOVER cleanup calls in the context of the root role of the workflow,
+// which has no task traits of its own.
+type recoverCallParent struct {
+	rootCallRole
+}
+
+func (recoverCallParent) GetTaskTraits() task.Traits {
+	return task.Traits{Trigger: "RECOVER"}
+}
+
+// runRecoverCleanupCalls runs the cleanup calls of the enabled integration plugins. Failures are
+// logged, but they don't prevent the recovery of the environment.
+func runRecoverCleanupCalls(wf workflow.Role) {
+	enabledPlugins := make(map[string]struct{})
+	for _, plugin := range integration.PluginsInstance() {
+		enabledPlugins[plugin.GetName()] = struct{}{}
+	}
+
+	root, ok := wf.(rootCallRole)
+	if !ok {
+		log.WithField("partition", wf.GetEnvironmentId().String()).
+			Warn("cannot run cleanup calls during environment recovery: unexpected root role type")
+		return
+	}
+	parent := recoverCallParent{rootCallRole: root}
+	for _, cleanupCall := range recoverCleanupCalls {
+		if _, enabled := enabledPlugins[cleanupCall.plugin]; !enabled {
+			continue
+		}
+		funcCall := fmt.Sprintf("%s.%s()", cleanupCall.plugin, cleanupCall.fn)
+		err := callable.NewCall(funcCall, "", parent).Call()
+		if err != nil {
+			log.WithField("partition", wf.GetEnvironmentId().String()).
+				WithField("call", funcCall).
+				WithField(infologger.Level, infologger.IL_Ops).
+				WithError(err).
+				Warn("cleanup call failed during environment recovery")
+		}
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/integration"
+	"github.com/AliceO2Group/Control/core/integration/testplugin"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/workflow"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("recovering environments", func() {
+	var (
+		env               *Environment
+		call              workflow.Role
+		savedCleanupCalls []struct {
+			plugin string
+			fn     string
+		}
+	)
+	BeforeEach(func() {
+		envId, err := uid.FromString("2oDvieFrVTi")
+		Expect(err).NotTo(HaveOccurred())
+
+		env, err = newEnvironment(map[string]string{}, envId)
+		Expect(err).NotTo(HaveOccurred())
+
+		call = workflow.NewCallRole("call",
+			task.Traits{Trigger: "before_CONFIGURE", Timeout: "5s", Critical: true},
+			"testplugin.Noop()",
+			"")
+		env.workflow = workflow.NewAggregatorRole("root", []workflow.Role{call})
+		workflow.LinkChildrenToParents(env.workflow)
+
+		// the call role is ACTIVE as after deployment, but it was left in ERROR by the failed transition
+		call.(workflow.PublicUpdatable).UpdateStatus(task.ACTIVE)
+		call.(workflow.PublicUpdatable).UpdateState(sm.ERROR)
+		env.Sm.SetState("ERROR")
+
+		savedCleanupCalls = recoverCleanupCalls
+		recoverCleanupCalls = []struct {
+			plugin string
+			fn     string
+		}{
+			{"testplugin", "Test"},
+			{"notenabled", "Cleanup"},
+		}
+		DeferCleanup(func() {
+			recoverCleanupCalls = savedCleanupCalls
+		})
+	})
+
+	It("should run the cleanup calls and bring the environment to DEPLOYED", func() {
+		err := env.Sm.Event(context.Background(), "RECOVER", NewRecoverTransition(nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env.CurrentState()).To(Equal("DEPLOYED"))
+
+		v, ok := env.workflow.GetUserVars().Get("root_called")
+		Expect(ok).To(BeTrue())
+		Expect(v).To(Equal("true"))
+	})
+
+	It("should clean up the trigger state among the other integrated services", func() {
+		trg := &recordingPlugin{name: "trg"}
+		integration.Reset()
+		integration.RegisterPlugin("testplugin", "testPluginEndpoint", testplugin.NewPlugin)
+		integration.RegisterPlugin("trg", "testPluginEndpoint", func(string) integration.Plugin { return trg })
+		viper.Set("integrationPlugins", []string{"testplugin", "trg"})
+		DeferCleanup(func() {
+			integration.Reset()
+			integration.RegisterPlugin("testplugin", "testPluginEndpoint", testplugin.NewPlugin)
+			viper.Set("integrationPlugins", []string{"testplugin"})
+		})
+		recoverCleanupCalls = savedCleanupCalls
+
+		err := env.Sm.Event(context.Background(), "RECOVER", NewRecoverTransition(nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env.CurrentState()).To(Equal("DEPLOYED"))
+		Expect(trg.getCalls()).To(Equal([]string{"EnsureRunStop", "EnsureRunUnload", "Cleanup"}))
+	})
+})
+
+// recordingPlugin records the calls made to its CallStack functions, which all succeed.
+type recordingPlugin struct {
+	name  string
+	mu    sync.Mutex
+	calls []string
+}
+
+func (p *recordingPlugin) GetName() string                                           { return p.name }
+func (p *recordingPlugin) GetPrettyName() string                                     { return p.name }
+func (p *recordingPlugin) GetEndpoint() string                                       { return "" }
+func (p *recordingPlugin) GetConnectionState() string                                { return "READY" }
+func (p *recordingPlugin) GetData(_ []any) string                                    { return "" }
+func (p *recordingPlugin) GetEnvironmentsData(_ []uid.ID) map[uid.ID]string          { return nil }
+func (p *recordingPlugin) GetEnvironmentsShortData(_ []uid.ID) map[uid.ID]string     { return nil }
+func (p *recordingPlugin) Init(_ string) error                                       { return nil }
+func (p *recordingPlugin) ObjectStack(_, _ map[string]string) map[string]interface{} { return nil }
+func (p *recordingPlugin) Destroy() error                                            { return nil }
+func (p *recordingPlugin) CallStack(_ interface{}) map[string]interface{} {
+	stack := make(map[string]interface{})
+	for _, name := range []string{"EnsureRunStop", "EnsureRunUnload", "Cleanup"} {
+		stack[name] = func() string {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.calls = append(p.calls, name)
+			return ""
+		}
+	}
+	return stack
+}
+
+func (p *recordingPlugin) getCalls() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return slices.Clone(p.calls)
+}
@@ -83,33 +83,7 @@ func (t StartActivityTransition) do(env *Environment) (err error) {
 		WithField(infologger.Level, infologger.IL_Support).
 		Info("starting new run")
 
-	cleanupCount := 0
-	cleanupCountS, ok := env.GlobalVars.Get("__fmq_cleanup_count")
-	if ok && len(cleanupCountS) > 0 {
-		var parseErr error
-		cleanupCount, parseErr = strconv.Atoi(cleanupCountS)
-		if parseErr != nil {
-			cleanupCount = 1 // something was there, even though non-parsable, so we signal to clean up
-		}
-	}
-
-	args := controlcommands.PropertyMap{
-		"runNumber": strconv.FormatUint(uint64(runNumber), 10),
-		"cleanup":   strconv.Itoa(cleanupCount),
-	}
-
-	// Get a handle to the consolidated var stack of the root role of the env's workflow
-	if wf := env.Workflow(); wf != nil {
-		if cvs, cvsErr := wf.ConsolidatedVarStack(); cvsErr == nil {
-			for _, key := range StartActivityParameterKeys {
-				if value, ok := cvs[key]; ok {
-					// we push the above parameters with both camelCase and snake_case identifiers for convenience
-					args[strcase.ToLowerCamel(key)] = value
-					args[key] = value
-				}
-			}
-		}
-	}
+	args := startActivityArgs(env, runNumber)
 
 	taskmanMessage := task.NewTransitionTaskMessage(
 		workflow.GetActiveTasks(env.Workflow()),
@@ -141,3 +115,35 @@ func (t StartActivityTransition) do(env *Environment) (err error) {
 	metric.AddResult(monitoring.SUCCESS)
 	return
 }
+
+// startActivityArgs builds the arguments pushed to the tasks of env along with the START event.
+func startActivityArgs(env *Environment, runNumber uint32) controlcommands.PropertyMap {
+	cleanupCount := 0
+	cleanupCountS, ok := env.GlobalVars.Get("__fmq_cleanup_count")
+	if ok && len(cleanupCountS) > 0 {
+		var parseErr error
+		cleanupCount, parseErr = strconv.Atoi(cleanupCountS)
+		if parseErr != nil {
+			cleanupCount = 1 // something was there, even though non-parsable, so we signal to clean up
+		}
+	}
+
+	args := controlcommands.PropertyMap{
+		"runNumber": strconv.FormatUint(uint64(runNumber), 10),
+		"cleanup":   strconv.Itoa(cleanupCount),
+	}
+
+	// Get a handle to the consolidated var stack of the root role of the env's workflow
+	if wf := env.Workflow(); wf != nil {
+		if cvs, cvsErr := wf.ConsolidatedVarStack(); cvsErr == nil {
+			for _, key := range StartActivityParameterKeys {
+				if value, ok := cvs[key]; ok {
+					// we push the above parameters with both camelCase and snake_case identifiers for convenience
+					args[strcase.ToLowerCamel(key)] = value
+					args[key] = value
+				}
+			}
+		}
+	}
+	return args
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/AliceO2Group/Control/common/logger/infologger"
 	"github.com/AliceO2Group/Control/common/monitoring"
 	pb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/sm"
 	"github.com/AliceO2Group/Control/core/workflow"
@@ -80,6 +81,27 @@ func parseWorkflowPublicInfo(workflowExpr string) (WorkflowPublicInfo, error) {
 	return WorkflowPublicInfo{IsPublic: isPublic, Name: name, Description: description}, nil
 }
 
+// ValidateUserVars checks userVars against the VarSpecs of the public variables
+// of the given workflow template, and returns a *repos.VarSpecValidationError
+// if any of them is invalid. Failures to resolve or parse the workflow template
+// are not reported here, they are left to CreateEnvironment.
+func ValidateUserVars(workflowExpr string, userVars map[string]string) error {
+	resolvedWorkflowPath, _, err := the.RepoManager().GetWorkflow(workflowExpr)
+	if err != nil {
+		return nil
+	}
+
+	_, _, varSpecMap, err := repos.ParseWorkflowPublicVariableInfo(resolvedWorkflowPath)
+	if err != nil {
+		log.WithField("workflow", workflowExpr).
+			WithError(err).
+			Debug("cannot parse public variables of workflow, user variables not validated")
+		return nil
+	}
+
+	return varSpecMap.Validate(userVars)
+}
+
 func JSONSliceToSlice(payload string) (slice []string, err error) {
 	slice = make([]string, 0)
 	err = json.Unmarshal([]byte(payload), &slice)
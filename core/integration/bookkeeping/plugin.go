@@ -1563,6 +1563,19 @@ func (p *Plugin) CallStack(data interface{}) (stack map[string]interface{}) {
 	return
 }
 
+// CallStackFunctions lists the CallStack functions explicitly, since the
+// CallStack cannot be built without a live environment. Keep it in sync.
+func (p *Plugin) CallStackFunctions() []string {
+	return []string{
+		"StartOfRun",
+		"UpdateRunStart",
+		"UpdateRunStop",
+		"CreateEnv",
+		"UpdateEnv",
+		"RetrieveFillInfo",
+	}
+}
+
 func (p *Plugin) Destroy() error {
 	return nil
 }
@@ -0,0 +1,238 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package integration
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type BreakerState int
+
+const (
+	BREAKER_CLOSED BreakerState = iota
+	BREAKER_OPEN
+	BREAKER_HALF_OPEN
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BREAKER_CLOSED:
+		return "CLOSED"
+	case BREAKER_OPEN:
+		return "OPEN"
+	case BREAKER_HALF_OPEN:
+		return "HALF_OPEN"
+	}
+	return "UNKNOWN"
+}
+
+var (
+	breakersMu sync.Mutex
+	breakers   = make(map[string]*CircuitBreaker)
+)
+
+// CircuitBreaker tracks the availability of the endpoint behind an
+// integration plugin. After a number of consecutive calls fail because the
+// endpoint is unavailable the breaker opens, and further calls through the
+// plugin fail fast instead of each waiting for its own timeout. Once the
+// cooldown elapses, or as soon as the plugin reports a READY connection, a
+// single probe call is let through to decide whether to close it again.
+type CircuitBreaker struct {
+	mu sync.Mutex
+
+	name      string
+	threshold int
+	cooldown  time.Duration
+	connState func() string
+
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	lastError string
+	probing   bool
+}
+
+// ErrBreakerOpen is returned by Allow when a call must fail fast.
+type ErrBreakerOpen struct {
+	Plugin    string
+	LastError string
+	RetryIn   time.Duration
+}
+
+func (e *ErrBreakerOpen) Error() string {
+	return fmt.Sprintf("%s endpoint is unavailable, failing fast for another %s (last error: %s)",
+		e.Plugin, e.RetryIn.Round(time.Millisecond).String(), e.LastError)
+}
+
+func NewCircuitBreaker(name string, threshold int, cooldown time.Duration, connState func() string) *CircuitBreaker {
+	return &CircuitBreaker{
+		name:      name,
+		threshold: threshold,
+		cooldown:  cooldown,
+		connState: connState,
+		state:     BREAKER_CLOSED,
+	}
+}
+
+// BreakerFor returns the circuit breaker of the loaded plugin with the given
+// name, or nil if no such plugin is loaded or breakers are disabled with
+// integrationBreakerThreshold = 0.
+func BreakerFor(pluginName string) *CircuitBreaker {
+	threshold := viper.GetInt("integrationBreakerThreshold")
+	if threshold <= 0 {
+		return nil
+	}
+
+	var plugin Plugin
+	for _, p := range PluginsInstance() {
+		if p.GetName() == pluginName {
+			plugin = p
+			break
+		}
+	}
+	if plugin == nil {
+		return nil
+	}
+
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	breaker, ok := breakers[pluginName]
+	if !ok {
+		breaker = NewCircuitBreaker(pluginName, threshold, viper.GetDuration("integrationBreakerCooldown"), plugin.GetConnectionState)
+		breakers[pluginName] = breaker
+	}
+	return breaker
+}
+
+// Allow reports whether a call may proceed. While the breaker is open it
+// returns an *ErrBreakerOpen, and in the half-open state only one probe call
+// at a time is allowed.
+func (b *CircuitBreaker) Allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BREAKER_CLOSED:
+		return nil
+	case BREAKER_OPEN:
+		elapsed := time.Since(b.openedAt)
+		if elapsed < b.cooldown && !b.connectionReady() {
+			return &ErrBreakerOpen{Plugin: b.name, LastError: b.lastError, RetryIn: b.cooldown - elapsed}
+		}
+		b.state = BREAKER_HALF_OPEN
+		b.probing = true
+		log.WithField("plugin", b.name).
+			Info("circuit breaker half-open, probing endpoint")
+		return nil
+	case BREAKER_HALF_OPEN:
+		if b.probing {
+			return &ErrBreakerOpen{Plugin: b.name, LastError: b.lastError, RetryIn: 0}
+		}
+		b.probing = true
+		return nil
+	}
+	return nil
+}
+
+// connectionReady must be called with b.mu held.
+func (b *CircuitBreaker) connectionReady() bool {
+	return b.connState != nil && b.connState() == "READY"
+}
+
+// RecordSuccess closes the breaker. Calls which failed for reasons other than
+// the endpoint being unavailable should be recorded as successes too, since
+// the endpoint did respond.
+func (b *CircuitBreaker) RecordSuccess() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != BREAKER_CLOSED {
+		log.WithField("plugin", b.name).
+			Info("circuit breaker closed, endpoint available again")
+	}
+	b.state = BREAKER_CLOSED
+	b.failures = 0
+	b.probing = false
+	b.lastError = ""
+}
+
+// RecordFailure counts a call which failed because the endpoint was
+// unavailable, and opens the breaker once the threshold is reached or if the
+// probe call of a half-open breaker failed.
+func (b *CircuitBreaker) RecordFailure(reason string) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.lastError = reason
+	b.probing = false
+	if b.state == BREAKER_HALF_OPEN || (b.state == BREAKER_CLOSED && b.failures >= b.threshold) {
+		b.state = BREAKER_OPEN
+		b.openedAt = time.Now()
+		log.WithField("plugin", b.name).
+			WithField("failures", b.failures).
+			WithField("cooldown", b.cooldown.String()).
+			WithField("error", reason).
+			Warn("circuit breaker open, calls to this plugin will fail fast")
+	}
+}
+
+// Release ends a call which tells nothing about the availability of the
+// endpoint, e.g. because the call expression could not be evaluated.
+func (b *CircuitBreaker) Release() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *CircuitBreaker) GetState() BreakerState {
+	if b == nil {
+		return BREAKER_CLOSED
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func resetBreakers() {
+	breakersMu.Lock()
+	defer breakersMu.Unlock()
+	breakers = make(map[string]*CircuitBreaker)
+}
@@ -0,0 +1,106 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package integration_test
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/core/integration"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("circuit breaker", func() {
+	var (
+		connState string
+		breaker   *integration.CircuitBreaker
+	)
+
+	BeforeEach(func() {
+		connState = "TRANSIENT_FAILURE"
+		breaker = integration.NewCircuitBreaker("test", 2, 50*time.Millisecond, func() string { return connState })
+	})
+
+	It("opens after consecutive unavailable failures and fails fast", func() {
+		Expect(breaker.Allow()).To(Succeed())
+		breaker.RecordFailure("down")
+		Expect(breaker.GetState()).To(Equal(integration.BREAKER_CLOSED))
+		Expect(breaker.Allow()).To(Succeed())
+		breaker.RecordFailure("still down")
+		Expect(breaker.GetState()).To(Equal(integration.BREAKER_OPEN))
+
+		err := breaker.Allow()
+		var openErr *integration.ErrBreakerOpen
+		Expect(err).To(BeAssignableToTypeOf(openErr))
+		Expect(err.Error()).To(ContainSubstring("still down"))
+	})
+
+	It("resets the failure count on success", func() {
+		breaker.RecordFailure("down")
+		breaker.RecordSuccess()
+		breaker.RecordFailure("down")
+		Expect(breaker.GetState()).To(Equal(integration.BREAKER_CLOSED))
+	})
+
+	It("lets a single probe through after the cooldown", func() {
+		breaker.RecordFailure("down")
+		breaker.RecordFailure("down")
+		Eventually(breaker.Allow).WithTimeout(time.Second).Should(Succeed())
+		Expect(breaker.GetState()).To(Equal(integration.BREAKER_HALF_OPEN))
+		Expect(breaker.Allow()).NotTo(Succeed())
+
+		breaker.RecordFailure("probe failed")
+		Expect(breaker.GetState()).To(Equal(integration.BREAKER_OPEN))
+		Expect(breaker.Allow()).NotTo(Succeed())
+
+		Eventually(breaker.Allow).WithTimeout(time.Second).Should(Succeed())
+		breaker.RecordSuccess()
+		Expect(breaker.GetState()).To(Equal(integration.BREAKER_CLOSED))
+		Expect(breaker.Allow()).To(Succeed())
+	})
+
+	It("probes early when the connection is ready again", func() {
+		breaker = integration.NewCircuitBreaker("test", 1, time.Hour, func() string { return connState })
+		breaker.RecordFailure("down")
+		Expect(breaker.Allow()).NotTo(Succeed())
+		connState = "READY"
+		Expect(breaker.Allow()).To(Succeed())
+		Expect(breaker.GetState()).To(Equal(integration.BREAKER_HALF_OPEN))
+	})
+
+	It("frees the probe slot when a call is released", func() {
+		breaker = integration.NewCircuitBreaker("test", 1, time.Hour, func() string { return "READY" })
+		breaker.RecordFailure("down")
+		Expect(breaker.Allow()).To(Succeed())
+		breaker.Release()
+		Expect(breaker.Allow()).To(Succeed())
+	})
+
+	It("is a no-op when nil", func() {
+		var nilBreaker *integration.CircuitBreaker
+		Expect(nilBreaker.Allow()).To(Succeed())
+		nilBreaker.RecordFailure("down")
+		Expect(nilBreaker.GetState()).To(Equal(integration.BREAKER_CLOSED))
+	})
+})
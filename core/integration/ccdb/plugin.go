@@ -570,6 +570,10 @@ func (p *Plugin) uploadCurrentGRP(grp *GeneralRunParameters, envId string, refre
 	return nil
 }
 
+func (p *Plugin) CallStackFunctions() []string {
+	return callable.CallStackFunctions(p)
+}
+
 func (p *Plugin) Destroy() error {
 	return nil
 }
@@ -2333,6 +2333,10 @@ func (p *Plugin) parseDetectors(dcsDetectorsParam string) (detectors DCSDetector
 	return
 }
 
+func (p *Plugin) CallStackFunctions() []string {
+	return callable.CallStackFunctions(p)
+}
+
 func (p *Plugin) Destroy() error {
 	return p.dcsClient.Close()
 }
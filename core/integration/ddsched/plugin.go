@@ -1144,6 +1144,10 @@ func (p *Plugin) CallStack(data interface{}) (stack map[string]interface{}) {
 	return
 }
 
+func (p *Plugin) CallStackFunctions() []string {
+	return callable.CallStackFunctions(p)
+}
+
 func (p *Plugin) Destroy() error {
 	return p.ddSchedClient.Close()
 }
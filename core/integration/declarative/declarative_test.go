@@ -0,0 +1,400 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package declarative
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	ddpb "github.com/AliceO2Group/Control/core/integration/ddsched/protos"
+	"github.com/AliceO2Group/Control/core/integration/simulator"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/workflow/callable"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type fakeParentRole struct {
+	mu   sync.Mutex
+	vars map[string]string
+}
+
+func (f *fakeParentRole) GetPath() string                                  { return "fake" }
+func (f *fakeParentRole) GetTaskTraits() task.Traits                       { return task.Traits{} }
+func (f *fakeParentRole) GetEnvironmentId() uid.ID                         { return uid.NilID() }
+func (f *fakeParentRole) ConsolidatedVarStack() (map[string]string, error) { return nil, nil }
+func (f *fakeParentRole) SendEvent(event.Event)                            {}
+func (f *fakeParentRole) SetRuntimeVar(key string, value string)           {}
+func (f *fakeParentRole) SetRuntimeVars(kv map[string]string)              {}
+func (f *fakeParentRole) DeleteRuntimeVar(key string)                      {}
+func (f *fakeParentRole) DeleteRuntimeVars(keys []string)                  {}
+func (f *fakeParentRole) DeleteGlobalRuntimeVar(key string)                {}
+func (f *fakeParentRole) DeleteGlobalRuntimeVars(keys []string)            {}
+func (f *fakeParentRole) GetCurrentRunNumber() uint32                      { return 0 }
+func (f *fakeParentRole) SetGlobalRuntimeVar(key string, value string) {
+	f.SetGlobalRuntimeVars(map[string]string{key: value})
+}
+func (f *fakeParentRole) SetGlobalRuntimeVars(kv map[string]string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for k, v := range kv {
+		f.vars[k] = v
+	}
+}
+
+func newTestCall(parent *fakeParentRole, funcCall string, varStack map[string]string) *callable.Call {
+	call := callable.NewCall(funcCall, "", parent)
+	call.VarStack = varStack
+	return call
+}
+
+func writeFile(dir, name string, content []byte) string {
+	path := filepath.Join(dir, name)
+	Expect(os.WriteFile(path, content, 0o644)).To(Succeed())
+	return path
+}
+
+var _ = Describe("service descriptions", func() {
+	It("applies defaults to a minimal HTTP description", func() {
+		desc, err := ParseDescription([]byte(`
+name: calib
+protocol: HTTP
+endpoint: http://127.0.0.1:8080
+timeout: 5s
+methods:
+  Prepare:
+    path: /prepare
+    timeout: 2s
+  Status:
+    httpMethod: get
+    path: /status
+`), "calib.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(desc.PrettyName).To(Equal("calib"))
+		Expect(desc.Protocol).To(Equal(PROTOCOL_HTTP))
+		Expect(desc.Methods["Prepare"].HttpMethod).To(Equal("POST"))
+		Expect(desc.Methods["Status"].HttpMethod).To(Equal("GET"))
+		Expect(desc.resolveTimeout(desc.Methods["Prepare"])).To(Equal(2 * time.Second))
+		Expect(desc.resolveTimeout(desc.Methods["Status"])).To(Equal(5 * time.Second))
+	})
+
+	It("accepts JSON descriptions", func() {
+		desc, err := ParseDescription([]byte(`{"name": "calib", "protocol": "http", "endpoint": "http://localhost",
+			"methods": {"Prepare": {"path": "/prepare", "expect": {"status": ["ok", "done"]}}}}`), "calib.json")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(desc.Methods["Prepare"].Expect["status"]).To(Equal(ValueList{"ok", "done"}))
+		Expect(desc.resolveTimeout(desc.Methods["Prepare"])).To(Equal(DEFAULT_CALL_TIMEOUT))
+	})
+
+	DescribeTable("rejects invalid descriptions",
+		func(content string) {
+			_, err := ParseDescription([]byte(content), "bad.yaml")
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("invalid name", "name: 'my-service'\nendpoint: x\nprotocol: http\nmethods: {A: {path: /}}"),
+		Entry("unknown protocol", "name: a\nendpoint: x\nprotocol: soap\nmethods: {A: {path: /}}"),
+		Entry("missing endpoint", "name: a\nprotocol: http\nmethods: {A: {path: /}}"),
+		Entry("no methods", "name: a\nendpoint: x\nprotocol: http"),
+		Entry("HTTP method without path", "name: a\nendpoint: x\nprotocol: http\nmethods: {A: {}}"),
+		Entry("gRPC without descriptor set", "name: a\nendpoint: x\nservice: s\nmethods: {A: {}}"),
+	)
+})
+
+var _ = Describe("HTTP plugin", func() {
+	var (
+		server   *httptest.Server
+		requests chan *http.Request
+		bodies   chan map[string]interface{}
+		plugin   *Plugin
+		parent   *fakeParentRole
+	)
+
+	BeforeEach(func() {
+		requests = make(chan *http.Request, 10)
+		bodies = make(chan map[string]interface{}, 10)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body := map[string]interface{}{}
+			data, _ := io.ReadAll(r.Body)
+			if len(data) > 0 {
+				_ = json.Unmarshal(data, &body)
+			}
+			requests <- r
+			bodies <- body
+			switch r.URL.Path {
+			case "/api/runs/42/prepare":
+				_, _ = w.Write([]byte(`{"status": "ok", "calibration": {"id": 7, "tag": "v1"}}`))
+			case "/api/status":
+				_, _ = w.Write([]byte(`{"status": "busy"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte("no such thing"))
+			}
+		}))
+
+		path := writeFile(GinkgoT().TempDir(), "calib.yaml", []byte(`
+name: calib
+prettyName: Calibration service
+protocol: http
+endpoint: `+server.URL+`
+headers:
+  X-Token: secret
+methods:
+  Prepare:
+    path: /api/runs/{runNumber}/prepare
+    request:
+      runNumber: run_number
+      detectors: detectors
+      options.mode: calib_mode
+    constants:
+      options.mode: default
+      options.verbose: true
+    response:
+      calibration.id: calib_id
+      calibration: calib_object
+    expect:
+      status: ok
+  Status:
+    httpMethod: GET
+    path: /api/status
+    request:
+      env: environment_id
+    expect:
+      status: [idle, ok]
+  Missing:
+    path: /api/missing
+`))
+		var err error
+		plugin, err = NewPlugin(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plugin.GetConnectionState()).To(Equal("UNKNOWN"))
+		Expect(plugin.Init("test")).To(Succeed())
+		Expect(plugin.GetConnectionState()).To(Equal("READY"))
+		parent = &fakeParentRole{vars: map[string]string{}}
+	})
+
+	AfterEach(func() {
+		Expect(plugin.Destroy()).To(Succeed())
+		server.Close()
+	})
+
+	It("exposes every method in the call stack", func() {
+		call := newTestCall(parent, "calib.Prepare()", map[string]string{"environment_id": "2oDvieFrVTi"})
+		stack := plugin.CallStack(call)
+		Expect(stack).To(HaveKey("Prepare"))
+		Expect(stack).To(HaveKey("Status"))
+		Expect(stack).To(HaveKey("Missing"))
+	})
+
+	It("maps varStack keys to the request and the response to runtime variables", func() {
+		call := newTestCall(parent, "calib.Prepare()", map[string]string{
+			"environment_id": "2oDvieFrVTi",
+			"run_number":     "42",
+			"detectors":      "TPC,ITS",
+		})
+		out := plugin.CallStack(call)["Prepare"].(func() string)()
+		Expect(call.VarStack).NotTo(HaveKey("__call_error"))
+		Expect(out).To(MatchJSON(`{"status": "ok", "calibration": {"id": 7, "tag": "v1"}}`))
+
+		var r *http.Request
+		Eventually(requests).Should(Receive(&r))
+		Expect(r.Method).To(Equal(http.MethodPost))
+		Expect(r.Header.Get("X-Token")).To(Equal("secret"))
+		var body map[string]interface{}
+		Eventually(bodies).Should(Receive(&body))
+		Expect(body).NotTo(HaveKey("runNumber"))
+		Expect(body).To(HaveKeyWithValue("detectors", "TPC,ITS"))
+		Expect(body).To(HaveKeyWithValue("options", map[string]interface{}{"mode": "default", "verbose": true}))
+
+		Expect(parent.vars).To(HaveKeyWithValue("calib_id", "7"))
+		Expect(parent.vars).To(HaveKeyWithValue("calib_object", `{"id":7,"tag":"v1"}`))
+	})
+
+	It("sends GET fields as query parameters and enforces expectations", func() {
+		call := newTestCall(parent, "calib.Status()", map[string]string{"environment_id": "2oDvieFrVTi"})
+		plugin.CallStack(call)["Status"].(func() string)()
+
+		var r *http.Request
+		Eventually(requests).Should(Receive(&r))
+		Expect(r.URL.Query().Get("env")).To(Equal("2oDvieFrVTi"))
+		Expect(call.VarStack).To(HaveKeyWithValue("__call_error", "Calibration service Status call failed"))
+		Expect(call.VarStack["__call_error_reason"]).To(ContainSubstring(`response field status is "busy"`))
+	})
+
+	It("reports HTTP errors", func() {
+		call := newTestCall(parent, "calib.Missing()", map[string]string{"environment_id": "2oDvieFrVTi"})
+		plugin.CallStack(call)["Missing"].(func() string)()
+		Expect(call.VarStack["__call_error_reason"]).To(ContainSubstring("404"))
+		Expect(call.VarStack["__call_error_reason"]).To(ContainSubstring("no such thing"))
+	})
+
+	It("fails when a required varStack key is missing", func() {
+		call := newTestCall(parent, "calib.Prepare()", map[string]string{"environment_id": "2oDvieFrVTi"})
+		plugin.CallStack(call)["Prepare"].(func() string)()
+		Expect(call.VarStack["__call_error_reason"]).To(ContainSubstring("is not set"))
+		Consistently(requests).ShouldNot(Receive())
+	})
+})
+
+var _ = Describe("gRPC plugin", func() {
+	var (
+		sim      *simulator.Simulator
+		listener net.Listener
+		dir      string
+		parent   *fakeParentRole
+	)
+
+	BeforeEach(func() {
+		sim = simulator.New(nil)
+		server, err := sim.NewServer(simulator.DDSCHED)
+		Expect(err).NotTo(HaveOccurred())
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go func() { _ = server.Serve(listener) }()
+		DeferCleanup(server.Stop)
+		DeferCleanup(sim.Stop)
+
+		dir = GinkgoT().TempDir()
+		set := &descriptorpb.FileDescriptorSet{
+			File: []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(ddpb.File_protos_ddsched_proto)},
+		}
+		data, err := proto.Marshal(set)
+		Expect(err).NotTo(HaveOccurred())
+		writeFile(dir, "ddsched.protoset", data)
+		parent = &fakeParentRole{vars: map[string]string{}}
+	})
+
+	newGrpcPlugin := func(methods string) *Plugin {
+		path := writeFile(dir, "dd.yaml", []byte(`
+name: dd
+endpoint: `+listener.Addr().String()+`
+service: o2.DataDistribution.Control.DataDistributionControl
+descriptorSet: `+filepath.Join(dir, "ddsched.protoset")+`
+timeout: 5s
+methods:
+`+methods))
+		plugin, err := NewPlugin(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plugin.Init("test")).To(Succeed())
+		DeferCleanup(plugin.Destroy)
+		return plugin
+	}
+
+	It("invokes unary RPCs through the descriptor set", func() {
+		plugin := newGrpcPlugin(`
+  Initialize:
+    rpc: PartitionInitialize
+    request:
+      partition_info.environment_id: environment_id
+      partitionInfo.partitionId: environment_id
+      stfb_host_id_map: dd_stfb_map
+      stfsHostIdMap: dd_stfs_map
+    expect:
+      partition_state: [PARTITION_CONFIGURING, PARTITION_CONFIGURED]
+    response:
+      partition_state: dd_partition_state
+`)
+		call := newTestCall(parent, "dd.Initialize()", map[string]string{
+			"environment_id": "2oDvieFrVTi",
+			"dd_stfb_map":    `{"stfb-1": "flp001-ib"}`,
+			"dd_stfs_map":    `{"stfs-1": "flp001-ib"}`,
+		})
+		out := plugin.CallStack(call)["Initialize"].(func() string)()
+		Expect(call.VarStack).NotTo(HaveKey("__call_error_reason"))
+		Expect(out).To(ContainSubstring("PARTITION_CONFIGURING"))
+		Expect(parent.vars).To(HaveKeyWithValue("dd_partition_state", "PARTITION_CONFIGURING"))
+		Expect(plugin.GetConnectionState()).To(Equal("READY"))
+	})
+
+	It("surfaces gRPC errors as call errors", func() {
+		sim.SetScenario(&simulator.Scenario{
+			DDsched: simulator.ServiceScenario{
+				Calls: map[string]*simulator.CallBehaviour{
+					"PartitionStatus": {Fail: true, GrpcError: true, Message: "scheduler down"},
+				},
+			},
+		})
+		plugin := newGrpcPlugin(`
+  Status:
+    rpc: PartitionStatus
+    request:
+      partition_id: environment_id
+`)
+		call := newTestCall(parent, "dd.Status()", map[string]string{"environment_id": "2oDvieFrVTi"})
+		plugin.CallStack(call)["Status"].(func() string)()
+		Expect(call.VarStack["__call_error_reason"]).To(ContainSubstring("scheduler down"))
+	})
+
+	It("rejects unknown RPCs and fields when loading", func() {
+		path := writeFile(dir, "bad.yaml", []byte(`
+name: dd
+endpoint: 127.0.0.1:1
+service: o2.DataDistribution.Control.DataDistributionControl
+descriptorSet: `+filepath.Join(dir, "ddsched.protoset")+`
+methods:
+  Status:
+    rpc: PartitionStatus
+    request:
+      no_such_field: environment_id
+`))
+		_, err := NewPlugin(path)
+		Expect(err).To(MatchError(ContainSubstring("no_such_field")))
+
+		path = writeFile(dir, "bad.yaml", []byte(`
+name: dd
+endpoint: 127.0.0.1:1
+service: o2.DataDistribution.Control.DataDistributionControl
+descriptorSet: `+filepath.Join(dir, "ddsched.protoset")+`
+methods:
+  Reset: {}
+`))
+		_, err = NewPlugin(path)
+		Expect(err).To(MatchError(ContainSubstring("rpc Reset not found")))
+	})
+
+	It("skips broken descriptions and duplicate names when loading a set", func() {
+		good := writeFile(dir, "good.yaml", []byte("name: a\nprotocol: http\nendpoint: http://localhost\nmethods: {A: {path: /}}"))
+		duplicate := writeFile(dir, "dup.yaml", []byte("name: a\nprotocol: http\nendpoint: http://localhost\nmethods: {B: {path: /}}"))
+		plugins := NewPlugins([]string{good, filepath.Join(dir, "missing.yaml"), duplicate})
+		Expect(plugins).To(HaveLen(1))
+		Expect(plugins[0].GetName()).To(Equal("a"))
+	})
+})
+
+func TestDeclarative(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Declarative integration plugin Test Suite")
+}
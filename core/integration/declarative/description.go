@@ -0,0 +1,213 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package declarative
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	PROTOCOL_GRPC = "grpc"
+	PROTOCOL_HTTP = "http"
+)
+
+var namePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// Description is the YAML or JSON document which defines a declarative
+// integration plugin: where the service lives and which calls the workflow
+// may perform against it.
+type Description struct {
+	// Name is the key under which the methods are exposed in the call stack,
+	// e.g. `calib` for `calib.Prepare()`.
+	Name       string        `yaml:"name"`
+	PrettyName string        `yaml:"prettyName"`
+	Protocol   string        `yaml:"protocol"`
+	Endpoint   string        `yaml:"endpoint"`
+	Timeout    time.Duration `yaml:"timeout"`
+
+	// gRPC only: the service full name (`package.Service`) and the path to a
+	// FileDescriptorSet which contains it, as produced by
+	// `protoc --include_imports --descriptor_set_out=...`.
+	Service       string `yaml:"service"`
+	DescriptorSet string `yaml:"descriptorSet"`
+
+	// HTTP only: headers added to every request.
+	Headers map[string]string `yaml:"headers"`
+
+	Methods map[string]*Method `yaml:"methods"`
+
+	path string
+}
+
+// Method describes a single call exposed as `<name>.<Method>()`.
+type Method struct {
+	// gRPC only: the RPC name within the service, defaults to the method key.
+	Rpc string `yaml:"rpc"`
+
+	// HTTP only: the verb (default POST) and the path relative to the
+	// endpoint. The path may contain `{field}` placeholders which are filled
+	// from the request fields of the same name.
+	HttpMethod string `yaml:"httpMethod"`
+	Path       string `yaml:"path"`
+
+	Timeout time.Duration `yaml:"timeout"`
+
+	// Request maps dotted request field paths to varStack keys.
+	Request map[string]string `yaml:"request"`
+	// Constants maps dotted request field paths to fixed values, used when
+	// the corresponding Request varStack key is not set.
+	Constants map[string]interface{} `yaml:"constants"`
+	// Response maps dotted response field paths to global runtime variables
+	// which are set after a successful call.
+	Response map[string]string `yaml:"response"`
+	// Expect maps dotted response field paths to the values for which the
+	// call is considered successful.
+	Expect map[string]ValueList `yaml:"expect"`
+}
+
+// ValueList accepts either a single scalar or a sequence of scalars.
+type ValueList []string
+
+func (v *ValueList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*v = ValueList{node.Value}
+		return nil
+	}
+	var values []string
+	if err := node.Decode(&values); err != nil {
+		return err
+	}
+	*v = values
+	return nil
+}
+
+// LoadDescription reads and validates a service description file. JSON is
+// accepted as well, since it is a subset of YAML.
+func LoadDescription(path string) (*Description, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read service description: %w", err)
+	}
+	return ParseDescription(data, path)
+}
+
+func ParseDescription(data []byte, path string) (*Description, error) {
+	desc := &Description{}
+	if err := yaml.Unmarshal(data, desc); err != nil {
+		return nil, fmt.Errorf("cannot parse service description %s: %w", path, err)
+	}
+	desc.path = path
+	if err := desc.validate(); err != nil {
+		return nil, fmt.Errorf("invalid service description %s: %w", path, err)
+	}
+	return desc, nil
+}
+
+func (d *Description) validate() error {
+	if !namePattern.MatchString(d.Name) {
+		return fmt.Errorf("name %q must be a valid identifier", d.Name)
+	}
+	if d.PrettyName == "" {
+		d.PrettyName = d.Name
+	}
+	d.Protocol = strings.ToLower(d.Protocol)
+	if d.Protocol == "" {
+		d.Protocol = PROTOCOL_GRPC
+	}
+	if d.Protocol != PROTOCOL_GRPC && d.Protocol != PROTOCOL_HTTP {
+		return fmt.Errorf("unsupported protocol %q (expected %s or %s)", d.Protocol, PROTOCOL_GRPC, PROTOCOL_HTTP)
+	}
+	if d.Endpoint == "" {
+		return fmt.Errorf("endpoint not set")
+	}
+	if d.Timeout < 0 {
+		return fmt.Errorf("negative timeout")
+	}
+	if d.Protocol == PROTOCOL_GRPC {
+		if d.Service == "" {
+			return fmt.Errorf("service not set for gRPC protocol")
+		}
+		if d.DescriptorSet == "" {
+			return fmt.Errorf("descriptorSet not set for gRPC protocol")
+		}
+	}
+	if len(d.Methods) == 0 {
+		return fmt.Errorf("no methods declared")
+	}
+	for name, m := range d.Methods {
+		if m == nil {
+			m = &Method{}
+			d.Methods[name] = m
+		}
+		if !namePattern.MatchString(name) {
+			return fmt.Errorf("method name %q must be a valid identifier", name)
+		}
+		if m.Timeout < 0 {
+			return fmt.Errorf("method %s: negative timeout", name)
+		}
+		switch d.Protocol {
+		case PROTOCOL_GRPC:
+			if m.Rpc == "" {
+				m.Rpc = name
+			}
+		case PROTOCOL_HTTP:
+			m.HttpMethod = strings.ToUpper(m.HttpMethod)
+			if m.HttpMethod == "" {
+				m.HttpMethod = "POST"
+			}
+			if m.Path == "" {
+				return fmt.Errorf("method %s: path not set for HTTP protocol", name)
+			}
+		}
+		for field, key := range m.Request {
+			if field == "" || key == "" {
+				return fmt.Errorf("method %s: request mappings need both a field path and a varStack key", name)
+			}
+		}
+		for field, variable := range m.Response {
+			if field == "" || variable == "" {
+				return fmt.Errorf("method %s: response mappings need both a field path and a variable name", name)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveTimeout returns the timeout declared for the method, falling back
+// to the service-wide one and finally to the package default.
+func (d *Description) resolveTimeout(method *Method) time.Duration {
+	if method.Timeout > 0 {
+		return method.Timeout
+	}
+	if d.Timeout > 0 {
+		return d.Timeout
+	}
+	return DEFAULT_CALL_TIMEOUT
+}
@@ -0,0 +1,210 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package declarative
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fieldResolver adapts request field paths and varStack values to the wire
+// format of a protocol.
+type fieldResolver interface {
+	// canonicalPath returns the path under which the field is sent, so that
+	// different spellings of the same field end up in the same place.
+	canonicalPath(path string) string
+	// convert turns a varStack string into the value sent for the field.
+	convert(path string, value string) (interface{}, error)
+}
+
+// buildRequestFields resolves the request mappings of a method against the
+// varStack, falling back to constants, and returns the result as a nested
+// map keyed by field path segments.
+func buildRequestFields(method *Method, varStack map[string]string, resolver fieldResolver) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+
+	paths := make([]string, 0, len(method.Request)+len(method.Constants))
+	for path := range method.Constants {
+		paths = append(paths, path)
+	}
+	for path := range method.Request {
+		if _, ok := method.Constants[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		var value interface{}
+		if key, ok := method.Request[path]; ok {
+			if strValue, ok := varStack[key]; ok {
+				converted, err := resolver.convert(path, strValue)
+				if err != nil {
+					return nil, fmt.Errorf("cannot convert varStack key %s for field %s: %w", key, path, err)
+				}
+				value = converted
+			} else if constant, ok := method.Constants[path]; ok {
+				value = constant
+			} else {
+				return nil, fmt.Errorf("varStack key %s required for field %s is not set", key, path)
+			}
+		} else {
+			value = method.Constants[path]
+		}
+		if err := setPath(fields, resolver.canonicalPath(path), value); err != nil {
+			return nil, err
+		}
+	}
+	return fields, nil
+}
+
+func setPath(fields map[string]interface{}, path string, value interface{}) error {
+	segments := strings.Split(path, ".")
+	current := fields
+	for i, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment]
+		if !ok {
+			nextMap := make(map[string]interface{})
+			current[segment] = nextMap
+			current = nextMap
+			continue
+		}
+		nextMap, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("field %s conflicts with field %s", path, strings.Join(segments[:i+1], "."))
+		}
+		current = nextMap
+	}
+	last := segments[len(segments)-1]
+	if _, exists := current[last]; exists {
+		return fmt.Errorf("field %s declared more than once", path)
+	}
+	current[last] = value
+	return nil
+}
+
+// lookupPath walks a decoded JSON document along a dotted field path.
+func lookupPath(document interface{}, path string) (interface{}, bool) {
+	current := document
+	for _, segment := range strings.Split(path, ".") {
+		switch typed := current.(type) {
+		case map[string]interface{}:
+			next, ok := typed[segment]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, false
+			}
+			current = typed[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// stringify renders a decoded JSON value the way it would be stored in a
+// varStack: scalars as-is, objects and arrays as compact JSON.
+func stringify(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	case bool:
+		return strconv.FormatBool(typed)
+	default:
+		out, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprintf("%v", typed)
+		}
+		return string(out)
+	}
+}
+
+func decodeJSON(data []byte) (interface{}, error) {
+	var document interface{}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// checkExpectations verifies that every expected response field holds one of
+// the accepted values.
+func checkExpectations(method *Method, document interface{}) error {
+	paths := make([]string, 0, len(method.Expect))
+	for path := range method.Expect {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		accepted := method.Expect[path]
+		value, ok := lookupPath(document, path)
+		actual := stringify(value)
+		if !ok {
+			actual = ""
+		}
+		matched := false
+		for _, candidate := range accepted {
+			if candidate == actual {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("response field %s is %q, expected one of [%s]", path, actual, strings.Join(accepted, ", "))
+		}
+	}
+	return nil
+}
+
+// extractResponseVars returns the runtime variables declared in the method's
+// response mappings. Fields missing from the response are skipped.
+func extractResponseVars(method *Method, document interface{}) map[string]string {
+	vars := make(map[string]string)
+	for path, variable := range method.Response {
+		value, ok := lookupPath(document, path)
+		if !ok {
+			continue
+		}
+		vars[variable] = stringify(value)
+	}
+	return vars
+}
@@ -0,0 +1,280 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package declarative
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type grpcInvoker struct {
+	desc    *Description
+	service protoreflect.ServiceDescriptor
+	conn    *grpc.ClientConn
+}
+
+func newGrpcInvoker(desc *Description) (*grpcInvoker, error) {
+	files, err := loadDescriptorSet(desc.DescriptorSet)
+	if err != nil {
+		return nil, err
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(desc.Service))
+	if err != nil {
+		return nil, fmt.Errorf("service %s not found in descriptor set %s: %w", desc.Service, desc.DescriptorSet, err)
+	}
+	service, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", desc.Service)
+	}
+	for name, method := range desc.Methods {
+		md := service.Methods().ByName(protoreflect.Name(method.Rpc))
+		if md == nil {
+			return nil, fmt.Errorf("method %s: rpc %s not found in service %s", name, method.Rpc, desc.Service)
+		}
+		if md.IsStreamingClient() || md.IsStreamingServer() {
+			return nil, fmt.Errorf("method %s: streaming rpc %s is not supported", name, method.Rpc)
+		}
+		for path := range method.Request {
+			if _, err := fieldByPath(md.Input(), path); err != nil {
+				return nil, fmt.Errorf("method %s: %w", name, err)
+			}
+		}
+		for path := range method.Constants {
+			if _, err := fieldByPath(md.Input(), path); err != nil {
+				return nil, fmt.Errorf("method %s: %w", name, err)
+			}
+		}
+	}
+	return &grpcInvoker{
+		desc:    desc,
+		service: service,
+	}, nil
+}
+
+// loadDescriptorSet builds a file registry from a serialized
+// FileDescriptorSet. Imports missing from the set are resolved against the
+// descriptors linked into this binary, so well-known types need not be
+// included.
+func loadDescriptorSet(path string) (*protoregistry.Files, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read descriptor set: %w", err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err = proto.Unmarshal(data, set); err != nil {
+		return nil, fmt.Errorf("cannot parse descriptor set %s: %w", path, err)
+	}
+	files := &protoregistry.Files{}
+	resolver := &chainedResolver{files}
+	for _, fdProto := range set.GetFile() {
+		if _, err = files.FindFileByPath(fdProto.GetName()); err == nil {
+			continue
+		}
+		fd, err := protodesc.NewFile(fdProto, resolver)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load %s from descriptor set %s: %w", fdProto.GetName(), path, err)
+		}
+		if err = files.RegisterFile(fd); err != nil {
+			return nil, fmt.Errorf("cannot register %s from descriptor set %s: %w", fdProto.GetName(), path, err)
+		}
+	}
+	return files, nil
+}
+
+type chainedResolver struct {
+	local *protoregistry.Files
+}
+
+func (r *chainedResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.local.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (r *chainedResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := r.local.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+// fieldByPath resolves a dotted path of proto or JSON field names.
+func fieldByPath(md protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	var fd protoreflect.FieldDescriptor
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		if md == nil {
+			return nil, fmt.Errorf("field %s: %s is not a message", path, strings.Join(segments[:i], "."))
+		}
+		fd = md.Fields().ByName(protoreflect.Name(segment))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(segment)
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("field %s: no field %s in %s", path, segment, md.FullName())
+		}
+		md = nil
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+	return fd, nil
+}
+
+func (g *grpcInvoker) init() error {
+	if g.conn != nil {
+		return nil
+	}
+	conn, err := grpc.NewClient(strings.TrimPrefix(g.desc.Endpoint, "//"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  backoff.DefaultConfig.BaseDelay,
+				Multiplier: backoff.DefaultConfig.Multiplier,
+				Jitter:     backoff.DefaultConfig.Jitter,
+				MaxDelay:   20 * time.Second,
+			},
+			MinConnectTimeout: 10 * time.Second,
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("cannot create gRPC client for %s: %w", g.desc.Endpoint, err)
+	}
+	conn.Connect()
+	g.conn = conn
+	return nil
+}
+
+func (g *grpcInvoker) connectionState() string {
+	if g.conn == nil {
+		return "UNKNOWN"
+	}
+	return g.conn.GetState().String()
+}
+
+func (g *grpcInvoker) close() error {
+	if g.conn == nil {
+		return nil
+	}
+	err := g.conn.Close()
+	g.conn = nil
+	return err
+}
+
+func (g *grpcInvoker) invoke(ctx context.Context, method *Method, varStack map[string]string) ([]byte, error) {
+	if g.conn == nil {
+		return nil, fmt.Errorf("gRPC client for %s not initialized", g.desc.Endpoint)
+	}
+	md := g.service.Methods().ByName(protoreflect.Name(method.Rpc))
+
+	fields, err := buildRequestFields(method, varStack, &grpcFieldResolver{input: md.Input()})
+	if err != nil {
+		return nil, err
+	}
+	requestJson, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	request := dynamicpb.NewMessage(md.Input())
+	if err = protojson.Unmarshal(requestJson, request); err != nil {
+		return nil, fmt.Errorf("cannot build %s request: %w", md.Input().FullName(), err)
+	}
+
+	response := dynamicpb.NewMessage(md.Output())
+	fullMethod := fmt.Sprintf("/%s/%s", g.service.FullName(), md.Name())
+	if err = g.conn.Invoke(ctx, fullMethod, request, response); err != nil {
+		return nil, err
+	}
+	return protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(response)
+}
+
+type grpcFieldResolver struct {
+	input protoreflect.MessageDescriptor
+}
+
+// canonicalPath spells the path with proto field names, since protojson
+// accepts both proto and JSON names but rejects a field given twice.
+func (r *grpcFieldResolver) canonicalPath(path string) string {
+	segments := strings.Split(path, ".")
+	md := r.input
+	for i, segment := range segments {
+		if md == nil {
+			break
+		}
+		fd := md.Fields().ByName(protoreflect.Name(segment))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(segment)
+		}
+		if fd == nil {
+			break
+		}
+		segments[i] = string(fd.Name())
+		md = nil
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+func (r *grpcFieldResolver) convert(path string, value string) (interface{}, error) {
+	fd, err := fieldByPath(r.input, path)
+	if err != nil {
+		return nil, err
+	}
+	return convertForField(fd, value)
+}
+
+// convertForField turns a varStack string into a value that protojson will
+// accept for the given field. Numbers and enums are accepted as strings, but
+// booleans are not, and composite fields are expected as JSON.
+func convertForField(fd protoreflect.FieldDescriptor, value string) (interface{}, error) {
+	if fd.IsList() || fd.IsMap() || fd.Kind() == protoreflect.MessageKind {
+		if json.Valid([]byte(value)) {
+			return json.RawMessage(value), nil
+		}
+		return value, nil
+	}
+	if fd.Kind() == protoreflect.BoolKind {
+		return strconv.ParseBool(value)
+	}
+	return value, nil
+}
@@ -0,0 +1,166 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package declarative
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const HTTP_MAX_ERROR_BODY = 512
+
+var placeholderPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+type httpInvoker struct {
+	desc        *Description
+	baseUrl     *url.URL
+	client      *http.Client
+	initialized bool
+}
+
+func newHttpInvoker(desc *Description) (*httpInvoker, error) {
+	baseUrl, err := url.Parse(desc.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("bad endpoint %s: %w", desc.Endpoint, err)
+	}
+	if baseUrl.Scheme != "http" && baseUrl.Scheme != "https" {
+		return nil, fmt.Errorf("bad endpoint %s: scheme must be http or https", desc.Endpoint)
+	}
+	if baseUrl.Path == "" {
+		baseUrl.Path = "/"
+	}
+	return &httpInvoker{
+		desc:    desc,
+		baseUrl: baseUrl,
+		client:  &http.Client{},
+	}, nil
+}
+
+func (h *httpInvoker) init() error {
+	h.initialized = true
+	return nil
+}
+
+func (h *httpInvoker) connectionState() string {
+	// HTTP keeps no long-lived connection, so there is nothing to report
+	// beyond whether the plugin is ready to issue requests.
+	if !h.initialized {
+		return "UNKNOWN"
+	}
+	return "READY"
+}
+
+func (h *httpInvoker) close() error {
+	h.client.CloseIdleConnections()
+	h.initialized = false
+	return nil
+}
+
+func (h *httpInvoker) canonicalPath(path string) string {
+	return path
+}
+
+// convert sends varStack values as JSON strings, HTTP services have no schema
+// to convert against.
+func (h *httpInvoker) convert(_ string, value string) (interface{}, error) {
+	return value, nil
+}
+
+func (h *httpInvoker) invoke(ctx context.Context, method *Method, varStack map[string]string) ([]byte, error) {
+	fields, err := buildRequestFields(method, varStack, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// Top-level fields referenced in the path are consumed by it
+	var placeholderErr error
+	path := placeholderPattern.ReplaceAllStringFunc(method.Path, func(placeholder string) string {
+		name := strings.Trim(placeholder, "{}")
+		value, ok := fields[name]
+		if !ok {
+			placeholderErr = fmt.Errorf("path placeholder %s has no matching request field", placeholder)
+			return placeholder
+		}
+		delete(fields, name)
+		return url.PathEscape(stringify(value))
+	})
+	if placeholderErr != nil {
+		return nil, placeholderErr
+	}
+
+	target := h.baseUrl.JoinPath(path)
+	var body io.Reader
+	switch method.HttpMethod {
+	case http.MethodGet, http.MethodDelete, http.MethodHead:
+		query := target.Query()
+		for name, value := range fields {
+			query.Set(name, stringify(value))
+		}
+		target.RawQuery = query.Encode()
+	default:
+		payload, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method.HttpMethod, target.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range h.desc.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		excerpt := string(respBody)
+		if len(excerpt) > HTTP_MAX_ERROR_BODY {
+			excerpt = excerpt[:HTTP_MAX_ERROR_BODY] + "..."
+		}
+		return nil, fmt.Errorf("%s %s returned %s: %s", method.HttpMethod, target.Path, resp.Status, strings.TrimSpace(excerpt))
+	}
+	return respBody, nil
+}
@@ -0,0 +1,305 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package declarative provides a generic integration plugin whose calls are
+// defined by a YAML or JSON service description rather than by Go code.
+// Each description yields one plugin, named after the description, which
+// exposes its methods as `<name>.<Method>()` in the workflow call stack.
+package declarative
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/common/monitoring"
+	pb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/integration"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow/callable"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+)
+
+const DEFAULT_CALL_TIMEOUT = 30 * time.Second
+
+var log = logger.New(logrus.StandardLogger(), "declarative")
+
+type invoker interface {
+	init() error
+	invoke(ctx context.Context, method *Method, varStack map[string]string) ([]byte, error)
+	connectionState() string
+	close() error
+}
+
+type Plugin struct {
+	desc    *Description
+	invoker invoker
+	topic   topic.Topic
+}
+
+// NewPlugins loads one plugin per service description path. Descriptions
+// which fail to load are logged and skipped, as are duplicate names.
+func NewPlugins(paths []string) []integration.Plugin {
+	plugins := make([]integration.Plugin, 0, len(paths))
+	names := make(map[string]struct{})
+	for _, path := range paths {
+		p, err := NewPlugin(path)
+		if err != nil {
+			log.WithError(err).
+				WithField("level", infologger.IL_Support).
+				WithField("description", path).
+				Error("cannot load declarative integration plugin")
+			continue
+		}
+		if _, exists := names[p.GetName()]; exists {
+			log.WithField("level", infologger.IL_Support).
+				WithField("description", path).
+				WithField("plugin", p.GetName()).
+				Error("declarative integration plugin name already in use, skipping")
+			continue
+		}
+		names[p.GetName()] = struct{}{}
+		plugins = append(plugins, p)
+	}
+	return plugins
+}
+
+func NewPlugin(path string) (*Plugin, error) {
+	desc, err := LoadDescription(path)
+	if err != nil {
+		return nil, err
+	}
+	return newPluginFromDescription(desc)
+}
+
+func newPluginFromDescription(desc *Description) (*Plugin, error) {
+	var (
+		inv invoker
+		err error
+	)
+	switch desc.Protocol {
+	case PROTOCOL_GRPC:
+		inv, err = newGrpcInvoker(desc)
+	case PROTOCOL_HTTP:
+		inv, err = newHttpInvoker(desc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid service description %s: %w", desc.path, err)
+	}
+	return &Plugin{
+		desc:    desc,
+		invoker: inv,
+		topic:   topic.IntegratedService + topic.Separator + topic.Topic(desc.Name),
+	}, nil
+}
+
+func (p *Plugin) GetName() string {
+	return p.desc.Name
+}
+
+func (p *Plugin) GetPrettyName() string {
+	return p.desc.PrettyName
+}
+
+func (p *Plugin) GetEndpoint() string {
+	return p.desc.Endpoint
+}
+
+func (p *Plugin) GetConnectionState() string {
+	if p == nil || p.invoker == nil {
+		return "UNKNOWN"
+	}
+	return p.invoker.connectionState()
+}
+
+func (p *Plugin) GetData(_ []any) string {
+	return ""
+}
+
+func (p *Plugin) GetEnvironmentsData(_ []uid.ID) map[uid.ID]string {
+	return nil
+}
+
+func (p *Plugin) GetEnvironmentsShortData(_ []uid.ID) map[uid.ID]string {
+	return nil
+}
+
+func (p *Plugin) Init(_ string) error {
+	if err := p.invoker.init(); err != nil {
+		return err
+	}
+	log.WithField("plugin", p.GetName()).
+		WithField("endpoint", p.GetEndpoint()).
+		Debug("declarative integration plugin initialized")
+	return nil
+}
+
+func (p *Plugin) ObjectStack(_ map[string]string, _ map[string]string) (stack map[string]interface{}) {
+	stack = make(map[string]interface{})
+	return stack
+}
+
+func (p *Plugin) CallStack(data interface{}) (stack map[string]interface{}) {
+	call, ok := data.(*callable.Call)
+	if !ok {
+		return
+	}
+	envId, ok := call.VarStack["environment_id"]
+	if !ok {
+		log.WithField("plugin", p.GetName()).
+			Error("cannot acquire environment ID")
+		return
+	}
+
+	stack = make(map[string]interface{})
+	for name, method := range p.desc.Methods {
+		stack[name] = func() (out string) { // must formally return string even when we return nothing
+			return p.doCall(call, envId, name, method)
+		}
+	}
+	return
+}
+
+// doCall performs a single declared method call and returns the JSON
+// response, which is also the output of the hook call.
+func (p *Plugin) doCall(call *callable.Call, envId string, name string, method *Method) (out string) {
+	varStack := call.VarStack
+	callFailedStr := fmt.Sprintf("%s %s call failed", p.GetPrettyName(), name)
+	operationStep := fmt.Sprintf("perform %s call: %s", p.GetName(), name)
+
+	log.WithField("partition", envId).
+		WithField("plugin", p.GetName()).
+		Debugf("performing %s.%s", p.GetName(), name)
+
+	timeout := callable.AcquireTimeout(p.desc.resolveTimeout(method), varStack, name, envId)
+	ctx, cancel := integration.NewContext(envId, varStack, timeout)
+	defer cancel()
+
+	the.EventWriterWithTopic(p.topic).WriteEvent(&pb.Ev_IntegratedServiceEvent{
+		Name:                call.GetName(),
+		OperationName:       call.Func,
+		OperationStatus:     pb.OpStatus_STARTED,
+		OperationStep:       operationStep,
+		OperationStepStatus: pb.OpStatus_STARTED,
+		EnvironmentId:       envId,
+	})
+
+	fail := func(err error, payload string) {
+		log.WithError(err).
+			WithField("level", infologger.IL_Support).
+			WithField("partition", envId).
+			WithField("endpoint", p.GetEndpoint()).
+			WithField("call", name).
+			Errorf("%s error", p.GetPrettyName())
+
+		call.VarStack["__call_error_reason"] = err.Error()
+		call.VarStack["__call_error"] = callFailedStr
+		if st, ok := status.FromError(err); ok {
+			call.VarStack["__call_error_code"] = st.Code().String()
+		}
+
+		the.EventWriterWithTopic(p.topic).WriteEvent(&pb.Ev_IntegratedServiceEvent{
+			Name:                call.GetName(),
+			OperationName:       call.Func,
+			OperationStatus:     pb.OpStatus_DONE_ERROR,
+			OperationStep:       operationStep,
+			OperationStepStatus: pb.OpStatus_DONE_ERROR,
+			EnvironmentId:       envId,
+			Payload:             payload,
+			Error:               err.Error(),
+		})
+	}
+
+	response, err := p.invokeMeasured(ctx, envId, name, method, varStack)
+	if err != nil {
+		fail(err, "")
+		return
+	}
+
+	document, err := decodeJSON(response)
+	if err != nil {
+		fail(fmt.Errorf("cannot decode response: %w", err), string(response))
+		return
+	}
+	if err = checkExpectations(method, document); err != nil {
+		fail(err, string(response))
+		return
+	}
+
+	if vars := extractResponseVars(method, document); len(vars) > 0 {
+		parentRole, ok := call.GetParentRole().(callable.ParentRole)
+		if !ok {
+			fail(errors.New("internal error: cannot acquire parent role"), string(response))
+			return
+		}
+		parentRole.SetGlobalRuntimeVars(vars)
+	}
+
+	the.EventWriterWithTopic(p.topic).WriteEvent(&pb.Ev_IntegratedServiceEvent{
+		Name:                call.GetName(),
+		OperationName:       call.Func,
+		OperationStatus:     pb.OpStatus_DONE_OK,
+		OperationStep:       operationStep,
+		OperationStepStatus: pb.OpStatus_DONE_OK,
+		EnvironmentId:       envId,
+		Payload:             string(response),
+	})
+
+	compact, err := json.Marshal(document)
+	if err != nil {
+		return string(response)
+	}
+	return string(compact)
+}
+
+func (p *Plugin) invokeMeasured(ctx context.Context, envId string, name string, method *Method, varStack map[string]string) ([]byte, error) {
+	metric := monitoring.NewMetric("declarative")
+	metric.AddTag("service", p.GetName())
+	metric.AddTag("method", name)
+	metric.AddTag("envId", envId)
+	metric.AddTag("runtype", integration.ExtractRunTypeOrUndefined(varStack))
+	defer monitoring.TimerSendSingle(&metric, monitoring.Millisecond)()
+
+	return p.invoker.invoke(ctx, method, varStack)
+}
+
+func (p *Plugin) CallStackFunctions() (functions []string) {
+	functions = make([]string, 0, len(p.desc.Methods))
+	for name := range p.desc.Methods {
+		functions = append(functions, name)
+	}
+	sort.Strings(functions)
+	return
+}
+
+func (p *Plugin) Destroy() error {
+	return p.invoker.close()
+}
@@ -480,6 +480,10 @@ func (p *Plugin) CallStack(data interface{}) (stack map[string]interface{}) {
 	return
 }
 
+func (p *Plugin) CallStackFunctions() []string {
+	return callable.CallStackFunctions(p)
+}
+
 func (p *Plugin) Destroy() error {
 	if err := p.kafkaWriter.Close(); err != nil {
 		log.Fatal("failed to close Kafka writer:", err)
@@ -1683,6 +1683,10 @@ func (p *Plugin) parseDetectors(detectorsParam string) (detectors []string, err
 	return
 }
 
+func (p *Plugin) CallStackFunctions() []string {
+	return callable.CallStackFunctions(p)
+}
+
 func (p *Plugin) Destroy() error {
 	p.cachedStatusCancelFunc()
 	return p.odcClient.Close()
@@ -46,6 +46,9 @@ var (
 
 	loaderOnce    sync.Once
 	pluginLoaders map[string]func() Plugin
+
+	setLoaderOnce    sync.Once
+	pluginSetLoaders map[string]func() []Plugin
 )
 
 type Plugins []Plugin
@@ -65,8 +68,19 @@ type Plugin interface {
 	Destroy() error
 }
 
+// CallStackLister is implemented by plugins which can tell, without a call
+// context, the names of the functions their CallStack provides. This allows
+// workflow templates to be checked statically.
+type CallStackLister interface {
+	CallStackFunctions() []string
+}
+
 type NewFunc func(endpoint string) Plugin
 
+// NewSetFunc builds zero or more plugins from a list of arguments, for plugin
+// kinds that can be instantiated several times under different names.
+type NewSetFunc func(args []string) []Plugin
+
 func RegisteredPlugins() map[string]func() Plugin {
 	return pluginLoaders
 }
@@ -84,6 +98,26 @@ func RegisterPlugin(pluginName string, endpointArgumentName string, newFunc NewF
 	}
 }
 
+// RegisterPluginSet registers a loader which can yield several plugins for a
+// single entry in integrationPlugins. The loader receives the string slice
+// configured under argumentName, and each resulting plugin is exposed under
+// its own GetName().
+func RegisterPluginSet(pluginSetName string, argumentName string, newSetFunc NewSetFunc) {
+	setLoaderOnce.Do(func() {
+		pluginSetLoaders = make(map[string]func() []Plugin)
+	})
+	pluginSetLoaders[pluginSetName] = func() []Plugin {
+		if viper.IsSet(argumentName) {
+			return newSetFunc(viper.GetStringSlice(argumentName))
+		}
+		return nil
+	}
+}
+
+func RegisteredPluginSets() map[string]func() []Plugin {
+	return pluginSetLoaders
+}
+
 func (p Plugins) InitAll(fid string) {
 	wg := &sync.WaitGroup{}
 	wg.Add(len(p))
@@ -121,6 +155,21 @@ func (p Plugins) CallStack(data interface{}) (stack map[string]interface{}) {
 	return
 }
 
+// CallStackFunctions returns the names of the CallStack functions of each
+// loaded plugin, by plugin name. Plugins which do not implement
+// CallStackLister map to a nil slice.
+func (p Plugins) CallStackFunctions() (functions map[string][]string) {
+	functions = make(map[string][]string)
+	for _, plugin := range p {
+		if lister, ok := plugin.(CallStackLister); ok {
+			functions[plugin.GetName()] = lister.CallStackFunctions()
+		} else {
+			functions[plugin.GetName()] = nil
+		}
+	}
+	return
+}
+
 func (p Plugins) ObjectStack(varStack map[string]string, baseConfigStack map[string]string) (stack map[string]interface{}) {
 	stack = make(map[string]interface{})
 
@@ -193,7 +242,28 @@ func PluginsInstance() Plugins {
 		instance = Plugins{}
 		pluginList := viper.GetStringSlice("integrationPlugins")
 
+		loadedNames := make(map[string]struct{})
+
 		for _, pluginName := range pluginList {
+			if pluginSetLoader, ok := pluginSetLoaders[pluginName]; ok {
+				newPlugins := pluginSetLoader()
+				if len(newPlugins) == 0 {
+					log.WithField("plugin", pluginName).
+						Error("plugin set loader failed")
+					continue
+				}
+				for _, newPlugin := range newPlugins {
+					if _, exists := loadedNames[newPlugin.GetName()]; exists {
+						log.WithField("plugin", newPlugin.GetName()).
+							WithField("pluginSet", pluginName).
+							Error("plugin name already in use, skipping")
+						continue
+					}
+					loadedNames[newPlugin.GetName()] = struct{}{}
+					instance = append(instance, newPlugin)
+				}
+				continue
+			}
 			if pluginLoaders == nil {
 				log.WithField("plugin", pluginName).
 					Error("requested plugin unavailable")
@@ -211,6 +281,12 @@ func PluginsInstance() Plugins {
 					Error("plugin loader failed")
 				continue
 			}
+			if _, exists := loadedNames[newPlugin.GetName()]; exists {
+				log.WithField("plugin", newPlugin.GetName()).
+					Error("plugin name already in use, skipping")
+				continue
+			}
+			loadedNames[newPlugin.GetName()] = struct{}{}
 			instance = append(instance, newPlugin)
 		}
 	})
@@ -223,6 +299,9 @@ func Reset() {
 	instance = Plugins{}
 	loaderOnce = sync.Once{}
 	pluginLoaders = make(map[string]func() Plugin)
+	setLoaderOnce = sync.Once{}
+	pluginSetLoaders = make(map[string]func() []Plugin)
+	resetBreakers()
 }
 
 func ExtractRunTypeOrUndefined(varStack map[string]string) string {
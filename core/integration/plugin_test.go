@@ -200,6 +200,47 @@ var _ = Describe("plugin framework", Ordered, func() {
 		})
 	})
 
+	Describe("Plugin Sets", Ordered, func() {
+		newNamedPlugins := func(names []string) []integration.Plugin {
+			plugins := make([]integration.Plugin, 0, len(names))
+			for _, name := range names {
+				plugins = append(plugins, &namedPlugin{Plugin: testplugin.NewPlugin("http://example.com"), name: name})
+			}
+			return plugins
+		}
+
+		BeforeEach(func() {
+			integration.Reset()
+			viper.Reset()
+		})
+
+		It("should expand a plugin set into one plugin per argument", func() {
+			integration.RegisterPluginSet("named", "namedPlugins", newNamedPlugins)
+			viper.Set("namedPlugins", []string{"first", "second"})
+			viper.Set("integrationPlugins", []string{"named"})
+			plugins := integration.PluginsInstance()
+			Expect(plugins).To(HaveLen(2))
+			Expect(plugins[0].GetName()).To(Equal("first"))
+			Expect(plugins[1].GetName()).To(Equal("second"))
+			Expect(plugins.CallStack(&callable.Call{VarStack: map[string]string{}})).To(HaveKey("second"))
+		})
+		It("should skip plugins whose name is already in use", func() {
+			integration.RegisterPlugin("testplugin", "testPluginEndpoint", testplugin.NewPlugin)
+			integration.RegisterPluginSet("named", "namedPlugins", newNamedPlugins)
+			viper.Set("testPluginEndpoint", "http://example.com")
+			viper.Set("namedPlugins", []string{"testplugin", "other"})
+			viper.Set("integrationPlugins", []string{"testplugin", "named"})
+			plugins := integration.PluginsInstance()
+			Expect(plugins).To(HaveLen(2))
+			Expect(plugins[1].GetName()).To(Equal("other"))
+		})
+		It("should not load anything if the argument is not set", func() {
+			integration.RegisterPluginSet("named", "namedPlugins", newNamedPlugins)
+			viper.Set("integrationPlugins", []string{"named"})
+			Expect(integration.PluginsInstance()).To(BeEmpty())
+		})
+	})
+
 	Describe("Object Stack", Ordered, func() {
 		var plugins integration.Plugins
 
@@ -223,6 +264,15 @@ var _ = Describe("plugin framework", Ordered, func() {
 	})
 })
 
+type namedPlugin struct {
+	integration.Plugin
+	name string
+}
+
+func (n *namedPlugin) GetName() string {
+	return n.name
+}
+
 func TestCoreIntegration(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Core Integration Test Suite")
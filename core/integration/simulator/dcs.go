@@ -0,0 +1,399 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package simulator
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	dcspb "github.com/AliceO2Group/Control/core/integration/dcs/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// DCS_TIME_FORMAT must match the format expected by the DCS plugin
+const DCS_TIME_FORMAT = "2006-01-02 15:04:05.000"
+
+const dcsSubscriberBufferSize = 64
+
+type dcsServer struct {
+	dcspb.UnimplementedConfiguratorServer
+	sim *Simulator
+
+	mu            sync.Mutex
+	detectors     map[dcspb.Detector]*dcspb.DetectorInfo
+	subscribers   map[chan *dcspb.Event]struct{}
+	cancelChanges context.CancelFunc
+	done          chan struct{}
+	stopOnce      sync.Once
+}
+
+// dcsOperation describes how a PFR, SOR or EOR request is simulated.
+type dcsOperation struct {
+	call              string
+	eventType         dcspb.EventType
+	progressState     dcspb.DetectorState
+	failState         dcspb.DetectorState
+	unavailableState  dcspb.DetectorState // reported if the detector is not available for this operation
+	availability      func(*dcspb.DetectorInfo) dcspb.DetectorState
+	stateAfterSuccess dcspb.DetectorState
+}
+
+var (
+	dcsPfr = dcsOperation{
+		call:              "PrepareForRun",
+		eventType:         dcspb.EventType_PFR_EVENT,
+		progressState:     dcspb.DetectorState_PREPARING,
+		failState:         dcspb.DetectorState_SOR_FAILURE, // the DCS plugin expects SOR_FAILURE for failed PFRs
+		unavailableState:  dcspb.DetectorState_PFR_UNAVAILABLE,
+		availability:      (*dcspb.DetectorInfo).GetPfrAvailability,
+		stateAfterSuccess: dcspb.DetectorState_READY,
+	}
+	dcsSor = dcsOperation{
+		call:              "StartOfRun",
+		eventType:         dcspb.EventType_SOR_EVENT,
+		progressState:     dcspb.DetectorState_SOR_PROGRESSING,
+		failState:         dcspb.DetectorState_SOR_FAILURE,
+		unavailableState:  dcspb.DetectorState_SOR_UNAVAILABLE,
+		availability:      (*dcspb.DetectorInfo).GetSorAvailability,
+		stateAfterSuccess: dcspb.DetectorState_RUN_OK,
+	}
+	dcsEor = dcsOperation{
+		call:              "EndOfRun",
+		eventType:         dcspb.EventType_EOR_EVENT,
+		progressState:     dcspb.DetectorState_EOR_PROGRESSING,
+		failState:         dcspb.DetectorState_EOR_FAILURE,
+		unavailableState:  dcspb.DetectorState_NULL_STATE, // EOR is always performed
+		stateAfterSuccess: dcspb.DetectorState_READY,
+	}
+)
+
+func newDcsServer(sim *Simulator) *dcsServer {
+	s := &dcsServer{
+		sim:         sim,
+		subscribers: make(map[chan *dcspb.Event]struct{}),
+		done:        make(chan struct{}),
+	}
+	s.resetDetectors()
+	go s.heartbeatLoop()
+	return s
+}
+
+func dcsTimestamp() string {
+	return time.Now().Format(DCS_TIME_FORMAT)
+}
+
+// resetDetectors sets all detectors to the initial states of the current scenario, and
+// (re)schedules its state changes.
+func (s *dcsServer) resetDetectors() {
+	sc := s.sim.getScenario()
+
+	detectors := make(map[dcspb.Detector]*dcspb.DetectorInfo)
+	for value := range dcspb.Detector_name {
+		det := dcspb.Detector(value)
+		if det == dcspb.Detector_NULL_DETECTOR || det == dcspb.Detector_LHC || det == dcspb.Detector_DCS {
+			continue
+		}
+		detectors[det] = &dcspb.DetectorInfo{
+			Detector:        det,
+			State:           dcspb.DetectorState_READY,
+			Timestamp:       dcsTimestamp(),
+			AllowedRunTypes: []dcspb.RunType{dcspb.RunType_ANY},
+			PfrAvailability: dcspb.DetectorState_PFR_AVAILABLE,
+			SorAvailability: dcspb.DetectorState_SOR_AVAILABLE,
+		}
+	}
+	for name, detSc := range sc.Dcs.Detectors {
+		det, _ := parseDcsDetector(name) // validated on load
+		info, ok := detectors[det]
+		if !ok {
+			continue
+		}
+		if len(detSc.State) > 0 {
+			info.State, _ = parseDcsState(detSc.State)
+		}
+		if len(detSc.PfrAvailability) > 0 {
+			info.PfrAvailability, _ = parseDcsState(detSc.PfrAvailability)
+		}
+		if len(detSc.SorAvailability) > 0 {
+			info.SorAvailability, _ = parseDcsState(detSc.SorAvailability)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s.mu.Lock()
+	if s.cancelChanges != nil {
+		s.cancelChanges()
+	}
+	s.cancelChanges = cancel
+	s.detectors = detectors
+	s.mu.Unlock()
+
+	for _, change := range sc.Dcs.StateChanges {
+		go func(change DcsStateChange) {
+			if sleep(ctx, change.After) != nil {
+				return
+			}
+			det, _ := parseDcsDetector(change.Detector)
+			state, _ := parseDcsState(change.State)
+			s.setDetectorState(det, state)
+		}(change)
+	}
+
+	s.publish(s.matrixEvent(dcspb.EventType_HEARTBEAT))
+}
+
+// setDetectorState updates the state or, for PFR_* and SOR_* states, the availability of
+// a detector, and publishes the change to subscribers.
+func (s *dcsServer) setDetectorState(det dcspb.Detector, state dcspb.DetectorState) {
+	s.mu.Lock()
+	info, ok := s.detectors[det]
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	switch state {
+	case dcspb.DetectorState_PFR_AVAILABLE, dcspb.DetectorState_PFR_UNAVAILABLE:
+		info.PfrAvailability = state
+	case dcspb.DetectorState_SOR_AVAILABLE, dcspb.DetectorState_SOR_UNAVAILABLE:
+		info.SorAvailability = state
+	default:
+		info.State = state
+	}
+	info.Timestamp = dcsTimestamp()
+	s.mu.Unlock()
+
+	log.WithField("detector", det.String()).
+		WithField("state", state.String()).
+		Info("DCS detector state changed")
+
+	s.publish(&dcspb.Event{
+		Eventtype: dcspb.EventType_STATE_CHANGE_EVENT,
+		DetectorMatrix: []*dcspb.DetectorInfo{{
+			Detector:  det,
+			State:     state,
+			Timestamp: dcsTimestamp(),
+		}},
+		Timestamp: dcsTimestamp(),
+	})
+}
+
+func (s *dcsServer) detectorInfo(det dcspb.Detector) *dcspb.DetectorInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, ok := s.detectors[det]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(info).(*dcspb.DetectorInfo)
+}
+
+func (s *dcsServer) matrix(filter []dcspb.Detector) []*dcspb.DetectorInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matrix := make([]*dcspb.DetectorInfo, 0, len(s.detectors))
+	if len(filter) == 0 {
+		for _, info := range s.detectors {
+			matrix = append(matrix, proto.Clone(info).(*dcspb.DetectorInfo))
+		}
+	} else {
+		for _, det := range filter {
+			if info, ok := s.detectors[det]; ok {
+				matrix = append(matrix, proto.Clone(info).(*dcspb.DetectorInfo))
+			}
+		}
+	}
+	sort.Slice(matrix, func(i, j int) bool {
+		return matrix[i].Detector < matrix[j].Detector
+	})
+	return matrix
+}
+
+func (s *dcsServer) matrixEvent(eventType dcspb.EventType) *dcspb.Event {
+	return &dcspb.Event{
+		Eventtype:      eventType,
+		DetectorMatrix: s.matrix(nil),
+		Timestamp:      dcsTimestamp(),
+	}
+}
+
+func (s *dcsServer) publish(ev *dcspb.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subscribers {
+		select {
+		case ch <- ev:
+		default:
+			log.WithField("event", ev.GetEventtype().String()).
+				Warn("DCS subscriber too slow, event dropped")
+		}
+	}
+}
+
+func (s *dcsServer) heartbeatLoop() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-time.After(s.sim.getScenario().dcsHeartbeatInterval()):
+			s.publish(s.matrixEvent(dcspb.EventType_HEARTBEAT))
+		}
+	}
+}
+
+func (s *dcsServer) stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+		s.mu.Lock()
+		if s.cancelChanges != nil {
+			s.cancelChanges()
+		}
+		s.mu.Unlock()
+	})
+}
+
+func (s *dcsServer) Subscribe(req *dcspb.SubscriptionRequest, stream dcspb.Configurator_SubscribeServer) error {
+	log.WithField("instanceId", req.GetInstanceId()).
+		Info("DCS subscriber connected")
+
+	ch := make(chan *dcspb.Event, dcsSubscriberBufferSize)
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.subscribers, ch)
+		s.mu.Unlock()
+		log.WithField("instanceId", req.GetInstanceId()).
+			Info("DCS subscriber disconnected")
+	}()
+
+	if err := stream.Send(s.matrixEvent(dcspb.EventType_HEARTBEAT)); err != nil {
+		return err
+	}
+	for {
+		select {
+		case ev := <-ch:
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-s.done:
+			return nil
+		}
+	}
+}
+
+func (s *dcsServer) PrepareForRun(req *dcspb.PfrRequest, stream dcspb.Configurator_PrepareForRunServer) error {
+	return s.runOperation(stream.Context(), dcsPfr, req.GetDetectors(), stream.Send)
+}
+
+func (s *dcsServer) StartOfRun(req *dcspb.SorRequest, stream dcspb.Configurator_StartOfRunServer) error {
+	return s.runOperation(stream.Context(), dcsSor, req.GetDetectors(), stream.Send)
+}
+
+func (s *dcsServer) EndOfRun(req *dcspb.EorRequest, stream dcspb.Configurator_EndOfRunServer) error {
+	return s.runOperation(stream.Context(), dcsEor, req.GetDetectors(), stream.Send)
+}
+
+// runOperation streams a PROGRESSING event for each detector, then after the scripted delay
+// a RUN_OK or failure event for each detector, and ends the stream.
+func (s *dcsServer) runOperation(ctx context.Context, op dcsOperation, requests []*dcspb.DetectorOperationRequest, send func(*dcspb.RunEvent) error) error {
+	b, fail := s.sim.call(DCS, op.call)
+	if fail && b.GrpcError {
+		if err := sleep(ctx, b.Delay); err != nil {
+			return err
+		}
+		return b.grpcErr(DCS, op.call)
+	}
+
+	failState := op.failState
+	if len(b.FailState) > 0 {
+		failState, _ = parseDcsState(b.FailState)
+	}
+
+	detectors := make([]dcspb.Detector, 0, len(requests))
+	for _, req := range requests {
+		det := req.GetDetector()
+		info := s.detectorInfo(det)
+		if info == nil {
+			continue
+		}
+		if op.unavailableState != dcspb.DetectorState_NULL_STATE && op.availability(info) == op.unavailableState {
+			if err := send(&dcspb.RunEvent{
+				Eventtype: op.eventType,
+				Detector:  det,
+				State:     op.unavailableState,
+				Timestamp: dcsTimestamp(),
+				Message:   det.String() + " not available for " + op.call,
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+		detectors = append(detectors, det)
+		if err := send(&dcspb.RunEvent{
+			Eventtype: op.eventType,
+			Detector:  det,
+			State:     op.progressState,
+			Timestamp: dcsTimestamp(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := sleep(ctx, b.Delay); err != nil {
+		return err
+	}
+
+	for _, det := range detectors {
+		ev := &dcspb.RunEvent{
+			Eventtype: op.eventType,
+			Detector:  det,
+			State:     dcspb.DetectorState_RUN_OK,
+			Timestamp: dcsTimestamp(),
+		}
+		if fail && b.failsDetector(det) {
+			ev.State = failState
+			ev.Message = b.errorMessage(DCS, op.call)
+			s.setDetectorState(det, failState)
+		} else {
+			s.setDetectorState(det, op.stateAfterSuccess)
+		}
+		if err := send(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *dcsServer) GetStatus(_ context.Context, req *dcspb.StatusRequest) (*dcspb.StatusReply, error) {
+	return &dcspb.StatusReply{
+		DetectorMatrix: s.matrix(req.GetDetector()),
+	}, nil
+}
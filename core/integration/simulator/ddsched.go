@@ -0,0 +1,169 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package simulator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ddpb "github.com/AliceO2Group/Control/core/integration/ddsched/protos"
+)
+
+type ddschedServer struct {
+	ddpb.UnimplementedDataDistributionControlServer
+	sim *Simulator
+
+	mu         sync.Mutex
+	partitions map[string]ddpb.PartitionState
+}
+
+func newDdschedServer(sim *Simulator) *ddschedServer {
+	return &ddschedServer{
+		sim:        sim,
+		partitions: make(map[string]ddpb.PartitionState),
+	}
+}
+
+// settle moves a partition to its final state once the scripted delay has elapsed, unless
+// its state was changed in the meantime.
+func (s *ddschedServer) settle(partitionId string, transient ddpb.PartitionState, final ddpb.PartitionState, delay time.Duration) {
+	apply := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.partitions[partitionId] == transient {
+			s.partitions[partitionId] = final
+			log.WithField("partition", partitionId).
+				WithField("state", final.String()).
+				Info("DD scheduler partition state changed")
+		}
+	}
+	if delay <= 0 {
+		apply()
+		return
+	}
+	time.AfterFunc(delay, apply)
+}
+
+// PartitionInitialize replies PARTITION_CONFIGURING, and the partition becomes
+// PARTITION_CONFIGURED (or PARTITION_ERROR on failure) after the scripted delay.
+func (s *ddschedServer) PartitionInitialize(_ context.Context, req *ddpb.PartitionInitRequest) (*ddpb.PartitionResponse, error) {
+	b, fail := s.sim.call(DDSCHED, "PartitionInitialize")
+	if fail && b.GrpcError {
+		return nil, b.grpcErr(DDSCHED, "PartitionInitialize")
+	}
+
+	partitionId := req.GetPartitionInfo().GetPartitionId()
+	if len(partitionId) == 0 {
+		return &ddpb.PartitionResponse{
+			PartitionState: ddpb.PartitionState_PARTITION_REQUEST_INVALID,
+			InfoMessage:    "partition ID not provided",
+		}, nil
+	}
+	if len(req.GetStfbHostIdMap()) != len(req.GetStfsHostIdMap()) {
+		return &ddpb.PartitionResponse{
+			PartitionState: ddpb.PartitionState_PARTITION_REQUEST_INVALID,
+			InfoMessage:    "StfBuilder and StfSender counts do not match",
+		}, nil
+	}
+
+	s.mu.Lock()
+	if state, exists := s.partitions[partitionId]; exists && state != ddpb.PartitionState_PARTITION_TERMINATED {
+		s.mu.Unlock()
+		return &ddpb.PartitionResponse{
+			PartitionState: ddpb.PartitionState_PARTITION_REQUEST_INVALID,
+			InfoMessage:    "partition already exists in state " + state.String(),
+		}, nil
+	}
+	s.partitions[partitionId] = ddpb.PartitionState_PARTITION_CONFIGURING
+	s.mu.Unlock()
+
+	final, infoMessage := ddpb.PartitionState_PARTITION_CONFIGURED, ""
+	if fail {
+		final, infoMessage = ddpb.PartitionState_PARTITION_ERROR, b.errorMessage(DDSCHED, "PartitionInitialize")
+	}
+	s.settle(partitionId, ddpb.PartitionState_PARTITION_CONFIGURING, final, b.Delay)
+
+	return &ddpb.PartitionResponse{
+		PartitionState: ddpb.PartitionState_PARTITION_CONFIGURING,
+		InfoMessage:    infoMessage,
+	}, nil
+}
+
+// PartitionTerminate replies PARTITION_TERMINATING, and the partition becomes
+// PARTITION_TERMINATED (or PARTITION_ERROR on failure) after the scripted delay.
+func (s *ddschedServer) PartitionTerminate(_ context.Context, req *ddpb.PartitionTermRequest) (*ddpb.PartitionResponse, error) {
+	b, fail := s.sim.call(DDSCHED, "PartitionTerminate")
+	if fail && b.GrpcError {
+		return nil, b.grpcErr(DDSCHED, "PartitionTerminate")
+	}
+
+	partitionId := req.GetPartitionInfo().GetPartitionId()
+
+	s.mu.Lock()
+	state, exists := s.partitions[partitionId]
+	if !exists {
+		s.mu.Unlock()
+		return &ddpb.PartitionResponse{
+			PartitionState: ddpb.PartitionState_PARTITION_UNKNOWN,
+		}, nil
+	}
+	if state == ddpb.PartitionState_PARTITION_TERMINATED {
+		s.mu.Unlock()
+		return &ddpb.PartitionResponse{
+			PartitionState: ddpb.PartitionState_PARTITION_TERMINATED,
+		}, nil
+	}
+	s.partitions[partitionId] = ddpb.PartitionState_PARTITION_TERMINATING
+	s.mu.Unlock()
+
+	final, infoMessage := ddpb.PartitionState_PARTITION_TERMINATED, ""
+	if fail {
+		final, infoMessage = ddpb.PartitionState_PARTITION_ERROR, b.errorMessage(DDSCHED, "PartitionTerminate")
+	}
+	s.settle(partitionId, ddpb.PartitionState_PARTITION_TERMINATING, final, b.Delay)
+
+	return &ddpb.PartitionResponse{
+		PartitionState: ddpb.PartitionState_PARTITION_TERMINATING,
+		InfoMessage:    infoMessage,
+	}, nil
+}
+
+func (s *ddschedServer) PartitionStatus(ctx context.Context, req *ddpb.PartitionInfo) (*ddpb.PartitionResponse, error) {
+	b, fail := s.sim.call(DDSCHED, "PartitionStatus")
+	if err := sleep(ctx, b.Delay); err != nil {
+		return nil, err
+	}
+	if fail {
+		return nil, b.grpcErr(DDSCHED, "PartitionStatus")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, exists := s.partitions[req.GetPartitionId()]
+	if !exists {
+		state = ddpb.PartitionState_PARTITION_UNKNOWN
+	}
+	return &ddpb.PartitionResponse{PartitionState: state}, nil
+}
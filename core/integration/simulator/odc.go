@@ -0,0 +1,343 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	odcpb "github.com/AliceO2Group/Control/core/integration/odc/protos"
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner/fairmq"
+)
+
+const odcErrorCode = 1
+
+type odcPartition struct {
+	sessionId string
+	runNumber uint64
+	state     string // aggregated FairMQ state
+	devices   []*odcpb.Device
+}
+
+type odcServer struct {
+	odcpb.UnimplementedODCServer
+	sim *Simulator
+
+	mu         sync.Mutex
+	partitions map[string]*odcPartition
+}
+
+func newOdcServer(sim *Simulator) *odcServer {
+	return &odcServer{
+		sim:        sim,
+		partitions: make(map[string]*odcPartition),
+	}
+}
+
+func (p *odcPartition) setState(state string) {
+	p.state = state
+	for _, device := range p.devices {
+		device.State = state
+	}
+}
+
+// generalReply builds a reply for partitionId, with the error message if errMsg is not empty.
+func (s *odcServer) generalReply(partitionId string, runNumber uint64, start time.Time, errMsg string) *odcpb.GeneralReply {
+	rep := &odcpb.GeneralReply{
+		Status:      odcpb.ReplyStatus_SUCCESS,
+		Exectime:    int32(time.Since(start).Milliseconds()),
+		Partitionid: partitionId,
+		Runnr:       runNumber,
+		State:       "UNDEFINED",
+	}
+	if p, ok := s.partitions[partitionId]; ok {
+		rep.Sessionid = p.sessionId
+		rep.State = p.state
+		for _, device := range p.devices {
+			rep.Hosts = append(rep.Hosts, device.Host)
+		}
+	}
+	if len(errMsg) > 0 {
+		rep.Status = odcpb.ReplyStatus_ERROR
+		rep.Error = &odcpb.Error{Msg: errMsg, Code: odcErrorCode}
+		rep.Msg = errMsg
+	}
+	return rep
+}
+
+// do applies the scripted behaviour of call, and if it succeeds runs fn with the lock held.
+// fn returns an error message, or an empty string on success.
+// If failState is not empty, a scripted failure moves the partition to that state.
+func (s *odcServer) do(ctx context.Context, call string, partitionId string, runNumber uint64, failState string, fn func() string) (*odcpb.GeneralReply, error) {
+	start := time.Now()
+	b, fail := s.sim.call(ODC, call)
+	if err := sleep(ctx, b.Delay); err != nil {
+		return nil, err
+	}
+	if fail && b.GrpcError {
+		return nil, b.grpcErr(ODC, call)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	errMsg := ""
+	if fail {
+		errMsg = b.errorMessage(ODC, call)
+		if p, ok := s.partitions[partitionId]; ok && len(failState) > 0 {
+			p.setState(failState)
+		}
+	} else {
+		errMsg = fn()
+	}
+	if len(errMsg) > 0 {
+		log.WithField("partition", partitionId).
+			WithField("call", call).
+			Warn(errMsg)
+	}
+	return s.generalReply(partitionId, runNumber, start, errMsg), nil
+}
+
+// transition moves a partition from one of the fromStates to toState.
+func (s *odcServer) transition(ctx context.Context, call string, req *odcpb.StateRequest, toState string, fromStates ...string) (*odcpb.StateReply, error) {
+	partitionId := req.GetPartitionid()
+	rep, err := s.do(ctx, call, partitionId, req.GetRunnr(), fairmq.ERROR, func() string {
+		p, ok := s.partitions[partitionId]
+		if !ok {
+			return fmt.Sprintf("partition %s not found", partitionId)
+		}
+		allowed := false
+		for _, from := range fromStates {
+			allowed = allowed || p.state == from
+		}
+		if !allowed {
+			return fmt.Sprintf("cannot %s partition %s in state %s", call, partitionId, p.state)
+		}
+		if req.GetRunnr() != 0 {
+			p.runNumber = req.GetRunnr()
+		}
+		p.setState(toState)
+		log.WithField("partition", partitionId).
+			WithField("state", toState).
+			Infof("ODC %s done", call)
+		return ""
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.stateReply(rep, partitionId, req.GetDetailed()), nil
+}
+
+func (s *odcServer) stateReply(rep *odcpb.GeneralReply, partitionId string, detailed bool) *odcpb.StateReply {
+	stateReply := &odcpb.StateReply{Reply: rep}
+	if !detailed {
+		return stateReply
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p, ok := s.partitions[partitionId]; ok {
+		for _, device := range p.devices {
+			stateReply.Devices = append(stateReply.Devices, &odcpb.Device{
+				Id:    device.Id,
+				State: device.State,
+				Path:  device.Path,
+				Host:  device.Host,
+			})
+		}
+	}
+	return stateReply
+}
+
+// createPartition creates a partition with the scripted number of devices, unless it exists.
+// The lock must be held.
+func (s *odcServer) createPartition(partitionId string, runNumber uint64, sessionId string) *odcPartition {
+	if p, ok := s.partitions[partitionId]; ok {
+		return p
+	}
+	if len(sessionId) == 0 {
+		sessionId = uid.New().String()
+	}
+	p := &odcPartition{
+		sessionId: sessionId,
+		runNumber: runNumber,
+		state:     fairmq.IDLE,
+	}
+	for i := 0; i < s.sim.getScenario().odcDevices(); i++ {
+		p.devices = append(p.devices, &odcpb.Device{
+			Id:    uint64(i + 1),
+			State: fairmq.IDLE,
+			Path:  fmt.Sprintf("main/sim-%s/device_%d", partitionId, i),
+			Host:  fmt.Sprintf("epn%03d", i%10),
+		})
+	}
+	s.partitions[partitionId] = p
+	log.WithField("partition", partitionId).
+		WithField("session", sessionId).
+		Info("ODC partition created")
+	return p
+}
+
+func (s *odcServer) Initialize(ctx context.Context, req *odcpb.InitializeRequest) (*odcpb.GeneralReply, error) {
+	return s.do(ctx, "Initialize", req.GetPartitionid(), req.GetRunnr(), "", func() string {
+		s.createPartition(req.GetPartitionid(), req.GetRunnr(), req.GetSessionid())
+		return ""
+	})
+}
+
+func (s *odcServer) Submit(ctx context.Context, req *odcpb.SubmitRequest) (*odcpb.GeneralReply, error) {
+	return s.do(ctx, "Submit", req.GetPartitionid(), req.GetRunnr(), "", func() string {
+		if _, ok := s.partitions[req.GetPartitionid()]; !ok {
+			return fmt.Sprintf("partition %s not found", req.GetPartitionid())
+		}
+		return ""
+	})
+}
+
+func (s *odcServer) Activate(ctx context.Context, req *odcpb.ActivateRequest) (*odcpb.GeneralReply, error) {
+	return s.do(ctx, "Activate", req.GetPartitionid(), req.GetRunnr(), "", func() string {
+		if _, ok := s.partitions[req.GetPartitionid()]; !ok {
+			return fmt.Sprintf("partition %s not found", req.GetPartitionid())
+		}
+		return ""
+	})
+}
+
+func (s *odcServer) Run(ctx context.Context, req *odcpb.RunRequest) (*odcpb.GeneralReply, error) {
+	return s.do(ctx, "Run", req.GetPartitionid(), req.GetRunnr(), "", func() string {
+		s.createPartition(req.GetPartitionid(), req.GetRunnr(), "")
+		return ""
+	})
+}
+
+func (s *odcServer) Update(ctx context.Context, req *odcpb.UpdateRequest) (*odcpb.GeneralReply, error) {
+	return s.do(ctx, "Update", req.GetPartitionid(), req.GetRunnr(), "", func() string {
+		if _, ok := s.partitions[req.GetPartitionid()]; !ok {
+			return fmt.Sprintf("partition %s not found", req.GetPartitionid())
+		}
+		return ""
+	})
+}
+
+func (s *odcServer) SetProperties(ctx context.Context, req *odcpb.SetPropertiesRequest) (*odcpb.GeneralReply, error) {
+	return s.do(ctx, "SetProperties", req.GetPartitionid(), req.GetRunnr(), "", func() string {
+		if _, ok := s.partitions[req.GetPartitionid()]; !ok {
+			return fmt.Sprintf("partition %s not found", req.GetPartitionid())
+		}
+		return ""
+	})
+}
+
+func (s *odcServer) Configure(ctx context.Context, req *odcpb.ConfigureRequest) (*odcpb.StateReply, error) {
+	return s.transition(ctx, "Configure", req.GetRequest(), fairmq.READY, fairmq.IDLE)
+}
+
+func (s *odcServer) Start(ctx context.Context, req *odcpb.StartRequest) (*odcpb.StateReply, error) {
+	return s.transition(ctx, "Start", req.GetRequest(), fairmq.RUNNING, fairmq.READY)
+}
+
+func (s *odcServer) Stop(ctx context.Context, req *odcpb.StopRequest) (*odcpb.StateReply, error) {
+	return s.transition(ctx, "Stop", req.GetRequest(), fairmq.READY, fairmq.RUNNING)
+}
+
+func (s *odcServer) Reset(ctx context.Context, req *odcpb.ResetRequest) (*odcpb.StateReply, error) {
+	return s.transition(ctx, "Reset", req.GetRequest(), fairmq.IDLE, fairmq.READY, fairmq.ERROR)
+}
+
+func (s *odcServer) Terminate(ctx context.Context, req *odcpb.TerminateRequest) (*odcpb.StateReply, error) {
+	return s.transition(ctx, "Terminate", req.GetRequest(), fairmq.EXITING, fairmq.IDLE, fairmq.READY, fairmq.RUNNING, fairmq.ERROR)
+}
+
+func (s *odcServer) Shutdown(ctx context.Context, req *odcpb.ShutdownRequest) (*odcpb.GeneralReply, error) {
+	return s.do(ctx, "Shutdown", req.GetPartitionid(), req.GetRunnr(), "", func() string {
+		if _, ok := s.partitions[req.GetPartitionid()]; !ok {
+			return fmt.Sprintf("partition %s not found", req.GetPartitionid())
+		}
+		delete(s.partitions, req.GetPartitionid())
+		log.WithField("partition", req.GetPartitionid()).
+			Info("ODC partition shut down")
+		return ""
+	})
+}
+
+func (s *odcServer) GetState(ctx context.Context, req *odcpb.StateRequest) (*odcpb.StateReply, error) {
+	partitionId := req.GetPartitionid()
+	rep, err := s.do(ctx, "GetState", partitionId, req.GetRunnr(), "", func() string {
+		if _, ok := s.partitions[partitionId]; !ok {
+			return fmt.Sprintf("partition %s not found", partitionId)
+		}
+		return ""
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.stateReply(rep, partitionId, req.GetDetailed()), nil
+}
+
+func (s *odcServer) Status(ctx context.Context, _ *odcpb.StatusRequest) (*odcpb.StatusReply, error) {
+	start := time.Now()
+	b, fail := s.sim.call(ODC, "Status")
+	if err := sleep(ctx, b.Delay); err != nil {
+		return nil, err
+	}
+	if fail {
+		if b.GrpcError {
+			return nil, b.grpcErr(ODC, "Status")
+		}
+		errMsg := b.errorMessage(ODC, "Status")
+		return &odcpb.StatusReply{
+			Msg:    errMsg,
+			Status: odcpb.ReplyStatus_ERROR,
+			Error:  &odcpb.Error{Msg: errMsg, Code: odcErrorCode},
+		}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	partitionIds := make([]string, 0, len(s.partitions))
+	for partitionId := range s.partitions {
+		partitionIds = append(partitionIds, partitionId)
+	}
+	sort.Strings(partitionIds)
+
+	rep := &odcpb.StatusReply{
+		Status:   odcpb.ReplyStatus_SUCCESS,
+		Exectime: int32(time.Since(start).Milliseconds()),
+	}
+	for _, partitionId := range partitionIds {
+		p := s.partitions[partitionId]
+		rep.Partitions = append(rep.Partitions, &odcpb.PartitionStatus{
+			Partitionid: partitionId,
+			Runnr:       p.runNumber,
+			Sessionid:   p.sessionId,
+			Status:      odcpb.SessionStatus_RUNNING,
+			State:       p.state,
+		})
+	}
+	return rep, nil
+}
@@ -0,0 +1,209 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package simulator
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	dcspb "github.com/AliceO2Group/Control/core/integration/dcs/protos"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DEFAULT_DCS_HEARTBEAT_INTERVAL = 10 * time.Second
+	DEFAULT_ODC_DEVICE_COUNT       = 4
+)
+
+// Scenario scripts the behaviour of the simulated services.
+// A zero Scenario makes all services reply successfully and immediately, with all
+// DCS detectors READY and available for PFR and SOR.
+type Scenario struct {
+	Dcs     DcsScenario     `yaml:"dcs"`
+	Trg     ServiceScenario `yaml:"trg"`
+	Odc     OdcScenario     `yaml:"odc"`
+	DDsched ServiceScenario `yaml:"ddsched"`
+}
+
+// ServiceScenario maps the name of a gRPC method of a service (e.g. "StartOfRun",
+// "RunLoad", "Configure", "PartitionInitialize") to the behaviour of the simulator
+// when that method is called.
+type ServiceScenario struct {
+	Calls map[string]*CallBehaviour `yaml:"calls"`
+}
+
+// CallBehaviour scripts the response to a single gRPC method.
+type CallBehaviour struct {
+	Delay     time.Duration `yaml:"delay"`     // time spent before the outcome of the call is known
+	Fail      bool          `yaml:"fail"`      // whether the call fails
+	FailTimes int           `yaml:"failTimes"` // if > 0, only the first failTimes calls fail
+	Message   string        `yaml:"message"`   // error message reported on failure
+	GrpcError bool          `yaml:"grpcError"` // fail with a gRPC error rather than with a failure reply
+
+	// DCS only
+	FailDetectors []string `yaml:"failDetectors"` // if set, only these detectors fail, implies fail
+	FailState     string   `yaml:"failState"`     // state reported by failing detectors, e.g. TIMEOUT
+}
+
+type DcsScenario struct {
+	ServiceScenario   `yaml:",inline"`
+	HeartbeatInterval time.Duration                  `yaml:"heartbeatInterval"`
+	Detectors         map[string]DcsDetectorScenario `yaml:"detectors"` // by DCS detector name, e.g. TPC
+	StateChanges      []DcsStateChange               `yaml:"stateChanges"`
+}
+
+// DcsDetectorScenario is the initial state of a DCS detector. Detectors which are not
+// listed start READY, PFR_AVAILABLE and SOR_AVAILABLE.
+type DcsDetectorScenario struct {
+	State           string `yaml:"state"`
+	PfrAvailability string `yaml:"pfrAvailability"`
+	SorAvailability string `yaml:"sorAvailability"`
+}
+
+// DcsStateChange is a detector state change published to the Subscribe streams a
+// given time after the scenario is loaded.
+// PFR_* and SOR_* states update the PFR and SOR availability of the detector.
+type DcsStateChange struct {
+	After    time.Duration `yaml:"after"`
+	Detector string        `yaml:"detector"`
+	State    string        `yaml:"state"`
+}
+
+type OdcScenario struct {
+	ServiceScenario `yaml:",inline"`
+	Devices         int `yaml:"devices"` // number of devices reported per partition
+}
+
+// LoadScenario reads a YAML scenario file. An empty path yields the default scenario.
+func LoadScenario(path string) (*Scenario, error) {
+	sc := &Scenario{}
+	if len(path) == 0 {
+		return sc, sc.validate()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal(data, sc); err != nil {
+		return nil, fmt.Errorf("cannot parse scenario %s: %w", path, err)
+	}
+	if err = sc.validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %w", path, err)
+	}
+	return sc, nil
+}
+
+func (sc *Scenario) validate() error {
+	for name, det := range sc.Dcs.Detectors {
+		if _, err := parseDcsDetector(name); err != nil {
+			return err
+		}
+		for _, state := range []string{det.State, det.PfrAvailability, det.SorAvailability} {
+			if len(state) == 0 {
+				continue
+			}
+			if _, err := parseDcsState(state); err != nil {
+				return err
+			}
+		}
+	}
+	for _, change := range sc.Dcs.StateChanges {
+		if _, err := parseDcsDetector(change.Detector); err != nil {
+			return err
+		}
+		if _, err := parseDcsState(change.State); err != nil {
+			return err
+		}
+	}
+	for call, b := range sc.Dcs.Calls {
+		if b == nil {
+			continue
+		}
+		for _, det := range b.FailDetectors {
+			if _, err := parseDcsDetector(det); err != nil {
+				return fmt.Errorf("call %s: %w", call, err)
+			}
+		}
+		if len(b.FailState) > 0 {
+			if _, err := parseDcsState(b.FailState); err != nil {
+				return fmt.Errorf("call %s: %w", call, err)
+			}
+		}
+	}
+	if sc.Odc.Devices < 0 {
+		return fmt.Errorf("negative ODC device count %d", sc.Odc.Devices)
+	}
+	return nil
+}
+
+func (sc *Scenario) dcsHeartbeatInterval() time.Duration {
+	if sc.Dcs.HeartbeatInterval <= 0 {
+		return DEFAULT_DCS_HEARTBEAT_INTERVAL
+	}
+	return sc.Dcs.HeartbeatInterval
+}
+
+func (sc *Scenario) odcDevices() int {
+	if sc.Odc.Devices == 0 {
+		return DEFAULT_ODC_DEVICE_COUNT
+	}
+	return sc.Odc.Devices
+}
+
+func (ss *ServiceScenario) behaviour(call string) CallBehaviour {
+	if ss == nil || ss.Calls == nil || ss.Calls[call] == nil {
+		return CallBehaviour{}
+	}
+	return *ss.Calls[call]
+}
+
+func (b CallBehaviour) failsDetector(det dcspb.Detector) bool {
+	if len(b.FailDetectors) == 0 {
+		return true
+	}
+	for _, name := range b.FailDetectors {
+		if parsed, _ := parseDcsDetector(name); parsed == det {
+			return true
+		}
+	}
+	return false
+}
+
+func parseDcsDetector(name string) (dcspb.Detector, error) {
+	det, ok := dcspb.Detector_value[strings.ToUpper(strings.TrimSpace(name))]
+	if !ok || det == int32(dcspb.Detector_NULL_DETECTOR) {
+		return dcspb.Detector_NULL_DETECTOR, fmt.Errorf("unknown DCS detector %s", name)
+	}
+	return dcspb.Detector(det), nil
+}
+
+func parseDcsState(name string) (dcspb.DetectorState, error) {
+	state, ok := dcspb.DetectorState_value[strings.ToUpper(strings.TrimSpace(name))]
+	if !ok {
+		return dcspb.DetectorState_NULL_STATE, fmt.Errorf("unknown DCS detector state %s", name)
+	}
+	return dcspb.DetectorState(state), nil
+}
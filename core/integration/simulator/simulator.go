@@ -0,0 +1,183 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package simulator implements local stand-ins for the DCS, TRG, ODC and DD scheduler
+// gRPC services, with scriptable behaviours, so that the corresponding integration
+// plugins can be exercised without the real services.
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	dcspb "github.com/AliceO2Group/Control/core/integration/dcs/protos"
+	ddpb "github.com/AliceO2Group/Control/core/integration/ddsched/protos"
+	odcpb "github.com/AliceO2Group/Control/core/integration/odc/protos"
+	trgpb "github.com/AliceO2Group/Control/core/integration/trg/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+var log = logger.New(logrus.StandardLogger(), "simulator")
+
+const (
+	DCS     = "dcs"
+	TRG     = "trg"
+	ODC     = "odc"
+	DDSCHED = "ddsched"
+)
+
+// Services lists the services which can be simulated, in the order they are usually started.
+var Services = []string{DCS, TRG, ODC, DDSCHED}
+
+type Simulator struct {
+	mu         sync.RWMutex
+	scenario   *Scenario
+	callCounts map[string]int
+
+	dcs     *dcsServer
+	trg     *trgServer
+	odc     *odcServer
+	ddsched *ddschedServer
+}
+
+func New(scenario *Scenario) *Simulator {
+	if scenario == nil {
+		scenario = &Scenario{}
+	}
+	s := &Simulator{
+		scenario:   scenario,
+		callCounts: make(map[string]int),
+	}
+	s.dcs = newDcsServer(s)
+	s.trg = newTrgServer(s)
+	s.odc = newOdcServer(s)
+	s.ddsched = newDdschedServer(s)
+	return s
+}
+
+// SetScenario replaces the current scenario. The failure counters are reset, the DCS
+// detectors are set to their new initial states and the scripted DCS state changes
+// are rescheduled. The state of the ongoing runs and partitions is kept.
+func (s *Simulator) SetScenario(scenario *Scenario) {
+	s.mu.Lock()
+	s.scenario = scenario
+	s.callCounts = make(map[string]int)
+	s.mu.Unlock()
+
+	s.dcs.resetDetectors()
+}
+
+func (s *Simulator) getScenario() *Scenario {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.scenario
+}
+
+// NewServer returns a gRPC server for one of the simulated Services.
+func (s *Simulator) NewServer(service string) (*grpc.Server, error) {
+	gs := grpc.NewServer()
+	switch service {
+	case DCS:
+		dcspb.RegisterConfiguratorServer(gs, s.dcs)
+	case TRG:
+		trgpb.RegisterCTPdServer(gs, s.trg)
+	case ODC:
+		odcpb.RegisterODCServer(gs, s.odc)
+	case DDSCHED:
+		ddpb.RegisterDataDistributionControlServer(gs, s.ddsched)
+	default:
+		return nil, fmt.Errorf("unknown service %s", service)
+	}
+	reflection.Register(gs)
+	return gs, nil
+}
+
+// Stop ends the DCS Subscribe streams and the scripted state changes.
+func (s *Simulator) Stop() {
+	s.dcs.stop()
+}
+
+// call looks up the behaviour scripted for a call and counts it. It returns whether the
+// call should fail.
+func (s *Simulator) call(service string, call string) (b CallBehaviour, fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch service {
+	case DCS:
+		b = s.scenario.Dcs.behaviour(call)
+	case TRG:
+		b = s.scenario.Trg.behaviour(call)
+	case ODC:
+		b = s.scenario.Odc.behaviour(call)
+	case DDSCHED:
+		b = s.scenario.DDsched.behaviour(call)
+	}
+
+	key := service + "/" + call
+	s.callCounts[key]++
+	fail = b.Fail || len(b.FailDetectors) > 0
+	if fail && b.FailTimes > 0 && s.callCounts[key] > b.FailTimes {
+		fail = false
+	}
+
+	log.WithField("service", service).
+		WithField("call", call).
+		WithField("count", s.callCounts[key]).
+		WithField("fail", fail).
+		Debug("simulated call")
+	return
+}
+
+func (b CallBehaviour) errorMessage(service string, call string) string {
+	if len(b.Message) > 0 {
+		return b.Message
+	}
+	return fmt.Sprintf("simulated %s %s failure", service, call)
+}
+
+func (b CallBehaviour) grpcErr(service string, call string) error {
+	return status.Error(codes.Unavailable, b.errorMessage(service, call))
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,307 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package simulator
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	dcspb "github.com/AliceO2Group/Control/core/integration/dcs/protos"
+	ddpb "github.com/AliceO2Group/Control/core/integration/ddsched/protos"
+	odcpb "github.com/AliceO2Group/Control/core/integration/odc/protos"
+	trgpb "github.com/AliceO2Group/Control/core/integration/trg/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const testScenario = `
+dcs:
+  heartbeatInterval: 1h
+  detectors:
+    TOF:
+      sorAvailability: SOR_UNAVAILABLE
+  stateChanges:
+    - after: 50ms
+      detector: ZDC
+      state: PFR_UNAVAILABLE
+  calls:
+    StartOfRun:
+      delay: 10ms
+      failDetectors: [TPC]
+      message: TPC HV trip
+trg:
+  calls:
+    RunLoad:
+      fail: true
+      failTimes: 1
+odc:
+  devices: 2
+  calls:
+    Start:
+      fail: true
+ddsched:
+  calls:
+    PartitionInitialize:
+      delay: 50ms
+`
+
+// serve starts a gRPC server for service and returns a client connection to it.
+func serve(sim *Simulator, service string) *grpc.ClientConn {
+	server, err := sim.NewServer(service)
+	Expect(err).NotTo(HaveOccurred())
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	go func() { _ = server.Serve(lis) }()
+	DeferCleanup(server.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+	return conn
+}
+
+func recvAll[T any](stream grpc.ServerStreamingClient[T]) (events []*T) {
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		events = append(events, ev)
+	}
+}
+
+var _ = Describe("integration service simulator", func() {
+	var (
+		sim *Simulator
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		path := filepath.Join(GinkgoT().TempDir(), "scenario.yaml")
+		Expect(os.WriteFile(path, []byte(testScenario), 0644)).To(Succeed())
+		scenario, err := LoadScenario(path)
+		Expect(err).NotTo(HaveOccurred())
+		sim = New(scenario)
+		DeferCleanup(sim.Stop)
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+		DeferCleanup(cancel)
+	})
+
+	When("loading a scenario", func() {
+		It("should parse delays and behaviours", func() {
+			scenario := sim.getScenario()
+			Expect(scenario.Dcs.behaviour("StartOfRun").Delay).To(Equal(10 * time.Millisecond))
+			Expect(scenario.Dcs.behaviour("EndOfRun")).To(Equal(CallBehaviour{}))
+			Expect(scenario.odcDevices()).To(Equal(2))
+			Expect(scenario.dcsHeartbeatInterval()).To(Equal(time.Hour))
+		})
+		It("should reject unknown detectors and states", func() {
+			sc := &Scenario{Dcs: DcsScenario{Detectors: map[string]DcsDetectorScenario{"XYZ": {}}}}
+			Expect(sc.validate()).NotTo(Succeed())
+			sc = &Scenario{Dcs: DcsScenario{StateChanges: []DcsStateChange{{Detector: "TPC", State: "SLEEPY"}}}}
+			Expect(sc.validate()).NotTo(Succeed())
+		})
+	})
+
+	When("simulating DCS", func() {
+		var client dcspb.ConfiguratorClient
+
+		BeforeEach(func() {
+			client = dcspb.NewConfiguratorClient(serve(sim, DCS))
+		})
+
+		It("should stream SOR progress and fail only the scripted detectors", func() {
+			stream, err := client.StartOfRun(ctx, &dcspb.SorRequest{
+				RunNumber: 1,
+				Detectors: []*dcspb.DetectorOperationRequest{
+					{Detector: dcspb.Detector_ITS},
+					{Detector: dcspb.Detector_TPC},
+					{Detector: dcspb.Detector_TOF},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			events := recvAll(stream)
+
+			final := make(map[dcspb.Detector]dcspb.DetectorState)
+			for _, ev := range events {
+				final[ev.GetDetector()] = ev.GetState()
+			}
+			Expect(events[0].GetState()).To(Equal(dcspb.DetectorState_SOR_PROGRESSING))
+			Expect(final).To(Equal(map[dcspb.Detector]dcspb.DetectorState{
+				dcspb.Detector_ITS: dcspb.DetectorState_RUN_OK,
+				dcspb.Detector_TPC: dcspb.DetectorState_SOR_FAILURE,
+				dcspb.Detector_TOF: dcspb.DetectorState_SOR_UNAVAILABLE,
+			}))
+
+			status, err := client.GetStatus(ctx, &dcspb.StatusRequest{Detector: []dcspb.Detector{dcspb.Detector_ITS}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.GetDetectorMatrix()).To(HaveLen(1))
+			Expect(status.GetDetectorMatrix()[0].GetState()).To(Equal(dcspb.DetectorState_RUN_OK))
+		})
+
+		It("should publish scripted state changes to subscribers", func() {
+			stream, err := client.Subscribe(ctx, &dcspb.SubscriptionRequest{InstanceId: "test"})
+			Expect(err).NotTo(HaveOccurred())
+
+			ev, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ev.GetEventtype()).To(Equal(dcspb.EventType_HEARTBEAT))
+			Expect(ev.GetDetectorMatrix()).NotTo(BeEmpty())
+
+			ev, err = stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ev.GetEventtype()).To(Equal(dcspb.EventType_STATE_CHANGE_EVENT))
+			Expect(ev.GetDetectorMatrix()[0].GetDetector()).To(Equal(dcspb.Detector_ZDC))
+			Expect(ev.GetDetectorMatrix()[0].GetState()).To(Equal(dcspb.DetectorState_PFR_UNAVAILABLE))
+		})
+	})
+
+	When("simulating TRG", func() {
+		var client trgpb.CTPdClient
+
+		BeforeEach(func() {
+			client = trgpb.NewCTPdClient(serve(sim, TRG))
+		})
+
+		It("should fail only the first RunLoad and then track the run", func() {
+			reply, err := client.RunLoad(ctx, &trgpb.RunLoadRequest{Runn: 100, Detectors: "tpc its"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).NotTo(BeZero())
+
+			reply, err = client.RunLoad(ctx, &trgpb.RunLoadRequest{Runn: 100, Detectors: "tpc its"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).To(BeZero())
+
+			reply, err = client.RunStart(ctx, &trgpb.RunStartRequest{Runn: 100})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).To(BeZero())
+
+			reply, err = client.RunStart(ctx, &trgpb.RunStartRequest{Runn: 7, Detector: "hmp"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).To(BeZero())
+
+			reply, err = client.RunList(ctx, &trgpb.Empty{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).To(BeEquivalentTo(2))
+			lines := strings.Split(reply.GetMsg(), "\n")
+			Expect(strings.Fields(lines[0])).To(Equal([]string{"S", "7", "R", "hmp"}))
+			Expect(strings.Fields(lines[1])).To(Equal([]string{"G", "100", "R", "its,tpc", "run100"}))
+
+			reply, err = client.RunStop(ctx, &trgpb.RunStopRequest{Runn: 100})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).To(BeZero())
+			reply, err = client.RunStatus(ctx, &trgpb.RunStatusRequest{Runn: 100})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).To(BeEquivalentTo(TRG_RUN_LOADED))
+
+			reply, err = client.RunUnload(ctx, &trgpb.RunStopRequest{Runn: 100})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).To(BeZero())
+			reply, err = client.RunStatus(ctx, &trgpb.RunStatusRequest{Runn: 100})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.GetRc()).To(BeEquivalentTo(TRG_RUN_DOES_NOT_EXIST))
+		})
+	})
+
+	When("simulating ODC", func() {
+		var client odcpb.ODCClient
+
+		BeforeEach(func() {
+			client = odcpb.NewODCClient(serve(sim, ODC))
+		})
+
+		It("should follow the FairMQ state machine and fail scripted transitions", func() {
+			rep, err := client.Run(ctx, &odcpb.RunRequest{Partitionid: "env1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rep.GetStatus()).To(Equal(odcpb.ReplyStatus_SUCCESS))
+			Expect(rep.GetState()).To(Equal("IDLE"))
+
+			stateRep, err := client.Stop(ctx, &odcpb.StopRequest{Request: &odcpb.StateRequest{Partitionid: "env1"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stateRep.GetReply().GetStatus()).To(Equal(odcpb.ReplyStatus_ERROR))
+			Expect(stateRep.GetReply().GetState()).To(Equal("IDLE"))
+
+			stateRep, err = client.Configure(ctx, &odcpb.ConfigureRequest{Request: &odcpb.StateRequest{Partitionid: "env1", Detailed: true}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stateRep.GetReply().GetStatus()).To(Equal(odcpb.ReplyStatus_SUCCESS))
+			Expect(stateRep.GetReply().GetState()).To(Equal("READY"))
+			Expect(stateRep.GetDevices()).To(HaveLen(2))
+
+			stateRep, err = client.Start(ctx, &odcpb.StartRequest{Request: &odcpb.StateRequest{Partitionid: "env1", Runnr: 5}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stateRep.GetReply().GetStatus()).To(Equal(odcpb.ReplyStatus_ERROR))
+			Expect(stateRep.GetReply().GetError().GetMsg()).NotTo(BeEmpty())
+			Expect(stateRep.GetReply().GetState()).To(Equal("ERROR"))
+
+			status, err := client.Status(ctx, &odcpb.StatusRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.GetPartitions()).To(HaveLen(1))
+			Expect(status.GetPartitions()[0].GetState()).To(Equal("ERROR"))
+		})
+	})
+
+	When("simulating the DD scheduler", func() {
+		var client ddpb.DataDistributionControlClient
+
+		BeforeEach(func() {
+			client = ddpb.NewDataDistributionControlClient(serve(sim, DDSCHED))
+		})
+
+		It("should configure and terminate partitions asynchronously", func() {
+			info := &ddpb.PartitionInfo{EnvironmentId: "env1", PartitionId: "env1"}
+			rep, err := client.PartitionInitialize(ctx, &ddpb.PartitionInitRequest{PartitionInfo: info})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rep.GetPartitionState()).To(Equal(ddpb.PartitionState_PARTITION_CONFIGURING))
+
+			Eventually(func() ddpb.PartitionState {
+				rep, _ := client.PartitionStatus(ctx, info)
+				return rep.GetPartitionState()
+			}).Should(Equal(ddpb.PartitionState_PARTITION_CONFIGURED))
+
+			rep, err = client.PartitionTerminate(ctx, &ddpb.PartitionTermRequest{PartitionInfo: info})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rep.GetPartitionState()).To(Equal(ddpb.PartitionState_PARTITION_TERMINATING))
+			Eventually(func() ddpb.PartitionState {
+				rep, _ := client.PartitionStatus(ctx, info)
+				return rep.GetPartitionState()
+			}).Should(Equal(ddpb.PartitionState_PARTITION_TERMINATED))
+		})
+	})
+})
+
+func TestSimulator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Integration service simulator Test Suite")
+}
@@ -0,0 +1,233 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	trgpb "github.com/AliceO2Group/Control/core/integration/trg/protos"
+)
+
+// RunStatus response codes, as returned by the CTP
+const (
+	TRG_RUN_ACTIVE         = 0
+	TRG_RUN_PAUSED         = 1
+	TRG_RUN_LOADED         = 2
+	TRG_RUN_DOES_NOT_EXIST = 3
+)
+
+type trgRun struct {
+	global    bool
+	running   bool
+	detectors []string
+}
+
+type trgServer struct {
+	trgpb.UnimplementedCTPdServer
+	sim *Simulator
+
+	mu   sync.Mutex
+	runs map[uint32]*trgRun
+}
+
+func newTrgServer(sim *Simulator) *trgServer {
+	return &trgServer{
+		sim:  sim,
+		runs: make(map[uint32]*trgRun),
+	}
+}
+
+// trgDetectors normalizes a space- or comma-separated detector list.
+func trgDetectors(detectors string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(detectors), func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	sort.Strings(fields)
+	return fields
+}
+
+// do applies the scripted behaviour of call, and if it succeeds runs fn with the lock held.
+func (s *trgServer) do(ctx context.Context, call string, fn func() *trgpb.RunReply) (*trgpb.RunReply, error) {
+	b, fail := s.sim.call(TRG, call)
+	if err := sleep(ctx, b.Delay); err != nil {
+		return nil, err
+	}
+	if fail {
+		if b.GrpcError {
+			return nil, b.grpcErr(TRG, call)
+		}
+		return &trgpb.RunReply{Rc: 1, Msg: b.errorMessage(TRG, call)}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(), nil
+}
+
+func trgOk() *trgpb.RunReply {
+	return &trgpb.RunReply{Rc: 0}
+}
+
+func trgError(format string, a ...interface{}) *trgpb.RunReply {
+	return &trgpb.RunReply{Rc: 1, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (s *trgServer) PrepareForRun(ctx context.Context, _ *trgpb.RunPrepareRequest) (*trgpb.RunReply, error) {
+	return s.do(ctx, "PrepareForRun", trgOk)
+}
+
+func (s *trgServer) RunLoad(ctx context.Context, req *trgpb.RunLoadRequest) (*trgpb.RunReply, error) {
+	return s.do(ctx, "RunLoad", func() *trgpb.RunReply {
+		if _, exists := s.runs[req.GetRunn()]; exists {
+			return trgError("run %d already exists", req.GetRunn())
+		}
+		s.runs[req.GetRunn()] = &trgRun{
+			global:    true,
+			detectors: trgDetectors(req.GetDetectors()),
+		}
+		log.WithField("run", req.GetRunn()).
+			WithField("detectors", req.GetDetectors()).
+			Info("TRG global run loaded")
+		return trgOk()
+	})
+}
+
+func (s *trgServer) RunStart(ctx context.Context, req *trgpb.RunStartRequest) (*trgpb.RunReply, error) {
+	return s.do(ctx, "RunStart", func() *trgpb.RunReply {
+		run, exists := s.runs[req.GetRunn()]
+		if len(req.GetDetector()) > 0 { // standalone run
+			if exists {
+				return trgError("run %d already exists", req.GetRunn())
+			}
+			s.runs[req.GetRunn()] = &trgRun{
+				running:   true,
+				detectors: trgDetectors(req.GetDetector()),
+			}
+		} else {
+			if !exists {
+				return trgError("global run %d not loaded", req.GetRunn())
+			}
+			if run.running {
+				return trgError("run %d already running", req.GetRunn())
+			}
+			run.running = true
+		}
+		log.WithField("run", req.GetRunn()).
+			Info("TRG run started")
+		return trgOk()
+	})
+}
+
+func (s *trgServer) RunStop(ctx context.Context, req *trgpb.RunStopRequest) (*trgpb.RunReply, error) {
+	return s.do(ctx, "RunStop", func() *trgpb.RunReply {
+		run, exists := s.runs[req.GetRunn()]
+		if !exists || !run.running {
+			return trgError("run %d not running", req.GetRunn())
+		}
+		if run.global {
+			run.running = false
+		} else {
+			delete(s.runs, req.GetRunn())
+		}
+		log.WithField("run", req.GetRunn()).
+			Info("TRG run stopped")
+		return trgOk()
+	})
+}
+
+func (s *trgServer) RunUnload(ctx context.Context, req *trgpb.RunStopRequest) (*trgpb.RunReply, error) {
+	return s.do(ctx, "RunUnload", func() *trgpb.RunReply {
+		run, exists := s.runs[req.GetRunn()]
+		if !exists || !run.global {
+			return trgError("global run %d not loaded", req.GetRunn())
+		}
+		delete(s.runs, req.GetRunn())
+		log.WithField("run", req.GetRunn()).
+			Info("TRG global run unloaded")
+		return trgOk()
+	})
+}
+
+func (s *trgServer) RunStatus(ctx context.Context, req *trgpb.RunStatusRequest) (*trgpb.RunReply, error) {
+	return s.do(ctx, "RunStatus", func() *trgpb.RunReply {
+		run, exists := s.runs[req.GetRunn()]
+		switch {
+		case !exists:
+			return &trgpb.RunReply{Rc: TRG_RUN_DOES_NOT_EXIST}
+		case run.running:
+			return &trgpb.RunReply{Rc: TRG_RUN_ACTIVE}
+		default:
+			return &trgpb.RunReply{Rc: TRG_RUN_LOADED}
+		}
+	})
+}
+
+// RunList replies with the number of runs as rc, and one line per run in the CTP format, e.g.
+//
+//	G 511707 R     tpc,its     run511707
+//	S   2222 R     hmp
+func (s *trgServer) RunList(ctx context.Context, _ *trgpb.Empty) (*trgpb.RunReply, error) {
+	return s.do(ctx, "RunList", func() *trgpb.RunReply {
+		runNumbers := make([]uint32, 0, len(s.runs))
+		for runNumber := range s.runs {
+			runNumbers = append(runNumbers, runNumber)
+		}
+		sort.Slice(runNumbers, func(i, j int) bool { return runNumbers[i] < runNumbers[j] })
+
+		lines := make([]string, 0, len(runNumbers))
+		for _, runNumber := range runNumbers {
+			run := s.runs[runNumber]
+			detectors := strings.Join(run.detectors, ",")
+			if run.global {
+				state := "L"
+				if run.running {
+					state = "R"
+				}
+				lines = append(lines, fmt.Sprintf("G %6d %s     %s     run%d", runNumber, state, detectors, runNumber))
+			} else {
+				lines = append(lines, fmt.Sprintf("S %6d R     %s", runNumber, detectors))
+			}
+		}
+		return &trgpb.RunReply{Rc: int32(len(lines)), Msg: strings.Join(lines, "\n")}
+	})
+}
+
+func (s *trgServer) RunConfig(ctx context.Context, _ *trgpb.RunStopRequest) (*trgpb.RunReply, error) {
+	return s.do(ctx, "RunConfig", trgOk)
+}
+
+func (s *trgServer) RunCleanup(ctx context.Context, _ *trgpb.Empty) (*trgpb.RunReply, error) {
+	return s.do(ctx, "RunCleanup", func() *trgpb.RunReply {
+		s.runs = make(map[uint32]*trgRun)
+		return trgOk()
+	})
+}
+
+func (s *trgServer) TPCReset(ctx context.Context, _ *trgpb.Empty) (*trgpb.RunReply, error) {
+	return s.do(ctx, "TPCReset", trgOk)
+}
@@ -1574,6 +1574,10 @@ func (p *Plugin) reportCtpStatus(clockTransitionExpected uint32, previousExpecte
 	}
 }
 
+func (p *Plugin) CallStackFunctions() []string {
+	return callable.CallStackFunctions(p)
+}
+
 func (p *Plugin) Destroy() error {
 	p.trgClientCancel()
 
@@ -175,3 +175,24 @@ func TestGetIdentifierHttpsDotGit(t *testing.T) {
 		t.Errorf("Got identifier %s instead of %s", repo.GetIdentifier(), expectedIdentifier)
 	}
 }
+
+func TestNormalizeRepoUrlHttps(t *testing.T) {
+	_, repo, _ := NewRepo(httpTs.RepoPath, "", httpTs.ReposPath)
+	expected := normalizeRepoUrl(repo.GetIdentifier())
+
+	for _, webhookUrl := range []string{
+		"https://github.com/AliceO2Group/ControlWorkflows.git",
+		"https://github.com/AliceO2Group/ControlWorkflows",
+		"git@github.com:AliceO2Group/ControlWorkflows.git",
+		"ssh://git@github.com/AliceO2Group/ControlWorkflows.git",
+		"git://github.com/aliceo2group/controlworkflows.git",
+	} {
+		if normalizeRepoUrl(webhookUrl) != expected {
+			t.Errorf("Got %s for %s instead of %s", normalizeRepoUrl(webhookUrl), webhookUrl, expected)
+		}
+	}
+
+	if normalizeRepoUrl("https://github.com/AliceO2Group/Control.git") == expected {
+		t.Errorf("Unexpected match of a different repo with %s", expected)
+	}
+}
@@ -86,6 +86,11 @@ func (r *localRepo) refresh() error {
 	return nil
 }
 
+func (r *localRepo) refreshRef(string) error {
+	// noop for local repo
+	return nil
+}
+
 func (r *localRepo) GetProtocol() string {
 	return "local"
 }
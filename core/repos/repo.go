@@ -36,7 +36,9 @@ import (
 	"strings"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/gobwas/glob"
 )
 
@@ -47,6 +49,7 @@ type iRepo interface {
 	getWorkflowDir() string
 	checkoutRevision(string) error
 	refresh() error
+	refreshRef(string) error
 	gatherRevisions(*git.Repository) error
 	populateWorkflows(string, bool) error
 	getWorkflows(string, []string, bool) (TemplatesByRevision, error)
@@ -354,6 +357,68 @@ func (r *Repo) refresh() error {
 	return nil
 }
 
+func (r *Repo) refreshRef(gitRef string) error {
+	return r.fetchRef(gitRef, nil)
+}
+
+// fetchRef fetches a single branch (refs/heads/...) or tag (refs/tags/...) from origin, and
+// then updates the revisions and workflow templates like refresh does.
+func (r *Repo) fetchRef(gitRef string, auth transport.AuthMethod) error {
+	var refSpec config.RefSpec
+	if strings.HasPrefix(gitRef, refHeadPrefix) {
+		refSpec = config.RefSpec("+" + gitRef + ":" + refRemotePrefix + strings.TrimPrefix(gitRef, refHeadPrefix))
+	} else if strings.HasPrefix(gitRef, refTagPrefix) {
+		refSpec = config.RefSpec("+" + gitRef + ":" + gitRef)
+	} else {
+		return errors.New("unsupported git reference " + gitRef + ": " + r.GetIdentifier())
+	}
+	if err := refSpec.Validate(); err != nil {
+		return errors.New(err.Error() + ": " + r.GetIdentifier())
+	}
+
+	ref, err := git.PlainOpen(r.GetCloneDir())
+	if err != nil {
+		return errors.New(err.Error() + ": " + r.GetIdentifier())
+	}
+
+	// clean the repo before doing anything
+	// this removes the untracked JIT-produced tasks and workflows
+	clnCmd := exec.Command("git", "-C", r.GetCloneDir(), "clean", "-f")
+	err = clnCmd.Run()
+	if err != nil {
+		return errors.New(err.Error() + ": " + r.GetIdentifier())
+	}
+
+	err = ref.Fetch(&git.FetchOptions{
+		RemoteName: "origin",
+		RefSpecs:   []config.RefSpec{refSpec},
+		Force:      true,
+		Auth:       auth,
+	})
+
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return errors.New(err.Error() + ": " + r.GetIdentifier() + " | ref: " + gitRef)
+	}
+
+	// gather revisions on update or if empty
+	if err != git.NoErrAlreadyUpToDate || r.Revisions == nil {
+		err = r.gatherRevisions(ref)
+		if err != nil {
+			return err
+		}
+	}
+
+	// populate workflows on update or if empty
+	if err != git.NoErrAlreadyUpToDate || len(templatesCache) == 0 {
+		err = r.populateWorkflows(r.GetDefaultRevision(), true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *Repo) gatherRevisions(ref *git.Repository) error {
 
 	var err error
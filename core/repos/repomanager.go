@@ -49,6 +49,7 @@ import (
 const (
 	refPrefix       = "refs/"
 	refTagPrefix    = refPrefix + "tags/"
+	refHeadPrefix   = refPrefix + "heads/"
 	refRemotePrefix = refPrefix + "remotes/origin/"
 )
 
@@ -461,6 +462,41 @@ func (manager *RepoManager) RefreshRepo(repoPath string) error {
 	return repo.refresh()
 }
 
+// RefreshRepoRef fetches a single branch (refs/heads/...) or tag (refs/tags/...) of the repository
+// which was cloned from any of repoUrls, e.g. the clone URLs found in a git push webhook.
+// It returns the identifier of the refreshed repository.
+func (manager *RepoManager) RefreshRepoRef(repoUrls []string, gitRef string) (repoIdentifier string, err error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	var repo iRepo
+	for _, repoUrl := range repoUrls {
+		if repo = manager.getRepoByUrl(repoUrl); repo != nil {
+			break
+		}
+	}
+	if repo == nil {
+		return "", fmt.Errorf("no repository matches %s", strings.Join(repoUrls, ", "))
+	}
+
+	return repo.GetIdentifier(), repo.refreshRef(gitRef)
+}
+
+// getRepoByUrl returns the repository whose identifier matches repoUrl, ignoring the protocol,
+// the user, the port, the .git suffix and the case, or nil if there is none.
+func (manager *RepoManager) getRepoByUrl(repoUrl string) iRepo {
+	normalizedUrl := normalizeRepoUrl(repoUrl)
+	if normalizedUrl == "" {
+		return nil
+	}
+	for _, repo := range manager.repoList {
+		if repo.GetProtocol() != "local" && normalizeRepoUrl(repo.GetIdentifier()) == normalizedUrl {
+			return repo
+		}
+	}
+	return nil
+}
+
 func (manager *RepoManager) RefreshRepoByIndex(index int) error {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -527,6 +563,58 @@ func (manager *RepoManager) GetWorkflow(workflowPath string) (resolvedWorkflowPa
 	return
 }
 
+// TemplateFiles holds the raw contents of all the workflow and task templates
+// of a repository at a given revision, keyed by template name.
+type TemplateFiles struct {
+	RepoIdentifier string
+	Revision       string
+	Hash           string
+	Workflows      map[string][]byte
+	Tasks          map[string][]byte
+}
+
+// GetTemplateFiles checks out the given revision of a repository and reads all
+// its workflow and task templates. An empty repoPath selects the default
+// repository and an empty revision its default revision.
+func (manager *RepoManager) GetTemplateFiles(repoPath string, revision string) (files *TemplateFiles, err error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	var repo iRepo
+	if repoPath == "" {
+		repo = manager.defaultRepo
+	} else {
+		repo = manager.repoList[strings.TrimSuffix(repoPath, "/")]
+	}
+	if repo == nil {
+		err = fmt.Errorf("unknown repo %s", repoPath)
+		return
+	}
+
+	if revision == "" {
+		revision = repo.GetDefaultRevision()
+	}
+	err = repo.checkoutRevision(revision)
+	if err != nil {
+		return
+	}
+
+	files = &TemplateFiles{
+		RepoIdentifier: repo.GetIdentifier(),
+		Revision:       revision,
+		Hash:           repo.GetHash(),
+	}
+	files.Workflows, err = readTemplateDir(repo.getWorkflowDir())
+	if err != nil {
+		return nil, err
+	}
+	files.Tasks, err = readTemplateDir(filepath.Join(repo.GetCloneDir(), "tasks"))
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 func (manager *RepoManager) setDefaultRepo(repo iRepo) {
 	if manager.defaultRepo != nil {
 		manager.defaultRepo.setDefault(false) // Update old default repo
@@ -28,6 +28,11 @@ package repos
 import (
 	"fmt"
 	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/AliceO2Group/Control/core/repos/varsource"
 	"gopkg.in/yaml.v3"
@@ -62,6 +67,32 @@ func ParseWorkflowPublicVariableInfo(fileName string) (bool, string, VarSpecMap,
 	return isPublic, description, workflowVarInfo, nil
 }
 
+// readTemplateDir reads all the YAML templates in a directory, keyed by file
+// name without extension. A missing directory yields an empty map.
+func readTemplateDir(dir string) (templates map[string][]byte, err error) {
+	templates = make(map[string][]byte)
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return templates, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".yaml" {
+			continue
+		}
+		var content []byte
+		content, err = os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		templates[strings.TrimSuffix(entry.Name(), ".yaml")] = content
+	}
+	return
+}
+
 // VarSpecMap holds a map of variable names to their variable information struct
 type VarSpecMap map[string]VarSpec
 
@@ -79,6 +110,7 @@ type VarSpec struct {
 	Rows          uint32           `yaml:"rows"`
 	VisibleIf     string           `yaml:"visibleif"`
 	EnabledIf     string           `yaml:"enabledif"`
+	Required      bool             `yaml:"required"`
 }
 
 // AuxNode Use an auxiliary node struct that also carries its parent Name
@@ -149,3 +181,21 @@ func parseYamlPublicVars(auxNode *AuxNode, workflowVarInfo *VarSpecMap) error {
 	}
 	return nil
 }
+
+// normalizeRepoUrl reduces a clone URL (https://host/path/repo.git, ssh://user@host:port/path/repo.git
+// or user@host:path/repo.git) or a repository identifier (host/path/repo or host:/path/repo) to
+// host/path/repo in lower case, so that they can be compared.
+func normalizeRepoUrl(repoUrl string) string {
+	repoUrl = strings.TrimSpace(repoUrl)
+	if u, err := url.Parse(repoUrl); err == nil && u.Scheme != "" && u.Host != "" {
+		repoUrl = path.Join(u.Hostname(), u.Path)
+	} else if i := strings.Index(repoUrl, ":"); i >= 0 && !strings.Contains(repoUrl[:i], "/") {
+		host := repoUrl[:i]
+		if j := strings.LastIndex(host, "@"); j >= 0 {
+			host = host[j+1:]
+		}
+		repoUrl = path.Join(host, repoUrl[i+1:])
+	}
+	repoUrl = strings.TrimSuffix(strings.TrimSuffix(repoUrl, "/"), ".git")
+	return strings.ToLower(repoUrl)
+}
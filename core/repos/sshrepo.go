@@ -109,6 +109,18 @@ func (r *sshRepo) refresh() error {
 	return nil
 }
 
+func (r *sshRepo) refreshRef(gitRef string) error {
+	auth, err := ssh.NewPublicKeysFromFile("git", viper.GetString("reposSshKey"), "")
+	if err != nil {
+		return errors.New(err.Error() + ": " + r.GetIdentifier())
+	}
+
+	// Disable strict host checking without which may block the fetch op without manual intervention
+	auth.HostKeyCallback = ssh2.InsecureIgnoreHostKey()
+
+	return r.fetchRef(gitRef, auth)
+}
+
 /*func (r *sshRepo) GetDplCommand(dplCommandUri string) (string, error) {
 	dplCommandPath := filepath.Join(r.GetCloneDir(), jitScriptsDir, dplCommandUri)
 	dplCommandPayload, err := os.ReadFile(dplCommandPath)
@@ -99,3 +99,18 @@ func TestNewRepoSsh(t *testing.T) {
 		t.Errorf("Repo's revision should fall back to default revision, when latter specified. Got %s instead of %s", repo.getRevision(), repo.GetDefaultRevision())
 	}
 }
+
+func TestNormalizeRepoUrlSsh(t *testing.T) {
+	_, repo, _ := NewRepo(sshTs.RepoPath, "", sshTs.ReposPath)
+	expected := normalizeRepoUrl(repo.GetIdentifier())
+
+	for _, webhookUrl := range []string{
+		"git@git-server:/opt/git/ControlWorkflows.git",
+		"ssh://git@git-server:2222/opt/git/ControlWorkflows.git",
+		"https://git-server/opt/git/ControlWorkflows",
+	} {
+		if normalizeRepoUrl(webhookUrl) != expected {
+			t.Errorf("Got %s for %s instead of %s", normalizeRepoUrl(webhookUrl), webhookUrl, expected)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package repos
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/expr-lang/expr"
+)
+
+// varRefPattern matches the $$name references to other variables in the
+// visibleif and enabledif expressions of a VarSpec
+var varRefPattern = regexp.MustCompile(`\$\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// VarViolation describes why the value supplied for a public variable does not
+// satisfy its VarSpec
+type VarViolation struct {
+	Name   string
+	Reason string
+}
+
+// VarSpecValidationError lists all the user variables which do not satisfy the
+// VarSpecs of a workflow template
+type VarSpecValidationError struct {
+	Violations []VarViolation
+}
+
+func (e *VarSpecValidationError) Error() string {
+	reasons := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		reasons[i] = fmt.Sprintf("%s: %s", v.Name, v.Reason)
+	}
+	return "invalid workflow variables: " + strings.Join(reasons, "; ")
+}
+
+// Validate checks userVars against the VarSpecs of the map, and returns a
+// *VarSpecValidationError listing every required variable which has neither a
+// value nor a default, and every value which does not match the type or the
+// allowed values of its VarSpec. Variables targeted at a specific role, i.e.
+// "path.to.role:name", are checked against the VarSpec of name. Variables
+// without a VarSpec are not checked. A required variable which is hidden or
+// disabled by its visibleif or enabledif expression, as evaluated against
+// userVars and the default values, does not need to be set.
+func (m VarSpecMap) Validate(userVars map[string]string) error {
+	keys := make([]string, 0, len(userVars))
+	for k := range userVars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	valuesByName := make(map[string][]string)
+	conditionVars := make(map[string]interface{})
+	for name, spec := range m {
+		conditionVars[name] = spec.DefaultValue
+	}
+	for _, k := range keys {
+		name := k
+		if i := strings.LastIndexByte(k, ':'); i >= 0 {
+			name = k[i+1:]
+			// a role-targeted value does not override a global one
+			if _, isGlobal := userVars[name]; !isGlobal {
+				conditionVars[name] = userVars[k]
+			}
+		} else {
+			conditionVars[name] = userVars[k]
+		}
+		valuesByName[name] = append(valuesByName[name], userVars[k])
+	}
+
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	violations := make([]VarViolation, 0)
+	for _, name := range names {
+		spec := m[name]
+		applicable := spec.isApplicable(conditionVars)
+		values, ok := valuesByName[name]
+		if !ok {
+			if spec.Required && spec.DefaultValue == "" && applicable {
+				violations = append(violations, VarViolation{Name: name, Reason: "required variable not set"})
+			}
+			continue
+		}
+		for _, value := range values {
+			if value == "" && !applicable {
+				continue
+			}
+			if reason := spec.check(value); reason != "" {
+				violations = append(violations, VarViolation{Name: name, Reason: reason})
+				break
+			}
+		}
+	}
+
+	if len(violations) != 0 {
+		return &VarSpecValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// isApplicable reports whether the variable is visible and enabled given the
+// values of the other variables. visibleif and enabledif are JS expressions
+// meant for the GUI, only the comparisons and boolean operators which they
+// share with expr are understood here. An expression which cannot be
+// evaluated leaves the variable applicable.
+func (spec VarSpec) isApplicable(vars map[string]interface{}) bool {
+	return evaluateVarCondition(spec.VisibleIf, vars) && evaluateVarCondition(spec.EnabledIf, vars)
+}
+
+func evaluateVarCondition(condition string, vars map[string]interface{}) bool {
+	condition = strings.TrimSpace(condition)
+	if condition == "" {
+		return true
+	}
+	condition = strings.NewReplacer("!==", "!=", "===", "==").Replace(condition)
+	condition = varRefPattern.ReplaceAllString(condition, `vars["$1"]`)
+
+	output, err := expr.Eval(condition, map[string]interface{}{"vars": vars})
+	if err != nil {
+		return true
+	}
+	switch typed := output.(type) {
+	case bool:
+		return typed
+	case string:
+		return typed != ""
+	case nil:
+		return false
+	}
+	return true
+}
+
+// check returns why value does not satisfy the VarSpec, or an empty string if
+// it does
+func (spec VarSpec) check(value string) string {
+	if value == "" {
+		if spec.Required {
+			return "required variable is empty"
+		}
+		return ""
+	}
+
+	var items []string
+	switch spec.VarType {
+	case "number":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Sprintf("value %q is not a number", value)
+		}
+		if spec.Widget == "slider" && len(spec.AllowedValues) == 2 {
+			low, errLow := strconv.ParseFloat(spec.AllowedValues[0], 64)
+			high, errHigh := strconv.ParseFloat(spec.AllowedValues[1], 64)
+			if errLow == nil && errHigh == nil && (number < low || number > high) {
+				return fmt.Sprintf("value %s is out of range [%s, %s]", value, spec.AllowedValues[0], spec.AllowedValues[1])
+			}
+			return ""
+		}
+	case "bool":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Sprintf("value %q is not a bool", value)
+		}
+		return ""
+	case "list":
+		if err := json.Unmarshal([]byte(value), &items); err != nil {
+			return fmt.Sprintf("value %q is not a JSON list of strings", value)
+		}
+	case "map":
+		dict := make(map[string]string)
+		if err := json.Unmarshal([]byte(value), &dict); err != nil {
+			return fmt.Sprintf("value %q is not a JSON map of strings", value)
+		}
+		return ""
+	}
+
+	// Only the widgets which offer a closed choice restrict the values, an
+	// editBox or comboBox also accepts values which are not listed
+	switch spec.Widget {
+	case "listBox", "dropDownBox", "radioButtonBox":
+	default:
+		return ""
+	}
+	if len(spec.AllowedValues) == 0 {
+		return ""
+	}
+	if items == nil {
+		items = []string{value}
+	}
+	for _, item := range items {
+		if !slices.Contains(spec.AllowedValues, item) {
+			return fmt.Sprintf("value %q is not one of %s", item, strings.Join(spec.AllowedValues, ", "))
+		}
+	}
+	return ""
+}
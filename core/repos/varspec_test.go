@@ -0,0 +1,135 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package repos
+
+import (
+	"errors"
+	"testing"
+)
+
+var testVarSpecs = VarSpecMap{
+	"detector_name": {VarType: "string", Required: true},
+	"run_type":      {VarType: "string", Widget: "dropDownBox", AllowedValues: []string{"PHYSICS", "TECHNICAL"}, DefaultValue: "PHYSICS"},
+	"n_flps":        {VarType: "number", Widget: "slider", AllowedValues: []string{"1", "10"}},
+	"dcs_enabled":   {VarType: "bool", Widget: "checkBox"},
+	"hosts":         {VarType: "list", Widget: "listBox", AllowedValues: []string{"flp001", "flp002"}},
+	"extra_env":     {VarType: "map"},
+	"comment":       {VarType: "string", Widget: "comboBox", AllowedValues: []string{"test"}},
+}
+
+func TestVarSpecValidateAcceptsValidVars(t *testing.T) {
+	err := testVarSpecs.Validate(map[string]string{
+		"detector_name":        "TPC",
+		"n_flps":               "4",
+		"dcs_enabled":          "true",
+		"hosts":                `["flp001","flp002"]`,
+		"extra_env":            `{"FOO":"bar"}`,
+		"comment":              "anything goes",
+		"readout:run_type":     "TECHNICAL",
+		"some_unspecified_var": "whatever",
+	})
+	if err != nil {
+		t.Errorf("valid vars shouldn't error out, got: %s", err)
+	}
+}
+
+func TestVarSpecValidateReportsEveryViolation(t *testing.T) {
+	err := testVarSpecs.Validate(map[string]string{
+		"n_flps":               "20",
+		"dcs_enabled":          "ture",
+		"hosts":                `["flp001","flp003"]`,
+		"extra_env":            "FOO=bar",
+		"ctp.readout:run_type": "PHYSIC",
+	})
+
+	var vErr *VarSpecValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("expected a *VarSpecValidationError, got: %v", err)
+	}
+
+	expected := []string{"dcs_enabled", "detector_name", "extra_env", "hosts", "n_flps", "run_type"}
+	if len(vErr.Violations) != len(expected) {
+		t.Fatalf("expected %d violations, got %d: %s", len(expected), len(vErr.Violations), err)
+	}
+	for i, name := range expected {
+		if vErr.Violations[i].Name != name {
+			t.Errorf("violation %d concerns %s instead of %s", i, vErr.Violations[i].Name, name)
+		}
+		if vErr.Violations[i].Reason == "" {
+			t.Errorf("violation of %s has no reason", name)
+		}
+	}
+}
+
+func TestVarSpecValidateRequiredWithDefault(t *testing.T) {
+	specs := VarSpecMap{"odc_topology": {Required: true, DefaultValue: "default.xml"}}
+	if err := specs.Validate(map[string]string{}); err != nil {
+		t.Errorf("a required var with a default value shouldn't need to be set, got: %s", err)
+	}
+	if err := specs.Validate(map[string]string{"odc_topology": ""}); err == nil {
+		t.Errorf("a required var shouldn't be accepted empty")
+	}
+}
+
+func TestVarSpecValidateRequiredOnlyWhenApplicable(t *testing.T) {
+	specs := VarSpecMap{
+		"dcs_enabled":   {VarType: "bool", Widget: "checkBox", DefaultValue: "false"},
+		"dcs_sor_param": {Required: true, VisibleIf: `$$dcs_enabled === "true"`},
+		"odc_topology":  {Required: true, EnabledIf: `$$odc_enabled !== "false" && $$dcs_enabled === 'true'`},
+		"qc_config_uri": {Required: true, VisibleIf: `$$qc_enabled.some(x => x)`},
+	}
+
+	err := specs.Validate(map[string]string{"odc_topology": ""})
+	var vErr *VarSpecValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("expected a *VarSpecValidationError, got: %v", err)
+	}
+	if len(vErr.Violations) != 1 || vErr.Violations[0].Name != "qc_config_uri" {
+		t.Errorf("only the var with an unparseable condition should be required, got: %s", err)
+	}
+
+	err = specs.Validate(map[string]string{"readout:dcs_enabled": "true", "qc_config_uri": "consul://qc"})
+	if !errors.As(err, &vErr) {
+		t.Fatalf("expected a *VarSpecValidationError, got: %v", err)
+	}
+	expected := []string{"dcs_sor_param", "odc_topology"}
+	if len(vErr.Violations) != len(expected) {
+		t.Fatalf("expected %d violations, got %d: %s", len(expected), len(vErr.Violations), err)
+	}
+	for i, name := range expected {
+		if vErr.Violations[i].Name != name {
+			t.Errorf("violation %d concerns %s instead of %s", i, vErr.Violations[i].Name, name)
+		}
+	}
+
+	err = specs.Validate(map[string]string{
+		"dcs_enabled":   "true",
+		"odc_enabled":   "false",
+		"dcs_sor_param": "x",
+		"qc_config_uri": "consul://qc",
+	})
+	if err != nil {
+		t.Errorf("a disabled required var shouldn't need to be set, got: %s", err)
+	}
+}
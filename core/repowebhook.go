@@ -0,0 +1,295 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event/topic"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	pb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/spf13/viper"
+)
+
+const (
+	maxRepoWebhookPayload = 25 << 20 // GitHub caps webhook payloads at 25 MB
+	zeroCommit            = "0000000000000000000000000000000000000000"
+)
+
+var errRepoWebhookUnauthorized = errors.New("invalid webhook signature or token")
+
+// repoPush is a push to a git repository, as reported by a GitHub or GitLab push webhook.
+type repoPush struct {
+	source   string
+	repoUrls []string // clone and web URLs of the pushed repository
+	ref      string
+	commit   string
+	pusher   string
+}
+
+func (p *repoPush) deleted() bool {
+	return p.commit == zeroCommit
+}
+
+type githubPushPayload struct {
+	Ref        string `json:"ref"`
+	After      string `json:"after"`
+	Repository struct {
+		CloneUrl string `json:"clone_url"`
+		SshUrl   string `json:"ssh_url"`
+		GitUrl   string `json:"git_url"`
+		HtmlUrl  string `json:"html_url"`
+	} `json:"repository"`
+	Pusher struct {
+		Name string `json:"name"`
+	} `json:"pusher"`
+}
+
+type gitlabPushPayload struct {
+	Ref          string `json:"ref"`
+	After        string `json:"after"`
+	UserUsername string `json:"user_username"`
+	Project      struct {
+		GitHttpUrl string `json:"git_http_url"`
+		GitSshUrl  string `json:"git_ssh_url"`
+		WebUrl     string `json:"web_url"`
+	} `json:"project"`
+}
+
+// repoWebhook handles the push webhooks sent by GitHub and GitLab, and refreshes the pushed branch
+// or tag of the matching workflow repository.
+// GitHub requests are authenticated with the HMAC-SHA256 signature of their payload, and GitLab
+// requests with their secret token, both made with the shared secret.
+type repoWebhook struct {
+	secret  []byte
+	refresh func(repoUrls []string, gitRef string) (repoIdentifier string, err error)
+	publish func(ev *pb.Ev_RepositoryRefreshEvent)
+	pushes  sync.WaitGroup // refreshes in progress
+}
+
+func newRepoWebhook(secretFile string) (*repoWebhook, error) {
+	if secretFile == "" {
+		return nil, errors.New("no webhook secret file provided")
+	}
+	secret, err := os.ReadFile(secretFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read webhook secret: %w", err)
+	}
+	secret = []byte(strings.TrimSpace(string(secret)))
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("webhook secret file %s is empty", secretFile)
+	}
+
+	return &repoWebhook{
+		secret:  secret,
+		refresh: the.RepoManager().RefreshRepoRef,
+		publish: func(ev *pb.Ev_RepositoryRefreshEvent) {
+			the.EventWriterWithTopic(topic.Core).WriteEvent(ev)
+		},
+	}, nil
+}
+
+func (h *repoWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRepoWebhookPayload))
+	if err != nil {
+		http.Error(w, "cannot read payload", http.StatusBadRequest)
+		return
+	}
+
+	push, err := h.parse(r.Header, body)
+	if errors.Is(err, errRepoWebhookUnauthorized) {
+		log.WithField("remote", r.RemoteAddr).
+			Warn("rejected repository webhook with invalid signature or token")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Non-push events, e.g. the GitHub ping sent when a webhook is created, and deleted refs
+	if push == nil || push.deleted() {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// Git servers give up on webhooks which take more than a few seconds, so we refresh asynchronously
+	// and report the outcome as an event
+	w.WriteHeader(http.StatusAccepted)
+	h.pushes.Add(1)
+	go func() {
+		defer h.pushes.Done()
+		h.handlePush(push)
+	}()
+}
+
+// parse authenticates a webhook request and returns the push it reports, or nil if it reports
+// another kind of event.
+func (h *repoWebhook) parse(header http.Header, body []byte) (push *repoPush, err error) {
+	if event := header.Get("X-GitHub-Event"); event != "" {
+		signature, found := strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+		if !found || !h.validSignature(body, signature) {
+			return nil, errRepoWebhookUnauthorized
+		}
+		if event != "push" {
+			return nil, nil
+		}
+
+		var payload githubPushPayload
+		if err = json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid push payload: %w", err)
+		}
+		push = &repoPush{
+			source: "github",
+			repoUrls: []string{
+				payload.Repository.CloneUrl,
+				payload.Repository.SshUrl,
+				payload.Repository.GitUrl,
+				payload.Repository.HtmlUrl,
+			},
+			ref:    payload.Ref,
+			commit: payload.After,
+			pusher: payload.Pusher.Name,
+		}
+	} else if event = header.Get("X-Gitlab-Event"); event != "" {
+		if subtle.ConstantTimeCompare([]byte(header.Get("X-Gitlab-Token")), h.secret) != 1 {
+			return nil, errRepoWebhookUnauthorized
+		}
+		if event != "Push Hook" && event != "Tag Push Hook" {
+			return nil, nil
+		}
+
+		var payload gitlabPushPayload
+		if err = json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid push payload: %w", err)
+		}
+		push = &repoPush{
+			source: "gitlab",
+			repoUrls: []string{
+				payload.Project.GitHttpUrl,
+				payload.Project.GitSshUrl,
+				payload.Project.WebUrl,
+			},
+			ref:    payload.Ref,
+			commit: payload.After,
+			pusher: payload.UserUsername,
+		}
+	} else {
+		return nil, errors.New("not a GitHub or GitLab webhook")
+	}
+
+	if push.ref == "" || push.repoUrls[0] == "" {
+		return nil, errors.New("push payload without repository or ref")
+	}
+	return push, nil
+}
+
+func (h *repoWebhook) validSignature(body []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func (h *repoWebhook) handlePush(push *repoPush) {
+	repoIdentifier, err := h.refresh(push.repoUrls, push.ref)
+
+	ev := &pb.Ev_RepositoryRefreshEvent{
+		Repository:    repoIdentifier,
+		RepositoryUrl: push.repoUrls[0],
+		Ref:           push.ref,
+		Commit:        push.commit,
+		Pusher:        push.pusher,
+		Source:        push.source,
+		Status:        pb.OpStatus_DONE_OK,
+	}
+	logEntry := log.WithField("repo", push.repoUrls[0]).
+		WithField("ref", push.ref).
+		WithField("commit", push.commit)
+	if err != nil {
+		ev.Status = pb.OpStatus_DONE_ERROR
+		ev.Error = err.Error()
+		logEntry.WithError(err).
+			WithField("level", infologger.IL_Support).
+			Error("repository refresh on push failed")
+	} else {
+		logEntry.WithField("level", infologger.IL_Support).
+			Info("repository refreshed on push")
+	}
+	h.publish(ev)
+}
+
+func runRepoWebhook() {
+	webhookEndpoint := viper.GetString("reposWebhookEndpoint")
+	if webhookEndpoint == "" {
+		return
+	}
+	err, port, endpoint := parseMetricsEndpoint(webhookEndpoint)
+	if err != nil {
+		log.WithField("error", err).Error("failed to parse repository webhook endpoint")
+		return
+	}
+
+	webhook, err := newRepoWebhook(viper.GetString("reposWebhookSecretFile"))
+	if err != nil {
+		log.WithField("error", err).Error("repository webhook disabled")
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(fmt.Sprintf("/%s", endpoint), webhook)
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		log.Infof("Starting to listen on endpoint %s:%d for repository push webhooks", endpoint, port)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.WithField("error", err).Errorf("failed to run repository webhook on port %d", port)
+		}
+	}()
+}
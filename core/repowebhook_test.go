@@ -0,0 +1,183 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	pb "github.com/AliceO2Group/Control/common/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const githubPush = `{
+	"ref": "refs/heads/master",
+	"after": "0123456789abcdef0123456789abcdef01234567",
+	"repository": {
+		"clone_url": "https://github.com/AliceO2Group/ControlWorkflows.git",
+		"ssh_url": "git@github.com:AliceO2Group/ControlWorkflows.git"
+	},
+	"pusher": {"name": "someuser"}
+}`
+
+const gitlabTagPush = `{
+	"ref": "refs/tags/v1.2.3",
+	"after": "0123456789abcdef0123456789abcdef01234567",
+	"user_username": "someuser",
+	"project": {
+		"git_http_url": "https://gitlab.cern.ch/AliceO2Group/ControlWorkflows.git",
+		"git_ssh_url": "ssh://git@gitlab.cern.ch:7999/AliceO2Group/ControlWorkflows.git"
+	}
+}`
+
+func sign(secret string, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ = Describe("repository webhook", func() {
+	var (
+		webhook    *repoWebhook
+		refreshed  chan []string
+		events     chan *pb.Ev_RepositoryRefreshEvent
+		refreshErr error
+	)
+
+	BeforeEach(func() {
+		refreshed = make(chan []string, 1)
+		events = make(chan *pb.Ev_RepositoryRefreshEvent, 1)
+		refreshErr = nil
+		webhook = &repoWebhook{
+			secret: []byte("s3cr3t"),
+			refresh: func(repoUrls []string, gitRef string) (string, error) {
+				refreshed <- append([]string{gitRef}, repoUrls...)
+				return "github.com/AliceO2Group/ControlWorkflows", refreshErr
+			},
+			publish: func(ev *pb.Ev_RepositoryRefreshEvent) {
+				events <- ev
+			},
+		}
+	})
+
+	AfterEach(func() {
+		// The refreshes started by a spec must not outlive it, as they use its channels
+		webhook.pushes.Wait()
+	})
+
+	post := func(body string, header map[string]string) int {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		webhook.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	It("refreshes the pushed branch on a signed GitHub push", func() {
+		code := post(githubPush, map[string]string{
+			"X-GitHub-Event":      "push",
+			"X-Hub-Signature-256": sign("s3cr3t", githubPush),
+		})
+		Expect(code).To(Equal(http.StatusAccepted))
+
+		var args []string
+		Eventually(refreshed).Should(Receive(&args))
+		Expect(args[0]).To(Equal("refs/heads/master"))
+		Expect(args).To(ContainElement("git@github.com:AliceO2Group/ControlWorkflows.git"))
+
+		var ev *pb.Ev_RepositoryRefreshEvent
+		Eventually(events).Should(Receive(&ev))
+		Expect(ev.GetRepository()).To(Equal("github.com/AliceO2Group/ControlWorkflows"))
+		Expect(ev.GetSource()).To(Equal("github"))
+		Expect(ev.GetPusher()).To(Equal("someuser"))
+		Expect(ev.GetStatus()).To(Equal(pb.OpStatus_DONE_OK))
+	})
+
+	It("refreshes the pushed tag on a GitLab tag push with the right token", func() {
+		code := post(gitlabTagPush, map[string]string{
+			"X-Gitlab-Event": "Tag Push Hook",
+			"X-Gitlab-Token": "s3cr3t",
+		})
+		Expect(code).To(Equal(http.StatusAccepted))
+
+		var args []string
+		Eventually(refreshed).Should(Receive(&args))
+		Expect(args[0]).To(Equal("refs/tags/v1.2.3"))
+		Expect(args[1]).To(Equal("https://gitlab.cern.ch/AliceO2Group/ControlWorkflows.git"))
+	})
+
+	It("reports failed refreshes in the published event", func() {
+		refreshErr = errors.New("no repository matches")
+		post(githubPush, map[string]string{
+			"X-GitHub-Event":      "push",
+			"X-Hub-Signature-256": sign("s3cr3t", githubPush),
+		})
+
+		var ev *pb.Ev_RepositoryRefreshEvent
+		Eventually(events).Should(Receive(&ev))
+		Expect(ev.GetStatus()).To(Equal(pb.OpStatus_DONE_ERROR))
+		Expect(ev.GetError()).To(Equal("no repository matches"))
+	})
+
+	It("rejects requests with an invalid signature or token", func() {
+		Expect(post(githubPush, map[string]string{
+			"X-GitHub-Event":      "push",
+			"X-Hub-Signature-256": sign("wrong", githubPush),
+		})).To(Equal(http.StatusUnauthorized))
+		Expect(post(githubPush, map[string]string{
+			"X-GitHub-Event": "push",
+		})).To(Equal(http.StatusUnauthorized))
+		Expect(post(gitlabTagPush, map[string]string{
+			"X-Gitlab-Event": "Tag Push Hook",
+			"X-Gitlab-Token": "wrong",
+		})).To(Equal(http.StatusUnauthorized))
+		Consistently(refreshed).ShouldNot(Receive())
+	})
+
+	It("ignores other events and deleted refs", func() {
+		Expect(post(`{"zen": "hi"}`, map[string]string{
+			"X-GitHub-Event":      "ping",
+			"X-Hub-Signature-256": sign("s3cr3t", `{"zen": "hi"}`),
+		})).To(Equal(http.StatusNoContent))
+
+		deleted := strings.Replace(githubPush, "0123456789abcdef0123456789abcdef01234567", zeroCommit, 1)
+		Expect(post(deleted, map[string]string{
+			"X-GitHub-Event":      "push",
+			"X-Hub-Signature-256": sign("s3cr3t", deleted),
+		})).To(Equal(http.StatusNoContent))
+		Consistently(refreshed).ShouldNot(Receive())
+	})
+
+	It("rejects requests which are not webhooks", func() {
+		Expect(post(githubPush, nil)).To(Equal(http.StatusBadRequest))
+	})
+})
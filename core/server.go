@@ -31,6 +31,7 @@ import (
 	"fmt"
 	"maps"
 	"runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"time"
@@ -41,6 +42,7 @@ import (
 	"github.com/AliceO2Group/Control/common/system"
 	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/auth"
 	"github.com/AliceO2Group/Control/core/integration"
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/repos/varsource"
@@ -66,16 +68,21 @@ import (
 
 const MAX_ERROR_LENGTH = 6000 // gRPC seems to impose this limit on the status message
 
-func NewServer(state *globalState) *grpc.Server {
-	s := grpc.NewServer()
+func NewServer(state *globalState) (*grpc.Server, error) {
+	opts, policy, err := newServerAuth()
+	if err != nil {
+		return nil, err
+	}
+	s := grpc.NewServer(opts...)
 	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
 	pb.RegisterControlServer(s, &RpcServer{
 		state:      state,
 		envStreams: newSafeStreamsMap(),
+		policy:     policy,
 	})
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
-	return s
+	return s, nil
 }
 
 func (m *RpcServer) logMethod() {
@@ -118,6 +125,7 @@ func (m *RpcServer) logMethodHandled() {
 type RpcServer struct {
 	state      *globalState
 	envStreams SafeStreamsMap
+	policy     *auth.Policy // nil means no detector restrictions
 }
 
 // We are setting up timestamp field of proto files in the moment of function return,
@@ -225,15 +233,13 @@ func (m *RpcServer) GetEnvironments(cxt context.Context, request *pb.GetEnvironm
 	}
 	defer setCurrentUnixMilli(&r.Timestamp)
 
-	// Get plugin-provided environment data for all envs
-	var integratedServicesEnvsData map[uid.ID]map[string]string
-	if request.GetShowDetailedIntegratedServices() {
-		integratedServicesEnvsData = integration.PluginsInstance().GetEnvironmentsData(m.state.environments.Ids())
-	} else {
-		integratedServicesEnvsData = integration.PluginsInstance().GetEnvironmentsShortData(m.state.environments.Ids())
+	filter, err := newEnvironmentFilter(request)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get all environments
+	// Select the matching environments
+	listed := make([]listedEnvironment, 0)
 	for _, id := range m.state.environments.Ids() {
 		env, err := m.state.environments.Environment(id)
 		if err != nil {
@@ -243,12 +249,40 @@ func (m *RpcServer) GetEnvironments(cxt context.Context, request *pb.GetEnvironm
 				Error("cannot get environment")
 			continue
 		}
-		if !request.ShowAll && !env.Public {
+		if !filter.matches(env) {
 			continue
 		}
+		listed = append(listed, listedEnvironment{
+			env:         env,
+			id:          id.String(),
+			createdWhen: env.CreatedWhen().UnixMilli(),
+		})
+	}
+	sortListedEnvironments(listed)
+	r.TotalSize = int32(len(listed))
+
+	page, nextPageToken, err := paginate(listed, request.GetPageSize(), request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	r.NextPageToken = nextPageToken
+
+	// Get plugin-provided environment data only for the envs in the page
+	pageIds := make([]uid.ID, len(page))
+	for i, item := range page {
+		pageIds[i] = item.env.Id()
+	}
+	var integratedServicesEnvsData map[uid.ID]map[string]string
+	if request.GetShowDetailedIntegratedServices() {
+		integratedServicesEnvsData = integration.PluginsInstance().GetEnvironmentsData(pageIds)
+	} else {
+		integratedServicesEnvsData = integration.PluginsInstance().GetEnvironmentsShortData(pageIds)
+	}
+
+	for _, item := range page {
+		env, id := item.env, item.env.Id()
 		tasks := env.Workflow().GetTasks()
-		var defaults, vars, userVars map[string]string
-		defaults, vars, userVars, err = env.Workflow().ConsolidatedVarMaps()
+		defaults, vars, userVars, err := env.Workflow().ConsolidatedVarMaps()
 		if err != nil {
 			// take raw of copy, not raw of original because the actual varstack is mutex protected
 			defaults = env.GlobalDefaults.RawCopy()
@@ -276,6 +310,7 @@ func (m *RpcServer) GetEnvironments(cxt context.Context, request *pb.GetEnvironm
 			NumberOfTasks:          int32(len(tasks)),
 			IntegratedServicesData: isEnvData,
 			CurrentTransition:      env.CurrentTransition(),
+			Labels:                 env.GetLabels(),
 			NumberOfActiveTasks: int32(len(tasks.Filtered(func(t *task.Task) bool {
 				parent := t.GetParentRole()
 				if parent == nil {
@@ -305,18 +340,17 @@ func (m *RpcServer) GetEnvironments(cxt context.Context, request *pb.GetEnvironm
 
 		r.Environments = append(r.Environments, e)
 	}
-	sort.Sort(EnvironmentInfos(r.Environments))
 
 	return r, nil
 }
 
-func (m *RpcServer) doNewEnvironmentAsync(cxt context.Context, userVars map[string]string, requestUser *evpb.User, workflowTemplate string, public bool, autoTransition bool, id uid.ID) {
+func (m *RpcServer) doNewEnvironmentAsync(cxt context.Context, userVars map[string]string, labels map[string]string, requestUser *evpb.User, workflowTemplate string, public bool, autoTransition bool, waitForDetectors bool, id uid.ID) {
 	var err error
 
 	// we store the last known request user in the environment
 	lastRequestUserJ, _ := json.Marshal(requestUser)
 	userVars["last_request_user"] = string(lastRequestUserJ[:])
-	id, err = m.state.environments.CreateEnvironment(workflowTemplate, userVars, public, id, autoTransition)
+	id, err = m.state.environments.CreateEnvironment(workflowTemplate, userVars, labels, public, id, autoTransition, waitForDetectors)
 	if err != nil {
 		the.EventWriterWithTopic(topic.Environment).WriteEvent(&evpb.Ev_EnvironmentEvent{
 			EnvironmentId:   id.String(),
@@ -365,16 +399,28 @@ func (m *RpcServer) NewEnvironmentAsync(cxt context.Context, request *pb.NewEnvi
 	// Create new Environment instance with some roles, we get back a UUID
 	id := uid.New()
 
-	// We must make a copy of the userVars, otherwise there will be a race for access with gRPC
+	if err = environment.ValidateLabels(request.GetLabels()); err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "cannot create new environment: %s", err.Error()).Err()
+	}
+	if err = environment.ValidateUserVars(request.GetWorkflowTemplate(), request.GetVars()); err != nil {
+		return nil, userVarsError(err)
+	}
+
+	// We must make a copy of the userVars and labels, otherwise there will be a race for access with gRPC
 	userVars := make(map[string]string)
 	if len(request.GetVars()) > 0 {
 		maps.Copy(userVars, request.GetVars())
 	}
+	labels := make(map[string]string)
+	if len(request.GetLabels()) > 0 {
+		maps.Copy(labels, request.GetLabels())
+	}
 
 	ei := &pb.EnvironmentInfo{
 		Id:       id.String(),
 		State:    "PENDING",
 		UserVars: request.GetVars(),
+		Labels:   request.GetLabels(),
 	}
 	reply = &pb.NewEnvironmentReply{
 		Environment: ei,
@@ -382,7 +428,7 @@ func (m *RpcServer) NewEnvironmentAsync(cxt context.Context, request *pb.NewEnvi
 	}
 	defer setCurrentUnixMilli(&reply.Timestamp)
 
-	go m.doNewEnvironmentAsync(cxt, userVars, request.GetRequestUser(), request.GetWorkflowTemplate(), request.GetPublic(), request.GetAutoTransition(), id)
+	go m.doNewEnvironmentAsync(cxt, userVars, labels, requestUser(cxt, request.GetRequestUser()), request.GetWorkflowTemplate(), request.GetPublic(), request.GetAutoTransition(), request.GetWaitForDetectors(), id)
 
 	return
 }
@@ -419,17 +465,24 @@ func (m *RpcServer) NewEnvironment(cxt context.Context, request *pb.NewEnvironme
 	reply = &pb.NewEnvironmentReply{Public: request.Public}
 	defer setCurrentUnixMilli(&reply.Timestamp)
 
+	if err = environment.ValidateLabels(request.GetLabels()); err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "cannot create new environment: %s", err.Error()).Err()
+	}
+	if err = environment.ValidateUserVars(request.GetWorkflowTemplate(), request.GetVars()); err != nil {
+		return nil, userVarsError(err)
+	}
+
 	inputUserVars := request.GetVars()
 	if len(inputUserVars) == 0 {
 		inputUserVars = make(map[string]string)
 	}
 	// we store the last known request user in the environment
-	lastRequestUserJ, _ := json.Marshal(request.RequestUser)
+	lastRequestUserJ, _ := json.Marshal(requestUser(cxt, request.RequestUser))
 	inputUserVars["last_request_user"] = string(lastRequestUserJ[:])
 
 	// Create new Environment instance with some roles, we get back a UUID
 	id := uid.New()
-	id, err = m.state.environments.CreateEnvironment(request.GetWorkflowTemplate(), inputUserVars, request.GetPublic(), id, request.GetAutoTransition())
+	id, err = m.state.environments.CreateEnvironment(request.GetWorkflowTemplate(), inputUserVars, request.GetLabels(), request.GetPublic(), id, request.GetAutoTransition(), request.GetWaitForDetectors())
 	if err != nil {
 		st := status.Newf(codes.Internal, "cannot create new environment: %s", utils.TruncateString(err.Error(), MAX_ERROR_LENGTH))
 		ei := &pb.EnvironmentInfo{
@@ -492,6 +545,7 @@ func (m *RpcServer) NewEnvironment(cxt context.Context, request *pb.NewEnvironme
 		IncludedDetectors:      newEnv.GetActiveDetectors().StringList(),
 		IntegratedServicesData: isEnvData,
 		CurrentTransition:      newEnv.CurrentTransition(),
+		Labels:                 newEnv.GetLabels(),
 		NumberOfActiveTasks: int32(len(tasks.Filtered(func(t *task.Task) bool {
 			parent := t.GetParentRole()
 			if parent == nil {
@@ -573,6 +627,7 @@ func (m *RpcServer) GetEnvironment(cxt context.Context, req *pb.GetEnvironmentRe
 			NumberOfTasks:          int32(len(tasks)),
 			IntegratedServicesData: isEnvData,
 			CurrentTransition:      env.CurrentTransition(),
+			Labels:                 env.GetLabels(),
 			NumberOfActiveTasks: int32(len(tasks.Filtered(func(t *task.Task) bool {
 				parent := t.GetParentRole()
 				if parent == nil {
@@ -611,6 +666,38 @@ func (m *RpcServer) GetEnvironment(cxt context.Context, req *pb.GetEnvironmentRe
 	return
 }
 
+func (m *RpcServer) SetEnvironmentLabels(cxt context.Context, req *pb.SetEnvironmentLabelsRequest) (reply *pb.SetEnvironmentLabelsReply, err error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Id) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	var envId uid.ID
+	envId, err = uid.FromString(req.Id)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+	}
+
+	var env *environment.Environment
+	env, err = m.state.environments.Environment(envId)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+
+	var labels map[string]string
+	labels, err = env.UpdateLabels(req.GetLabels(), req.GetRemoveLabels())
+	if err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "cannot set environment labels: %s", err.Error()).Err()
+	}
+
+	reply = &pb.SetEnvironmentLabelsReply{Labels: labels}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+	return
+}
+
 func (m *RpcServer) ControlEnvironment(cxt context.Context, req *pb.ControlEnvironmentRequest) (*pb.ControlEnvironmentReply, error) {
 	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
 	m.logMethod()
@@ -630,7 +717,11 @@ func (m *RpcServer) ControlEnvironment(cxt context.Context, req *pb.ControlEnvir
 		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
 	}
 
-	env.SetLastRequestUser(req.RequestUser)
+	if err = m.authorizeForEnvironment(cxt, env); err != nil {
+		return nil, err
+	}
+
+	env.SetLastRequestUser(requestUser(cxt, req.RequestUser))
 
 	trans := environment.MakeTransition(m.state.taskman, req.Type)
 	if trans == nil {
@@ -667,17 +758,104 @@ func (m *RpcServer) ControlEnvironment(cxt context.Context, req *pb.ControlEnvir
 	}
 	defer setCurrentUnixMilli(&reply.Timestamp)
 	if err != nil {
-		return reply, status.Newf(codes.Aborted, err.Error()).Err()
+		return reply, status.New(codes.Aborted, err.Error()).Err()
 	}
 
 	return reply, nil
 }
 
-func (*RpcServer) ModifyEnvironment(context.Context, *pb.ModifyEnvironmentRequest) (*pb.ModifyEnvironmentReply, error) {
-	log.WithPrefix("rpcserver").
-		WithField("method", "ModifyEnvironment").
-		Debug("implement me")
-	return nil, status.New(codes.Unimplemented, "not implemented").Err()
+func (m *RpcServer) GetTransitionPlan(cxt context.Context, req *pb.GetTransitionPlanRequest) (*pb.GetTransitionPlanReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Id) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	envId, err := uid.FromString(req.Id)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+	}
+
+	env, err := m.state.environments.Environment(envId)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+
+	if req.Type == pb.ControlEnvironmentRequest_NOOP {
+		return nil, status.Newf(codes.InvalidArgument, "cannot plan invalid transition %s", req.GetType().String()).Err()
+	}
+
+	plan, err := env.PlanTransition(req.GetType().String())
+	if err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "cannot plan transition: %s", err.Error()).Err()
+	}
+
+	reply := transitionPlanToPb(req.Id, plan)
+	reply.Timestamp = currentUnixMilli()
+	return reply, nil
+}
+
+func (m *RpcServer) ModifyEnvironment(cxt context.Context, req *pb.ModifyEnvironmentRequest) (*pb.ModifyEnvironmentReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.Id) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	envId, err := uid.FromString(req.Id)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+	}
+
+	env, err := m.state.environments.Environment(envId)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+
+	if err = m.authorizeForEnvironment(cxt, env); err != nil {
+		return nil, err
+	}
+
+	if env.CurrentState() != "CONFIGURED" {
+		return nil, status.Newf(codes.FailedPrecondition, "cannot modify environment in state %s", env.CurrentState()).Err()
+	}
+
+	// ModifyEnvironmentRequest carries no claimed user, so only a verified caller is recorded
+	env.SetLastRequestUser(requestUser(cxt, nil))
+
+	authorizeAdded := func(detectors []string) error {
+		return m.authorizeForDetectors(cxt, detectors)
+	}
+	failedOperations, err := m.state.environments.ModifyEnvironment(envId, req.GetOperations(), req.GetReconfigureAll(), authorizeAdded)
+	if err != nil {
+		log.WithField("partition", env.Id()).
+			WithField("level", infologger.IL_Ops).
+			WithError(err).
+			Error("environment modification failed, transitioning into ERROR.")
+		the.EventWriterWithTopic(topic.Environment).WriteEvent(
+			environment.NewEnvGoErrorEvent(env, fmt.Sprintf("environment modification failed: %v", err)),
+		)
+		goErrorErr := env.TryTransition(environment.NewGoErrorTransition(m.state.taskman))
+		if goErrorErr != nil {
+			log.WithField("partition", env.Id()).Warnf("could not complete requested GO_ERROR transition, forcing move to ERROR: %s", goErrorErr.Error())
+			env.Sm.SetState("ERROR")
+		}
+	}
+
+	reply := &pb.ModifyEnvironmentReply{
+		FailedOperations: failedOperations,
+		Id:               env.Id().String(),
+		State:            env.CurrentState(),
+	}
+	if err != nil {
+		return reply, status.New(codes.Aborted, err.Error()).Err()
+	}
+
+	return reply, nil
 }
 
 func (m *RpcServer) DestroyEnvironment(cxt context.Context, req *pb.DestroyEnvironmentRequest) (reply *pb.DestroyEnvironmentReply, err error) {
@@ -709,7 +887,11 @@ func (m *RpcServer) DestroyEnvironment(cxt context.Context, req *pb.DestroyEnvir
 		return
 	}
 
-	env.SetLastRequestUser(req.RequestUser)
+	if err = m.authorizeForEnvironment(cxt, env); err != nil {
+		return
+	}
+
+	env.SetLastRequestUser(requestUser(cxt, req.RequestUser))
 
 	// if Force immediately disband the environment (unlocking all tasks) and run the cleanup.
 	if req.Force {
@@ -925,6 +1107,34 @@ func (m *RpcServer) GetTask(cxt context.Context, req *pb.GetTaskRequest) (*pb.Ge
 	return rep, nil
 }
 
+func (m *RpcServer) GetTaskLogs(cxt context.Context, req *pb.GetTaskLogsRequest) (*pb.GetTaskLogsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.TaskId) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	if req.Lines < 0 {
+		return nil, status.New(codes.InvalidArgument, "the number of lines cannot be negative").Err()
+	}
+	if m.state.taskman.GetTask(req.TaskId) == nil {
+		return nil, status.New(codes.NotFound, "task not found").Err()
+	}
+
+	logs, truncated, err := m.state.taskman.GetTaskLogs(req.TaskId, int(req.Lines))
+	if err != nil {
+		return nil, status.New(codes.Unavailable, err.Error()).Err()
+	}
+
+	return &pb.GetTaskLogsReply{
+		TaskId:    req.TaskId,
+		Logs:      logs,
+		Truncated: truncated,
+		Timestamp: currentUnixMilli(),
+	}, nil
+}
+
 func (m *RpcServer) CleanupTasks(cxt context.Context, req *pb.CleanupTasksRequest) (*pb.CleanupTasksReply, error) {
 	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
 	m.logMethod()
@@ -1050,6 +1260,79 @@ func (m *RpcServer) GetWorkflowTemplates(cxt context.Context, req *pb.GetWorkflo
 	return &pb.GetWorkflowTemplatesReply{WorkflowTemplates: workflowTemplateInfos, Timestamp: currentUnixMilli()}, nil
 }
 
+func (m *RpcServer) GetWorkflowTemplatePlan(cxt context.Context, req *pb.GetWorkflowTemplatePlanRequest) (*pb.GetWorkflowTemplatePlanReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	if len(req.GetWorkflowTemplate()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "no workflow template provided").Err()
+	}
+
+	userVars := req.GetVars()
+	if len(userVars) == 0 {
+		userVars = make(map[string]string)
+	}
+
+	root, err := m.state.environments.PlanWorkflow(req.GetWorkflowTemplate(), userVars)
+	if err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "cannot plan workflow template %s: %s", req.GetWorkflowTemplate(), err.Error()).Err()
+	}
+
+	plannedRoot := workflowToPlannedRoleTree(root, m.state.taskman)
+	taskClasses := root.GetTaskClasses()
+	sort.Strings(taskClasses)
+	taskClasses = slices.Compact(taskClasses)
+
+	return &pb.GetWorkflowTemplatePlanReply{
+		Root:        plannedRoot,
+		TaskClasses: taskClasses,
+		Triggers:    plannedRoleTreeToPlannedTriggers(plannedRoot),
+		Timestamp:   currentUnixMilli(),
+	}, nil
+}
+
+func (m *RpcServer) LintWorkflowTemplates(cxt context.Context, req *pb.LintWorkflowTemplatesRequest) (*pb.LintWorkflowTemplatesReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	files, err := the.RepoManager().GetTemplateFiles(req.GetRepo(), req.GetRevision())
+	if err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "cannot read templates of %s@%s: %s", req.GetRepo(), req.GetRevision(), err.Error()).Err()
+	}
+
+	knownVars := make([]string, 0)
+	for k := range the.ConfSvc().GetDefaults() {
+		knownVars = append(knownVars, k)
+	}
+	for k := range the.ConfSvc().GetVars() {
+		knownVars = append(knownVars, k)
+	}
+
+	findings := workflow.Lint(files, workflow.LintOptions{
+		KnownVars:   knownVars,
+		PluginCalls: integration.PluginsInstance().CallStackFunctions(),
+	})
+
+	return &pb.LintWorkflowTemplatesReply{
+		Repo:                  files.RepoIdentifier,
+		Revision:              files.Revision,
+		Hash:                  files.Hash,
+		WorkflowTemplateCount: int32(len(files.Workflows)),
+		TaskTemplateCount:     int32(len(files.Tasks)),
+		Findings:              lintFindingsToPb(findings),
+		Timestamp:             currentUnixMilli(),
+	}, nil
+}
+
 func (m *RpcServer) ListRepos(cxt context.Context, req *pb.ListReposRequest) (*pb.ListReposReply, error) {
 	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
 	m.logMethod()
@@ -1241,7 +1524,7 @@ func (m *RpcServer) NewAutoEnvironment(cxt context.Context, request *pb.NewAutoE
 		inputUserVars = make(map[string]string)
 	}
 	// we store the last known request user in the environment
-	lastRequestUserJ, _ := json.Marshal(request.RequestUser)
+	lastRequestUserJ, _ := json.Marshal(requestUser(cxt, request.RequestUser))
 	inputUserVars["last_request_user"] = string(lastRequestUserJ[:])
 
 	go m.state.environments.CreateAutoEnvironment(request.GetWorkflowTemplate(), inputUserVars, id, sub)
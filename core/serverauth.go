@@ -0,0 +1,121 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"errors"
+
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/core/auth"
+	"github.com/AliceO2Group/Control/core/environment"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// newServerAuth builds the TLS and authentication options of the Control gRPC
+// server, as well as the detector authorization policy, from the core configuration.
+func newServerAuth() (opts []grpc.ServerOption, policy *auth.Policy, err error) {
+	opts = make([]grpc.ServerOption, 0)
+
+	certFile := viper.GetString("controlTlsCertFile")
+	keyFile := viper.GetString("controlTlsKeyFile")
+	if len(certFile) != 0 || len(keyFile) != 0 {
+		tlsConfig, err := auth.ServerTLSConfig(certFile, keyFile, viper.GetString("controlTlsClientCaFile"))
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if len(viper.GetString("controlTlsClientCaFile")) != 0 {
+		return nil, nil, errors.New("controlTlsClientCaFile requires controlTlsCertFile and controlTlsKeyFile")
+	}
+
+	authenticator, err := auth.NewAuthenticator(viper.GetString("controlAuthTokensFile"), viper.GetBool("controlAuthRequired"))
+	if err != nil {
+		return nil, nil, err
+	}
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(authenticator.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(authenticator.StreamServerInterceptor()),
+	)
+
+	if policyFile := viper.GetString("controlAuthPolicyFile"); len(policyFile) != 0 {
+		policy, err = auth.LoadPolicy(policyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return
+}
+
+// requestUser returns the user to be recorded for a request. If the caller was
+// authenticated, the verified identity replaces the user claimed in the request,
+// and the claimed IDs are only kept if the claimed name matches.
+func requestUser(cxt context.Context, claimed *evpb.User) *evpb.User {
+	id, _ := auth.FromContext(cxt)
+	if !id.IsVerified() {
+		return claimed
+	}
+	user := &evpb.User{Name: id.Name}
+	if claimed != nil && claimed.GetName() == id.Name {
+		user.Id = claimed.Id
+		user.ExternalId = claimed.ExternalId
+	}
+	return user
+}
+
+// authorizeForEnvironment checks the caller against the detector policy for the
+// detectors currently active in env.
+func (m *RpcServer) authorizeForEnvironment(cxt context.Context, env *environment.Environment) error {
+	id, _ := auth.FromContext(cxt)
+	err := m.policy.Authorize(id, env.GetActiveDetectors().StringList())
+	if err != nil {
+		log.WithPrefix("rpcserver").
+			WithField("partition", env.Id().String()).
+			WithField("user", id.Name).
+			WithError(err).
+			Warn("request denied by detector policy")
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+// authorizeForDetectors checks the caller against the detector policy for the
+// given detectors.
+func (m *RpcServer) authorizeForDetectors(cxt context.Context, detectors []string) error {
+	id, _ := auth.FromContext(cxt)
+	err := m.policy.Authorize(id, detectors)
+	if err != nil {
+		log.WithPrefix("rpcserver").
+			WithField("detectors", detectors).
+			WithField("user", id.Name).
+			WithError(err).
+			Warn("request denied by detector policy")
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
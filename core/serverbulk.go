@@ -0,0 +1,213 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/system"
+	"github.com/AliceO2Group/Control/common/utils"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/environment"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *RpcServer) ControlEnvironments(cxt context.Context, req *pb.ControlEnvironmentsRequest) (*pb.ControlEnvironmentsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	envIds, err := m.selectEnvironments(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ControlEnvironmentsReply{
+		Outcomes: make([]*pb.ControlEnvironmentOutcome, len(envIds)),
+	}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+
+	fanOut(envIds, req.GetMaxConcurrency(), func(i int, envId string) {
+		controlReply, err := m.ControlEnvironment(cxt, &pb.ControlEnvironmentRequest{
+			Id:          envId,
+			Type:        req.GetType(),
+			RequestUser: req.GetRequestUser(),
+		})
+		reply.Outcomes[i] = &pb.ControlEnvironmentOutcome{
+			Outcome: newEnvironmentOutcome(envId, err),
+			Reply:   controlReply,
+		}
+	})
+	return reply, nil
+}
+
+func (m *RpcServer) DestroyEnvironments(cxt context.Context, req *pb.DestroyEnvironmentsRequest) (*pb.DestroyEnvironmentsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	envIds, err := m.selectEnvironments(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.DestroyEnvironmentsReply{
+		Outcomes: make([]*pb.DestroyEnvironmentOutcome, len(envIds)),
+	}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+
+	fanOut(envIds, req.GetMaxConcurrency(), func(i int, envId string) {
+		destroyReply, err := m.DestroyEnvironment(cxt, &pb.DestroyEnvironmentRequest{
+			Id:                  envId,
+			KeepTasks:           req.GetKeepTasks(),
+			AllowInRunningState: req.GetAllowInRunningState(),
+			Force:               req.GetForce(),
+			RequestUser:         req.GetRequestUser(),
+		})
+		reply.Outcomes[i] = &pb.DestroyEnvironmentOutcome{
+			Outcome: newEnvironmentOutcome(envId, err),
+			Reply:   destroyReply,
+		}
+	})
+	return reply, nil
+}
+
+// selectEnvironments returns the IDs of the environments matched by the
+// selector. Explicitly requested IDs are kept even if they do not exist, so
+// that they are reported as failed outcomes rather than silently dropped.
+func (m *RpcServer) selectEnvironments(selector *pb.EnvironmentSelector) ([]string, error) {
+	if selector == nil ||
+		(len(selector.GetIds()) == 0 && len(selector.GetDetector()) == 0 && len(selector.GetUserVars()) == 0 &&
+			len(selector.GetLabels()) == 0) {
+		return nil, status.New(codes.InvalidArgument, "empty environment selector").Err()
+	}
+
+	var detector system.ID
+	if len(selector.GetDetector()) > 0 {
+		var err error
+		detector, err = system.IDString(selector.GetDetector())
+		if err != nil {
+			return nil, status.Newf(codes.InvalidArgument, "invalid detector %s", selector.GetDetector()).Err()
+		}
+	}
+
+	candidates := selector.GetIds()
+	if len(candidates) == 0 {
+		for _, envId := range m.state.environments.Ids() {
+			candidates = append(candidates, envId.String())
+		}
+	}
+
+	selected := make([]string, 0, len(candidates))
+	seen := make(map[string]struct{}, len(candidates))
+	for _, candidate := range candidates {
+		if _, ok := seen[candidate]; ok {
+			continue
+		}
+		seen[candidate] = struct{}{}
+
+		envId, err := uid.FromString(candidate)
+		if err != nil {
+			selected = append(selected, candidate)
+			continue
+		}
+		env, err := m.state.environments.Environment(envId)
+		if err != nil {
+			selected = append(selected, candidate)
+			continue
+		}
+		if environmentMatches(env, selector, detector) {
+			selected = append(selected, candidate)
+		}
+	}
+	return selected, nil
+}
+
+func environmentMatches(env *environment.Environment, selector *pb.EnvironmentSelector, detector system.ID) bool {
+	if len(selector.GetDetector()) > 0 {
+		if _, ok := env.GetActiveDetectors()[detector]; !ok {
+			return false
+		}
+	}
+	for key, value := range selector.GetUserVars() {
+		if actual, ok := env.UserVars.Get(key); !ok || actual != value {
+			return false
+		}
+	}
+	return env.HasLabels(selector.GetLabels())
+}
+
+func newEnvironmentOutcome(envId string, err error) *pb.EnvironmentOutcome {
+	if err == nil {
+		return &pb.EnvironmentOutcome{Id: envId, Ok: true}
+	}
+	st := status.Convert(err)
+	return &pb.EnvironmentOutcome{
+		Id:        envId,
+		Ok:        false,
+		Error:     st.Message(),
+		ErrorCode: st.Code().String(),
+	}
+}
+
+// fanOut calls fn for every environment ID with at most maxConcurrency calls
+// in flight, and returns once all of them are done. The requested concurrency
+// defaults to bulkOperationConcurrency and is capped by
+// bulkOperationMaxConcurrency.
+func fanOut(envIds []string, maxConcurrency int32, fn func(i int, envId string)) {
+	concurrency := int(maxConcurrency)
+	if concurrency <= 0 {
+		concurrency = viper.GetInt("bulkOperationConcurrency")
+	}
+	if upperBound := viper.GetInt("bulkOperationMaxConcurrency"); upperBound > 0 {
+		concurrency = min(concurrency, upperBound)
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	semaphore := make(chan struct{}, concurrency)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(envIds))
+	for i, envId := range envIds {
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			fn(i, envId)
+		}()
+	}
+	wg.Wait()
+}
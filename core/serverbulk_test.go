@@ -0,0 +1,100 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("bulk environment operations", func() {
+	It("calls every environment once with bounded concurrency", func() {
+		ids := []string{"a", "b", "c", "d", "e", "f", "g"}
+		results := make([]string, len(ids))
+		var inFlight, maxInFlight atomic.Int32
+
+		fanOut(ids, 3, func(i int, envId string) {
+			current := inFlight.Add(1)
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			results[i] = envId
+			inFlight.Add(-1)
+		})
+
+		Expect(results).To(Equal(ids))
+		Expect(maxInFlight.Load()).To(BeNumerically("<=", 3))
+		Expect(maxInFlight.Load()).To(BeNumerically(">", 1))
+	})
+
+	It("caps the requested concurrency", func() {
+		viper.Set("bulkOperationMaxConcurrency", 2)
+		DeferCleanup(func() {
+			viper.Set("bulkOperationMaxConcurrency", 0)
+		})
+
+		ids := []string{"a", "b", "c", "d", "e", "f", "g"}
+		var inFlight, maxInFlight atomic.Int32
+		fanOut(ids, 1000, func(i int, envId string) {
+			current := inFlight.Add(1)
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+		})
+
+		Expect(maxInFlight.Load()).To(BeNumerically("<=", 2))
+	})
+
+	It("reports the gRPC status of failed operations", func() {
+		outcome := newEnvironmentOutcome("2oDvieFrVTi", status.New(codes.NotFound, "environment not found").Err())
+		Expect(outcome.GetOk()).To(BeFalse())
+		Expect(outcome.GetErrorCode()).To(Equal("NotFound"))
+		Expect(outcome.GetError()).To(Equal("environment not found"))
+
+		outcome = newEnvironmentOutcome("2oDvieFrVTi", nil)
+		Expect(outcome.GetOk()).To(BeTrue())
+		Expect(outcome.GetErrorCode()).To(BeEmpty())
+	})
+
+	It("rejects empty selectors", func() {
+		m := &RpcServer{}
+		_, err := m.selectEnvironments(nil)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
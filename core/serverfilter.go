@@ -0,0 +1,189 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/runtype"
+	"github.com/AliceO2Group/Control/common/system"
+	"github.com/AliceO2Group/Control/core/environment"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// environmentFilter holds the filters of a GetEnvironmentsRequest, already
+// validated and parsed.
+type environmentFilter struct {
+	showAll       bool
+	labels        map[string]string
+	states        map[string]struct{}
+	detector      system.ID
+	hasDetector   bool
+	runType       runtype.RunType
+	hasRunType    bool
+	createdAfter  int64 // msec, 0 if unset
+	createdBefore int64 // msec, 0 if unset
+}
+
+func newEnvironmentFilter(request *pb.GetEnvironmentsRequest) (*environmentFilter, error) {
+	f := &environmentFilter{
+		showAll:       request.GetShowAll(),
+		labels:        request.GetLabels(),
+		createdAfter:  request.GetCreatedAfter(),
+		createdBefore: request.GetCreatedBefore(),
+	}
+	if len(request.GetStates()) > 0 {
+		f.states = make(map[string]struct{}, len(request.GetStates()))
+		for _, state := range request.GetStates() {
+			f.states[strings.ToUpper(state)] = struct{}{}
+		}
+	}
+	if len(request.GetDetector()) > 0 {
+		detector, err := system.IDString(request.GetDetector())
+		if err != nil {
+			return nil, status.Newf(codes.InvalidArgument, "invalid detector %s", request.GetDetector()).Err()
+		}
+		f.detector, f.hasDetector = detector, true
+	}
+	if len(request.GetRunType()) > 0 {
+		rt, err := runtype.RunTypeString(request.GetRunType())
+		if err != nil {
+			return nil, status.Newf(codes.InvalidArgument, "invalid run type %s", request.GetRunType()).Err()
+		}
+		f.runType, f.hasRunType = rt, true
+	}
+	if f.createdAfter > 0 && f.createdBefore > 0 && f.createdAfter >= f.createdBefore {
+		return nil, status.New(codes.InvalidArgument, "createdAfter must be earlier than createdBefore").Err()
+	}
+	return f, nil
+}
+
+// matches checks the cheap criteria first, so that the workflow is only
+// queried for the environments which are still candidates.
+func (f *environmentFilter) matches(env *environment.Environment) bool {
+	if !f.showAll && !env.Public {
+		return false
+	}
+	createdWhen := env.CreatedWhen().UnixMilli()
+	if f.createdAfter > 0 && createdWhen < f.createdAfter {
+		return false
+	}
+	if f.createdBefore > 0 && createdWhen >= f.createdBefore {
+		return false
+	}
+	if f.states != nil {
+		if _, ok := f.states[env.CurrentState()]; !ok {
+			return false
+		}
+	}
+	if !env.HasLabels(f.labels) {
+		return false
+	}
+	if f.hasDetector {
+		if _, ok := env.GetActiveDetectors()[f.detector]; !ok {
+			return false
+		}
+	}
+	if f.hasRunType {
+		// not env.GetRunType(), which warns about every environment without a valid run type
+		rt, err := runtype.RunTypeString(env.GetKV("", "run_type"))
+		if err != nil || rt != f.runType {
+			return false
+		}
+	}
+	return true
+}
+
+// listedEnvironment is an environment selected by GetEnvironments, with the
+// keys which define the listing order.
+type listedEnvironment struct {
+	env         *environment.Environment
+	id          string
+	createdWhen int64 // msec
+}
+
+func sortListedEnvironments(list []listedEnvironment) {
+	sort.Slice(list, func(i, j int) bool {
+		return listedEnvironmentLess(list[i].createdWhen, list[i].id, list[j].createdWhen, list[j].id)
+	})
+}
+
+func listedEnvironmentLess(iCreatedWhen int64, iId string, jCreatedWhen int64, jId string) bool {
+	if iCreatedWhen != jCreatedWhen {
+		return iCreatedWhen < jCreatedWhen
+	}
+	return iId < jId
+}
+
+// A page token points at the last environment of the previous page, so that
+// paging stays consistent when environments are created or destroyed between
+// two requests.
+func encodePageToken(last listedEnvironment) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d/%s", last.createdWhen, last.id)))
+}
+
+func decodePageToken(token string) (createdWhen int64, id string, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, "", err
+	}
+	createdWhenS, id, found := strings.Cut(string(raw), "/")
+	if !found || len(id) == 0 {
+		return 0, "", fmt.Errorf("malformed page token")
+	}
+	createdWhen, err = strconv.ParseInt(createdWhenS, 10, 64)
+	return
+}
+
+// paginate returns the page of the sorted list which starts after pageToken,
+// and the token of the following page, empty if there is none.
+func paginate(list []listedEnvironment, pageSize int32, pageToken string) (page []listedEnvironment, nextPageToken string, err error) {
+	if pageSize < 0 {
+		return nil, "", status.New(codes.InvalidArgument, "pageSize must not be negative").Err()
+	}
+
+	start := 0
+	if len(pageToken) > 0 {
+		createdWhen, id, decodeErr := decodePageToken(pageToken)
+		if decodeErr != nil {
+			return nil, "", status.Newf(codes.InvalidArgument, "invalid page token: %s", decodeErr.Error()).Err()
+		}
+		start = sort.Search(len(list), func(i int) bool {
+			return listedEnvironmentLess(createdWhen, id, list[i].createdWhen, list[i].id)
+		})
+	}
+
+	page = list[start:]
+	if pageSize > 0 && len(page) > int(pageSize) {
+		page = page[:pageSize]
+		nextPageToken = encodePageToken(page[len(page)-1])
+	}
+	return
+}
@@ -0,0 +1,121 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	pb "github.com/AliceO2Group/Control/core/protos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("environment list filters", func() {
+	It("rejects invalid filters", func() {
+		_, err := newEnvironmentFilter(&pb.GetEnvironmentsRequest{Detector: "NOPE"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		_, err = newEnvironmentFilter(&pb.GetEnvironmentsRequest{RunType: "NOPE"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		_, err = newEnvironmentFilter(&pb.GetEnvironmentsRequest{CreatedAfter: 2000, CreatedBefore: 1000})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("parses valid filters", func() {
+		f, err := newEnvironmentFilter(&pb.GetEnvironmentsRequest{
+			Detector: "tpc",
+			RunType:  "PHYSICS",
+			States:   []string{"running", "CONFIGURED"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.hasDetector).To(BeTrue())
+		Expect(f.hasRunType).To(BeTrue())
+		Expect(f.states).To(HaveKey("RUNNING"))
+		Expect(f.states).To(HaveKey("CONFIGURED"))
+	})
+})
+
+var _ = Describe("environment list pagination", func() {
+	var list []listedEnvironment
+
+	BeforeEach(func() {
+		list = []listedEnvironment{
+			{id: "e", createdWhen: 300},
+			{id: "a", createdWhen: 100},
+			{id: "c", createdWhen: 200},
+			{id: "b", createdWhen: 200},
+			{id: "d", createdWhen: 250},
+		}
+		sortListedEnvironments(list)
+	})
+
+	ids := func(page []listedEnvironment) []string {
+		out := make([]string, len(page))
+		for i, item := range page {
+			out[i] = item.id
+		}
+		return out
+	}
+
+	It("sorts by creation time and id", func() {
+		Expect(ids(list)).To(Equal([]string{"a", "b", "c", "d", "e"}))
+	})
+
+	It("returns everything without a page size", func() {
+		page, next, err := paginate(list, 0, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(page)).To(Equal([]string{"a", "b", "c", "d", "e"}))
+		Expect(next).To(BeEmpty())
+	})
+
+	It("walks through all the pages", func() {
+		page, next, err := paginate(list, 2, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(page)).To(Equal([]string{"a", "b"}))
+
+		page, next, err = paginate(list, 2, next)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(page)).To(Equal([]string{"c", "d"}))
+
+		page, next, err = paginate(list, 2, next)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(page)).To(Equal([]string{"e"}))
+		Expect(next).To(BeEmpty())
+	})
+
+	It("stays consistent when an environment of a previous page goes away", func() {
+		_, next, err := paginate(list, 2, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		page, _, err := paginate(list[1:], 2, next)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(page)).To(Equal([]string{"c", "d"}))
+	})
+
+	It("rejects invalid page tokens and sizes", func() {
+		_, _, err := paginate(list, 2, "not a token")
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		_, _, err = paginate(list, -1, "")
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
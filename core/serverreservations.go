@@ -0,0 +1,140 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	evpb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/system"
+	"github.com/AliceO2Group/Control/common/utils"
+	"github.com/AliceO2Group/Control/core/environment"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *RpcServer) GetDetectorReservations(cxt context.Context, req *pb.GetDetectorReservationsRequest) (*pb.GetDetectorReservationsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	leases, queue := m.state.environments.DetectorReservations()
+	reply := &pb.GetDetectorReservationsReply{
+		Leases: detectorLeasesToPb(leases),
+		Queue:  make([]*pb.QueuedEnvironmentRequest, len(queue)),
+	}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+	for i, queued := range queue {
+		reply.Queue[i] = &pb.QueuedEnvironmentRequest{
+			EnvironmentId: queued.EnvironmentId.String(),
+			Owner:         queued.Owner,
+			Detectors:     queued.Detectors.StringList(),
+			QueuedWhen:    queued.QueuedWhen.UnixMilli(),
+		}
+	}
+	return reply, nil
+}
+
+func (m *RpcServer) ReserveDetectors(cxt context.Context, req *pb.ReserveDetectorsRequest) (*pb.ReserveDetectorsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.GetDetectors()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	detectors, err := detectorsFromStrings(req.GetDetectors())
+	if err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "received bad detector list: %s", err.Error()).Err()
+	}
+	if err = m.authorizeForDetectors(cxt, detectors.StringList()); err != nil {
+		return nil, err
+	}
+	owner := requestUser(cxt, req.GetRequestUser()).GetName()
+	if owner == "" {
+		return nil, status.New(codes.InvalidArgument, "detector reservations require a request user").Err()
+	}
+
+	ttl := time.Duration(req.GetTtlSeconds()) * time.Second
+	leases, err := m.state.environments.ReserveDetectors(owner, detectors, ttl)
+	if err != nil {
+		var inUse *environment.DetectorsInUseError
+		if errors.As(err, &inUse) {
+			return nil, status.Newf(codes.FailedPrecondition, "cannot reserve detectors: %s", err.Error()).Err()
+		}
+		return nil, status.Newf(codes.InvalidArgument, "cannot reserve detectors: %s", err.Error()).Err()
+	}
+
+	reply := &pb.ReserveDetectorsReply{Leases: detectorLeasesToPb(leases)}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+	return reply, nil
+}
+
+func (m *RpcServer) ReleaseDetectors(cxt context.Context, req *pb.ReleaseDetectorsRequest) (*pb.ReleaseDetectorsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	detectors, err := detectorsFromStrings(req.GetDetectors())
+	if err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "received bad detector list: %s", err.Error()).Err()
+	}
+	owner := requestUser(cxt, req.GetRequestUser()).GetName()
+	if owner == "" {
+		return nil, status.New(codes.InvalidArgument, "detector reservations require a request user").Err()
+	}
+
+	// only the owner's own reservations are released, so no detector policy check is needed
+	leases := m.state.environments.ReleaseDetectors(owner, detectors)
+
+	reply := &pb.ReleaseDetectorsReply{Leases: detectorLeasesToPb(leases)}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+	return reply, nil
+}
+
+func detectorsFromStrings(detectors []string) (system.IDMap, error) {
+	ids := make(system.IDMap)
+	for _, det := range detectors {
+		id, err := system.IDString(det)
+		if err != nil {
+			return nil, err
+		}
+		ids[id] = struct{}{}
+	}
+	return ids, nil
+}
+
+func detectorLeasesToPb(leases []environment.DetectorLease) []*evpb.DetectorLease {
+	out := make([]*evpb.DetectorLease, len(leases))
+	for i, lease := range leases {
+		out[i] = lease.ToPb()
+	}
+	return out
+}
@@ -0,0 +1,147 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/utils"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/environment"
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *RpcServer) ScheduleTransition(cxt context.Context, req *pb.ScheduleTransitionRequest) (*pb.ScheduleTransitionReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.GetEnvironmentId()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	envId, err := uid.FromString(req.GetEnvironmentId())
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+	}
+	env, err := m.state.environments.Environment(envId)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+	if err = m.authorizeForEnvironment(cxt, env); err != nil {
+		return nil, err
+	}
+
+	schedule := &environment.TransitionSchedule{
+		EnvironmentId:    envId,
+		Optype:           req.GetType(),
+		AfterRunDuration: time.Duration(req.GetAfterRunDuration()) * time.Millisecond,
+		Condition:        req.GetCondition(),
+		RequestUser:      requestUser(cxt, req.GetRequestUser()),
+	}
+	if req.GetAt() != 0 {
+		schedule.At = time.UnixMilli(req.GetAt())
+	}
+	if err = m.state.environments.ScheduleTransition(schedule); err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "cannot schedule transition: %s", err.Error()).Err()
+	}
+
+	reply := &pb.ScheduleTransitionReply{Schedule: transitionScheduleToPb(schedule)}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+	return reply, nil
+}
+
+func (m *RpcServer) GetScheduledTransitions(cxt context.Context, req *pb.GetScheduledTransitionsRequest) (*pb.GetScheduledTransitionsReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	envId := uid.NilID()
+	if len(req.GetEnvironmentId()) > 0 {
+		var err error
+		envId, err = uid.FromString(req.GetEnvironmentId())
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "received bad environment id").Err()
+		}
+	}
+
+	schedules := m.state.environments.ScheduledTransitions(envId)
+	reply := &pb.GetScheduledTransitionsReply{
+		Schedules: make([]*pb.TransitionSchedule, len(schedules)),
+	}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+	for i, schedule := range schedules {
+		reply.Schedules[i] = transitionScheduleToPb(schedule)
+	}
+	return reply, nil
+}
+
+func (m *RpcServer) CancelScheduledTransition(cxt context.Context, req *pb.CancelScheduledTransitionRequest) (*pb.CancelScheduledTransitionReply, error) {
+	defer utils.TimeTrackFunction(time.Now(), log.WithPrefix("rpcserver"))
+	m.logMethod()
+	defer m.logMethodHandled()
+
+	if req == nil || len(req.GetId()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	// the caller must be allowed to control the environment, if it still exists
+	for _, schedule := range m.state.environments.ScheduledTransitions(uid.NilID()) {
+		if schedule.Id != req.GetId() {
+			continue
+		}
+		if env, err := m.state.environments.Environment(schedule.EnvironmentId); err == nil {
+			if err = m.authorizeForEnvironment(cxt, env); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	schedule, err := m.state.environments.CancelScheduledTransition(req.GetId())
+	if err != nil {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	}
+
+	reply := &pb.CancelScheduledTransitionReply{Schedule: transitionScheduleToPb(schedule)}
+	defer setCurrentUnixMilli(&reply.Timestamp)
+	return reply, nil
+}
+
+func transitionScheduleToPb(schedule *environment.TransitionSchedule) *pb.TransitionSchedule {
+	out := &pb.TransitionSchedule{
+		Id:               schedule.Id,
+		EnvironmentId:    schedule.EnvironmentId.String(),
+		Type:             schedule.Optype,
+		AfterRunDuration: schedule.AfterRunDuration.Milliseconds(),
+		Condition:        schedule.Condition,
+		CreatedWhen:      schedule.CreatedWhen.UnixMilli(),
+		RequestUser:      schedule.RequestUser,
+	}
+	if !schedule.At.IsZero() {
+		out.At = schedule.At.UnixMilli()
+	}
+	return out
+}
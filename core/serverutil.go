@@ -25,16 +25,23 @@
 package core
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/AliceO2Group/Control/core/repos"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/environment"
 	pb "github.com/AliceO2Group/Control/core/protos"
 	"github.com/AliceO2Group/Control/core/task/channel"
 
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/workflow"
+	"github.com/AliceO2Group/Control/core/workflow/callable"
 )
 
 const MESOS_AGENT_PORT = 5051
@@ -189,6 +196,214 @@ func workflowToRoleTree(root workflow.Role) (ri *pb.RoleInfo) {
 	return
 }
 
+type plannedTaskRole interface {
+	GetTaskClass() string
+	GetTaskTraits() task.Traits
+}
+
+type plannedCallRole interface {
+	GetFuncCall() string
+	GetReturnVar() string
+	GetTaskTraits() task.Traits
+}
+
+// workflowToPlannedRoleTree converts a processed but undeployed workflow into a tree of
+// PlannedRoles. Task roles get their channels and constraints merged with the ones of their
+// task class, as they would be at deployment time.
+func workflowToPlannedRoleTree(root workflow.Role, taskman *task.Manager) (pr *pb.PlannedRole) {
+	if root == nil || !root.IsEnabled() {
+		return
+	}
+	consolidatedVarStack, _ := root.ConsolidatedVarStack()
+	pr = &pb.PlannedRole{
+		Name:              root.GetName(),
+		FullPath:          root.GetPath(),
+		Roles:             make([]*pb.PlannedRole, 0),
+		ConsolidatedStack: consolidatedVarStack,
+	}
+	for _, cr := range root.GetRoles() {
+		if cpr := workflowToPlannedRoleTree(cr, taskman); cpr != nil {
+			pr.Roles = append(pr.Roles, cpr)
+		}
+	}
+
+	var traits task.Traits
+	switch r := root.(type) {
+	case plannedTaskRole:
+		traits = r.GetTaskTraits()
+		pr.Task = &pb.PlannedTask{
+			ClassName: r.GetTaskClass(),
+		}
+		descriptors := root.GenerateTaskDescriptors()
+		if len(descriptors) == 0 {
+			break
+		}
+		descriptor := descriptors[0]
+		inbound, outbound := descriptor.RoleBind, descriptor.RoleConnect
+		if taskClass := taskman.GetTaskClass(descriptor.TaskClassName); taskClass != nil {
+			inbound = channel.MergeInbound(inbound, taskClass.Bind)
+			outbound = channel.MergeOutbound(outbound, taskClass.Connect)
+		}
+		pr.Task.InboundChannels = inboundChannelsToPbChannels(inbound)
+		pr.Task.OutboundChannels = outboundChannelsToPbChannels(outbound)
+
+		constraints := taskman.BuildDescriptorConstraints(descriptors)[descriptor]
+		pr.Task.Constraints = make([]string, len(constraints))
+		for i := range constraints {
+			pr.Task.Constraints[i] = constraints[i].String()
+		}
+	case plannedCallRole:
+		traits = r.GetTaskTraits()
+		pr.Call = &pb.PlannedCall{
+			Func:   r.GetFuncCall(),
+			Return: r.GetReturnVar(),
+		}
+	default:
+		return
+	}
+	pr.Trigger = traits.Trigger
+	pr.Await = traits.Await
+	pr.Timeout = traits.Timeout
+	pr.Critical = traits.Critical
+	return
+}
+
+// plannedRoleTreeToPlannedTriggers collects the hooks of a PlannedRole tree by trigger name.
+// Triggers are sorted by name, and the hooks of each trigger by weight and role path.
+func plannedRoleTreeToPlannedTriggers(root *pb.PlannedRole) (triggers []*pb.PlannedTrigger) {
+	hooksByTrigger := make(map[string][]*pb.PlannedHook)
+	var walk func(pr *pb.PlannedRole)
+	walk = func(pr *pb.PlannedRole) {
+		if pr == nil {
+			return
+		}
+		for _, cpr := range pr.GetRoles() {
+			walk(cpr)
+		}
+		if len(pr.GetTrigger()) == 0 {
+			return
+		}
+		triggerName, triggerWeight := callable.ParseTriggerExpression(pr.GetTrigger())
+		hook := &pb.PlannedHook{
+			RolePath: pr.GetFullPath(),
+			Weight:   int32(triggerWeight),
+			Await:    pr.GetAwait(),
+			Critical: pr.GetCritical(),
+			Timeout:  pr.GetTimeout(),
+		}
+		if pr.GetCall() != nil {
+			hook.Name = pr.GetCall().GetFunc()
+			hook.IsCall = true
+		} else {
+			hook.Name = pr.GetTask().GetClassName()
+		}
+		hooksByTrigger[triggerName] = append(hooksByTrigger[triggerName], hook)
+	}
+	walk(root)
+
+	triggers = make([]*pb.PlannedTrigger, 0, len(hooksByTrigger))
+	for triggerName, hooks := range hooksByTrigger {
+		sort.SliceStable(hooks, func(i, j int) bool {
+			if hooks[i].Weight != hooks[j].Weight {
+				return hooks[i].Weight < hooks[j].Weight
+			}
+			return hooks[i].RolePath < hooks[j].RolePath
+		})
+		triggers = append(triggers, &pb.PlannedTrigger{
+			Trigger: triggerName,
+			Hooks:   hooks,
+		})
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].Trigger < triggers[j].Trigger
+	})
+	return
+}
+
+// callToPlannedHook converts a call into a PlannedHook, with the weight of its trigger expression.
+func callToPlannedHook(call *callable.Call) *pb.PlannedHook {
+	traits := call.GetTraits()
+	_, triggerWeight := callable.ParseTriggerExpression(traits.Trigger)
+	return &pb.PlannedHook{
+		RolePath: call.GetParentRolePath(),
+		Weight:   int32(triggerWeight),
+		Name:     call.Func,
+		IsCall:   true,
+		Await:    traits.Await,
+		Critical: traits.Critical,
+		Timeout:  traits.Timeout,
+	}
+}
+
+func callsToPlannedHooks(calls callable.Calls) (hooks []*pb.PlannedHook) {
+	hooks = make([]*pb.PlannedHook, len(calls))
+	for i, call := range calls {
+		hooks[i] = callToPlannedHook(call)
+	}
+	return
+}
+
+func hookTasksToPlannedHooks(tasks task.Tasks) (hooks []*pb.PlannedHook) {
+	hooks = make([]*pb.PlannedHook, len(tasks))
+	for i, t := range tasks {
+		traits := t.GetTraits()
+		_, triggerWeight := callable.ParseTriggerExpression(traits.Trigger)
+		hooks[i] = &pb.PlannedHook{
+			RolePath: t.GetParentRolePath(),
+			Weight:   int32(triggerWeight),
+			Name:     t.GetClassName(),
+			Await:    traits.Await,
+			Critical: traits.Critical,
+			Timeout:  traits.Timeout,
+		}
+	}
+	return
+}
+
+func transitionPlanToPb(envId string, plan *environment.TransitionPlan) *pb.GetTransitionPlanReply {
+	hookGroups := make([]*pb.PlannedHookGroup, len(plan.HookGroups))
+	for i, group := range plan.HookGroups {
+		hookGroups[i] = &pb.PlannedHookGroup{
+			Trigger: group.Trigger,
+			Weight:  int32(group.Weight),
+			Calls:   callsToPlannedHooks(group.Calls),
+			Awaited: callsToPlannedHooks(group.Awaited),
+			Tasks:   hookTasksToPlannedHooks(group.Tasks),
+		}
+	}
+	return &pb.GetTransitionPlanReply{
+		Id:           envId,
+		Transition:   plan.Transition,
+		CurrentState: plan.Src,
+		TargetState:  plan.Dst,
+		Steps:        plan.Steps,
+		HookGroups:   hookGroups,
+		Pending:      callsToPlannedHooks(plan.Pending),
+	}
+}
+
+// userVarsError converts the error returned by environment.ValidateUserVars into
+// an InvalidArgument status, which carries one field violation per invalid variable.
+func userVarsError(err error) error {
+	st := status.Newf(codes.InvalidArgument, "cannot create new environment: %s", err.Error())
+
+	var vErr *repos.VarSpecValidationError
+	if !errors.As(err, &vErr) {
+		return st.Err()
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range vErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Name,
+			Description: v.Reason,
+		})
+	}
+	if withDetails, detailsErr := st.WithDetails(badRequest); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
 func VarSpecMapToPbVarSpecMap(varSpecMap map[string]repos.VarSpec) map[string]*pb.VarSpecMessage {
 	ret := make(map[string]*pb.VarSpecMessage)
 	var vsm *pb.VarSpecMessage
@@ -205,6 +420,7 @@ func VarSpecMapToPbVarSpecMap(varSpecMap map[string]repos.VarSpec) map[string]*p
 			VisibleIf:     v.VisibleIf,
 			EnabledIf:     v.EnabledIf,
 			Rows:          v.Rows,
+			Required:      v.Required,
 		}
 		ret[k] = vsm
 	}
@@ -237,3 +453,21 @@ func convertVarTypeStringToEnum(varType string) pb.VarSpecMessage_Type {
 	}
 	return pb.VarSpecMessage_Type(msgType)
 }
+
+func lintFindingsToPb(findings []workflow.LintFinding) (pbFindings []*pb.LintFinding) {
+	pbFindings = make([]*pb.LintFinding, len(findings))
+	for i, finding := range findings {
+		severity := pb.LintFinding_ERROR
+		if finding.Severity == workflow.LINT_WARNING {
+			severity = pb.LintFinding_WARNING
+		}
+		pbFindings[i] = &pb.LintFinding{
+			Severity: severity,
+			Check:    finding.Check,
+			Template: finding.Template,
+			RolePath: finding.RolePath,
+			Message:  finding.Message,
+		}
+	}
+	return
+}
@@ -0,0 +1,132 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	pb "github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/workflow"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const plannedWorkflow = `
+name: plan-test
+defaults:
+  pfr_timeout: 10s
+roles:
+  - name: dcs
+    roles:
+      - name: pfr
+        call:
+          func: dcs.PrepareForRun()
+          trigger: before_CONFIGURE-10
+          await: after_CONFIGURE
+          timeout: "{{ pfr_timeout }}"
+          critical: false
+      - name: sor
+        call:
+          func: dcs.StartOfRun()
+          trigger: before_START_ACTIVITY+10
+          timeout: 5s
+          critical: true
+  - name: trg
+    roles:
+      - name: prepare
+        call:
+          func: trg.PrepareForRun()
+          trigger: before_CONFIGURE
+          timeout: 5s
+          critical: true
+      - name: runload
+        call:
+          func: trg.RunLoad()
+          trigger: before_START_ACTIVITY
+          timeout: 5s
+          critical: true
+  - name: odc
+    call:
+      func: odc.Configure()
+      trigger: before_CONFIGURE+10
+      timeout: 60s
+      critical: true
+`
+
+var _ = Describe("workflow template plan", func() {
+	var plannedRoot *pb.PlannedRole
+
+	BeforeEach(func() {
+		root, err := workflow.ParseTemplate([]byte(plannedWorkflow), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(root.ProcessTemplates(nil, nil, map[string]string{})).To(Succeed())
+
+		plannedRoot = workflowToPlannedRoleTree(root, nil)
+		Expect(plannedRoot).NotTo(BeNil())
+	})
+
+	It("mirrors the nested role tree", func() {
+		Expect(plannedRoot.GetFullPath()).To(Equal("plan-test"))
+		Expect(plannedRoot.GetRoles()).To(HaveLen(3))
+
+		dcs := plannedRoot.GetRoles()[0]
+		Expect(dcs.GetFullPath()).To(Equal("plan-test.dcs"))
+		Expect(dcs.GetCall()).To(BeNil())
+		Expect(dcs.GetRoles()).To(HaveLen(2))
+
+		pfr := dcs.GetRoles()[0]
+		Expect(pfr.GetFullPath()).To(Equal("plan-test.dcs.pfr"))
+		Expect(pfr.GetCall().GetFunc()).To(Equal("dcs.PrepareForRun()"))
+		Expect(pfr.GetTrigger()).To(Equal("before_CONFIGURE-10"))
+		Expect(pfr.GetAwait()).To(Equal("after_CONFIGURE"))
+		Expect(pfr.GetTimeout()).To(Equal("10s"))
+		Expect(pfr.GetCritical()).To(BeFalse())
+		Expect(pfr.GetConsolidatedStack()).To(HaveKeyWithValue("pfr_timeout", "10s"))
+
+		Expect(plannedRoot.GetRoles()[2].GetFullPath()).To(Equal("plan-test.odc"))
+		Expect(plannedRoot.GetRoles()[2].GetRoles()).To(BeEmpty())
+	})
+
+	It("orders hooks by trigger, weight and role path", func() {
+		triggers := plannedRoleTreeToPlannedTriggers(plannedRoot)
+		Expect(triggers).To(HaveLen(2))
+
+		Expect(triggers[0].GetTrigger()).To(Equal("before_CONFIGURE"))
+		hooks := triggers[0].GetHooks()
+		Expect(hooks).To(HaveLen(3))
+		Expect([]string{hooks[0].GetRolePath(), hooks[1].GetRolePath(), hooks[2].GetRolePath()}).
+			To(Equal([]string{"plan-test.dcs.pfr", "plan-test.trg.prepare", "plan-test.odc"}))
+		Expect([]int32{hooks[0].GetWeight(), hooks[1].GetWeight(), hooks[2].GetWeight()}).
+			To(Equal([]int32{-10, 0, 10}))
+		Expect(hooks[0].GetIsCall()).To(BeTrue())
+		Expect(hooks[0].GetName()).To(Equal("dcs.PrepareForRun()"))
+		Expect(hooks[0].GetAwait()).To(Equal("after_CONFIGURE"))
+
+		Expect(triggers[1].GetTrigger()).To(Equal("before_START_ACTIVITY"))
+		hooks = triggers[1].GetHooks()
+		Expect(hooks).To(HaveLen(2))
+		Expect(hooks[0].GetRolePath()).To(Equal("plan-test.trg.runload"))
+		Expect(hooks[0].GetWeight()).To(Equal(int32(0)))
+		Expect(hooks[1].GetRolePath()).To(Equal("plan-test.dcs.sor"))
+		Expect(hooks[1].GetWeight()).To(Equal(int32(10)))
+	})
+})
@@ -28,7 +28,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/AliceO2Group/Control/common/utils"
 	mesos "github.com/mesos/mesos-go/api/v1/lib"
 )
 
@@ -62,39 +61,13 @@ func (attrs Attributes) Satisfy(cts Constraints) (ok bool) {
 		log.Debug("no constraints to satisfy, defaulting to true")
 		return
 	}
-	if attrs == nil {
-		log.Debug("no attributes but non-null constraints, defaulting to false")
-		return
-	}
 
-	for _, constraint := range cts {
-		switch constraint.Operator {
-		case Equals:
-			var value string
-			if value, ok = attrs.Get(constraint.Attribute); ok {
-				if strings.Contains(value, ",") {
-					values := strings.Split(value, ",")
-					if utils.StringSliceContains(values, constraint.Value) {
-						ok = true
-						continue
-					}
-				}
-				if value == constraint.Value {
-					ok = true
-					continue
-				} else {
-					ok = false
-					break
-				}
-			} else { //at least 1 constraint not satisfiable, bailing out
-				log.WithField("constraint", constraint.Attribute).Warning("at least 1 constraint not satisfiable (cannot get attribute)")
-				ok = false
-				break
-			}
-		default:
-			log.WithField("constraint", constraint.Attribute).Warning("unsupported operator, skipping constraint")
-			continue
+	for i := range cts {
+		if !cts[i].SatisfiedBy(attrs) { // at least 1 constraint not satisfied, bailing out
+			log.WithField("constraint", cts[i].String()).
+				Trace("constraint not satisfied")
+			return false
 		}
 	}
-	return
+	return true
 }
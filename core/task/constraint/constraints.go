@@ -36,36 +36,42 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-/*
-	TODO:
-
-type Operator func(attribute string, value string) bool
-
-func Equals(attribute string, value string) bool {
-
-}
-*/
 var log = logger.New(logrus.StandardLogger(), "constraints")
 
+// Constraint is a predicate on a single agent attribute. The operator is optional in YAML
+// and defaults to Equals.
+// For In and NotIn, the value is a comma-separated list. For Like and Unlike, it is a regular
+// expression which must match the whole attribute value.
 type Constraint struct {
-	Attribute string `yaml:"attribute"`
-	Value     string `yaml:"value"`
-	// TODO: unmarshal this ↓
-	Operator Operator
+	Attribute string   `yaml:"attribute"`
+	Value     string   `yaml:"value,omitempty"`
+	Operator  Operator `yaml:"operator,omitempty"`
 }
 
-type Operator int8
-
-const (
-	Equals Operator = 0
-)
+func (c *Constraint) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _constraint Constraint
+	aux := _constraint{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+	*c = Constraint(aux)
+	return c.validate()
+}
 
-func (o Operator) String() string {
-	switch o {
-	case Equals:
-		return "EQUALS"
+// validate rejects Like and Unlike constraints whose value is not a valid regular expression.
+// Templated values are only checked once they are resolved, when the constraint is evaluated.
+func (c *Constraint) validate() error {
+	if c.Operator != Like && c.Operator != Unlike {
+		return nil
 	}
-	return ""
+	if strings.Contains(c.Value, "{{") {
+		return nil
+	}
+	if _, err := compilePattern(c.Value); err != nil {
+		return fmt.Errorf("invalid regular expression in constraint on attribute '%s': %w", c.Attribute, err)
+	}
+	return nil
 }
 
 func (c *Constraint) String() string {
@@ -88,22 +94,35 @@ func (cts Constraints) String() string {
 	return fmt.Sprintf("[%s]", strings.Join(strs, "; "))
 }
 
+// MergeParent returns the parent constraints overridden by cts. A child constraint on a given
+// attribute replaces all the parent constraints on the same attribute, so several constraints on
+// one attribute (e.g. a numeric range) are overridden as a whole.
 func (cts Constraints) MergeParent(parentConstraints Constraints) (merged Constraints) {
-	merged = make(Constraints, len(parentConstraints))
-	copy(merged, parentConstraints)
-
+	overridden := make(map[string]bool)
 	for _, ct := range cts {
-		updated := false
-		for j, pCt := range merged {
-			// If we find that both new and parent have a constraint for the same attribute,
-			// then the new one replaces the parent's constraint in the merged Constraints
+		overridden[ct.Attribute] = true
+	}
+
+	merged = make(Constraints, 0, len(parentConstraints)+len(cts))
+	emitted := make(map[string]bool)
+	for _, pCt := range parentConstraints {
+		if !overridden[pCt.Attribute] {
+			merged = append(merged, pCt)
+			continue
+		}
+		// The child constraints take the place of the first parent constraint on the same attribute
+		if emitted[pCt.Attribute] {
+			continue
+		}
+		emitted[pCt.Attribute] = true
+		for _, ct := range cts {
 			if ct.Attribute == pCt.Attribute {
-				merged[j] = ct
-				updated = true
-				break
+				merged = append(merged, ct)
 			}
 		}
-		if !updated {
+	}
+	for _, ct := range cts {
+		if !emitted[ct.Attribute] {
 			merged = append(merged, ct)
 		}
 	}
@@ -0,0 +1,180 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package constraint
+
+import (
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+func textAttributes(kv map[string]string) (attrs Attributes) {
+	attrs = make(Attributes, 0, len(kv))
+	for k, v := range kv {
+		attrs = append(attrs, mesos.Attribute{
+			Name: k,
+			Type: mesos.TEXT,
+			Text: &mesos.Value_Text{Value: v},
+		})
+	}
+	return
+}
+
+var _ = Describe("constraints", func() {
+	Describe("unmarshaling", func() {
+		It("should default to Equals", func() {
+			var cts Constraints
+			Expect(yaml.Unmarshal([]byte("- attribute: machine_id\n  value: flp001\n"), &cts)).To(Succeed())
+			Expect(cts).To(Equal(Constraints{{Attribute: "machine_id", Value: "flp001", Operator: Equals}}))
+		})
+		It("should accept operator names and symbols", func() {
+			var cts Constraints
+			doc := `
+- attribute: a
+  operator: not_equals
+  value: x
+- attribute: b
+  operator: "=~"
+  value: "flp0.*"
+- attribute: c
+  operator: notin
+  value: "x,y"
+- attribute: d
+  operator: ">="
+  value: "2"
+- attribute: e
+  operator: Exists
+`
+			Expect(yaml.Unmarshal([]byte(doc), &cts)).To(Succeed())
+			Expect(cts).To(HaveLen(5))
+			Expect(cts[0].Operator).To(Equal(NotEquals))
+			Expect(cts[1].Operator).To(Equal(Like))
+			Expect(cts[2].Operator).To(Equal(NotIn))
+			Expect(cts[3].Operator).To(Equal(GreaterThanOrEqual))
+			Expect(cts[4].Operator).To(Equal(Exists))
+		})
+		It("should reject unknown operators", func() {
+			var cts Constraints
+			Expect(yaml.Unmarshal([]byte("- attribute: a\n  operator: sometimes\n  value: x\n"), &cts)).NotTo(Succeed())
+		})
+		It("should reject invalid regular expressions, unless they are templated", func() {
+			var cts Constraints
+			Expect(yaml.Unmarshal([]byte("- attribute: a\n  operator: like\n  value: \"(\"\n"), &cts)).NotTo(Succeed())
+			Expect(yaml.Unmarshal([]byte("- attribute: a\n  operator: unlike\n  value: \"flp[\"\n"), &cts)).NotTo(Succeed())
+			Expect(yaml.Unmarshal([]byte("- attribute: a\n  operator: like\n  value: \"{{ pattern }}(\"\n"), &cts)).To(Succeed())
+			Expect(yaml.Unmarshal([]byte("- attribute: a\n  value: \"(\"\n"), &cts)).To(Succeed())
+		})
+		It("should marshal back to operator names", func() {
+			out, err := yaml.Marshal(Constraints{{Attribute: "a", Value: "x", Operator: Unlike}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("operator: UNLIKE"))
+		})
+	})
+
+	Describe("evaluation", func() {
+		attrs := textAttributes(map[string]string{
+			"machine_id": "flp001",
+			"roles":      "readout,qc",
+			"cores":      "64",
+		})
+
+		DescribeTable("SatisfiedBy",
+			func(ct Constraint, expected bool) {
+				Expect(ct.SatisfiedBy(attrs)).To(Equal(expected))
+			},
+			Entry("equals", Constraint{Attribute: "machine_id", Value: "flp001"}, true),
+			Entry("equals list element", Constraint{Attribute: "roles", Value: "qc"}, true),
+			Entry("equals missing attribute", Constraint{Attribute: "rack", Value: "r1"}, false),
+			Entry("not equals", Constraint{Attribute: "machine_id", Value: "flp002", Operator: NotEquals}, true),
+			Entry("not equals list element", Constraint{Attribute: "roles", Value: "qc", Operator: NotEquals}, false),
+			Entry("not equals missing attribute", Constraint{Attribute: "rack", Value: "r1", Operator: NotEquals}, true),
+			Entry("like", Constraint{Attribute: "machine_id", Value: "flp0[0-9]+", Operator: Like}, true),
+			Entry("like is anchored", Constraint{Attribute: "machine_id", Value: "flp", Operator: Like}, false),
+			Entry("unlike", Constraint{Attribute: "machine_id", Value: "epn.*", Operator: Unlike}, true),
+			Entry("invalid regex", Constraint{Attribute: "machine_id", Value: "(", Operator: Like}, false),
+			Entry("in", Constraint{Attribute: "machine_id", Value: "flp002, flp001", Operator: In}, true),
+			Entry("not in", Constraint{Attribute: "roles", Value: "qc,dcs", Operator: NotIn}, false),
+			Entry("less than", Constraint{Attribute: "cores", Value: "128", Operator: LessThan}, true),
+			Entry("less than or equal", Constraint{Attribute: "cores", Value: "64", Operator: LessThanOrEqual}, true),
+			Entry("greater than", Constraint{Attribute: "cores", Value: "64", Operator: GreaterThan}, false),
+			Entry("greater than or equal", Constraint{Attribute: "cores", Value: "32", Operator: GreaterThanOrEqual}, true),
+			Entry("numeric on non-numeric attribute", Constraint{Attribute: "machine_id", Value: "1", Operator: GreaterThan}, false),
+			Entry("exists", Constraint{Attribute: "cores", Operator: Exists}, true),
+			Entry("exists missing attribute", Constraint{Attribute: "rack", Operator: Exists}, false),
+			Entry("not exists", Constraint{Attribute: "rack", Operator: NotExists}, true),
+		)
+
+		It("should compile each pattern once", func() {
+			first, err := compilePattern("flp0[0-9]+")
+			Expect(err).NotTo(HaveOccurred())
+			second, err := compilePattern("flp0[0-9]+")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second).To(BeIdenticalTo(first))
+		})
+		It("should require all constraints to be satisfied", func() {
+			Expect(attrs.Satisfy(Constraints{
+				{Attribute: "machine_id", Value: "flp002"},
+				{Attribute: "cores", Operator: Exists},
+			})).To(BeFalse())
+			Expect(attrs.Satisfy(Constraints{
+				{Attribute: "machine_id", Value: "flp001"},
+				{Attribute: "cores", Value: "16", Operator: GreaterThan},
+			})).To(BeTrue())
+			Expect(attrs.Satisfy(nil)).To(BeTrue())
+		})
+	})
+
+	Describe("MergeParent", func() {
+		It("should override parent constraints on the same attribute", func() {
+			parent := Constraints{
+				{Attribute: "a", Value: "1"},
+				{Attribute: "b", Value: "2"},
+			}
+			child := Constraints{{Attribute: "a", Value: "3", Operator: NotEquals}}
+			Expect(child.MergeParent(parent)).To(Equal(Constraints{
+				{Attribute: "a", Value: "3", Operator: NotEquals},
+				{Attribute: "b", Value: "2"},
+			}))
+		})
+		It("should keep multiple child constraints on one attribute and drop all the parent ones", func() {
+			parent := Constraints{
+				{Attribute: "cores", Value: "8", Operator: GreaterThan},
+				{Attribute: "machine_id", Value: "epn.*", Operator: Unlike},
+				{Attribute: "cores", Value: "256", Operator: LessThan},
+			}
+			child := Constraints{
+				{Attribute: "cores", Value: "16", Operator: GreaterThanOrEqual},
+				{Attribute: "cores", Value: "64", Operator: LessThanOrEqual},
+				{Attribute: "rack", Operator: Exists},
+			}
+			Expect(child.MergeParent(parent)).To(Equal(Constraints{
+				{Attribute: "cores", Value: "16", Operator: GreaterThanOrEqual},
+				{Attribute: "cores", Value: "64", Operator: LessThanOrEqual},
+				{Attribute: "machine_id", Value: "epn.*", Operator: Unlike},
+				{Attribute: "rack", Operator: Exists},
+			}))
+		})
+	})
+})
@@ -0,0 +1,226 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package constraint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type Operator int8
+
+const (
+	Equals Operator = iota
+	NotEquals
+	Like
+	Unlike
+	In
+	NotIn
+	LessThan
+	LessThanOrEqual
+	GreaterThan
+	GreaterThanOrEqual
+	Exists
+	NotExists
+)
+
+var operatorNames = map[Operator]string{
+	Equals:             "EQUALS",
+	NotEquals:          "NOT_EQUALS",
+	Like:               "LIKE",
+	Unlike:             "UNLIKE",
+	In:                 "IN",
+	NotIn:              "NOT_IN",
+	LessThan:           "LT",
+	LessThanOrEqual:    "LE",
+	GreaterThan:        "GT",
+	GreaterThanOrEqual: "GE",
+	Exists:             "EXISTS",
+	NotExists:          "NOT_EXISTS",
+}
+
+// operatorAliases maps the symbolic forms accepted in YAML to operators, in addition to the
+// (case-insensitive) operator names.
+var operatorAliases = map[string]Operator{
+	"=":  Equals,
+	"==": Equals,
+	"!=": NotEquals,
+	"=~": Like,
+	"!~": Unlike,
+	"<":  LessThan,
+	"<=": LessThanOrEqual,
+	">":  GreaterThan,
+	">=": GreaterThanOrEqual,
+}
+
+func (o Operator) String() string {
+	if name, ok := operatorNames[o]; ok {
+		return name
+	}
+	return ""
+}
+
+func ParseOperator(str string) (Operator, error) {
+	str = strings.TrimSpace(str)
+	if op, ok := operatorAliases[str]; ok {
+		return op, nil
+	}
+	normalized := strings.ReplaceAll(strings.ToUpper(str), "-", "_")
+	for op, name := range operatorNames {
+		if normalized == name || normalized == strings.ReplaceAll(name, "_", "") {
+			return op, nil
+		}
+	}
+	return Equals, fmt.Errorf("unknown constraint operator '%s'", str)
+}
+
+func (o *Operator) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	var str string
+	err = unmarshal(&str)
+	if err != nil {
+		return
+	}
+	*o, err = ParseOperator(str)
+	return
+}
+
+func (o Operator) MarshalYAML() (interface{}, error) {
+	return o.String(), nil
+}
+
+// isNegation returns true for the operators which are satisfied by agents that do not have the
+// constrained attribute at all.
+func (o Operator) isNegation() bool {
+	return o == NotEquals || o == Unlike || o == NotIn || o == NotExists
+}
+
+// SatisfiedBy evaluates the constraint against a set of agent attributes.
+// An attribute value may be a comma-separated list, in which case Equals, Like and In are satisfied
+// if any of its elements matches. Numeric comparisons fail if either side is not a number.
+func (c *Constraint) SatisfiedBy(attrs Attributes) bool {
+	if c == nil {
+		return true
+	}
+	value, ok := attrs.Get(c.Attribute)
+	if !ok {
+		return c.Operator.isNegation()
+	}
+
+	values := []string{value}
+	if strings.Contains(value, ",") {
+		values = append(values, strings.Split(value, ",")...)
+	}
+
+	switch c.Operator {
+	case Equals:
+		return anyValue(values, func(v string) bool { return v == c.Value })
+	case NotEquals:
+		return !anyValue(values, func(v string) bool { return v == c.Value })
+	case Like, Unlike:
+		re, err := compilePattern(c.Value)
+		if err != nil {
+			log.WithField("constraint", c.Attribute).
+				WithError(err).
+				Warning("invalid regular expression in constraint, cannot be satisfied")
+			return false
+		}
+		return anyValue(values, re.MatchString) == (c.Operator == Like)
+	case In, NotIn:
+		wanted := strings.Split(c.Value, ",")
+		for i := range wanted {
+			wanted[i] = strings.TrimSpace(wanted[i])
+		}
+		matches := anyValue(values, func(v string) bool {
+			for _, w := range wanted {
+				if v == w {
+					return true
+				}
+			}
+			return false
+		})
+		return matches == (c.Operator == In)
+	case LessThan, LessThanOrEqual, GreaterThan, GreaterThanOrEqual:
+		attrNum, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return false
+		}
+		ctNum, err := strconv.ParseFloat(strings.TrimSpace(c.Value), 64)
+		if err != nil {
+			log.WithField("constraint", c.Attribute).
+				WithError(err).
+				Warning("non-numeric value in numeric constraint, cannot be satisfied")
+			return false
+		}
+		switch c.Operator {
+		case LessThan:
+			return attrNum < ctNum
+		case LessThanOrEqual:
+			return attrNum <= ctNum
+		case GreaterThan:
+			return attrNum > ctNum
+		default:
+			return attrNum >= ctNum
+		}
+	case Exists:
+		return true
+	case NotExists:
+		return false
+	}
+	log.WithField("constraint", c.Attribute).Warning("unsupported operator, constraint cannot be satisfied")
+	return false
+}
+
+// patterns caches the compiled Like and Unlike patterns by constraint value, since constraints
+// are evaluated for every offer and descriptor. Values are only known once the workflow
+// templates are resolved, so they cannot all be compiled when the constraints are parsed.
+var patterns sync.Map // string -> compiledPattern
+
+type compiledPattern struct {
+	re  *regexp.Regexp
+	err error
+}
+
+// compilePattern returns the regular expression which matches whole attribute values against
+// pattern.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := patterns.Load(pattern); ok {
+		compiled := cached.(compiledPattern)
+		return compiled.re, compiled.err
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	patterns.Store(pattern, compiledPattern{re: re, err: err})
+	return re, err
+}
+
+func anyValue(values []string, pred func(string) bool) bool {
+	for _, v := range values {
+		if pred(v) {
+			return true
+		}
+	}
+	return false
+}
@@ -52,11 +52,12 @@ func (tm *TaskmanMessage) GetMessageType() taskop.MessageType {
 }
 
 type environmentMessage struct {
-	envId       uid.ID
-	tasks       Tasks
-	descriptors Descriptors
-	runNumber   string
-	errSt       string
+	envId          uid.ID
+	tasks          Tasks
+	descriptors    Descriptors
+	runNumber      string
+	errSt          string
+	skipConfigured bool
 }
 
 func (em *environmentMessage) GetEnvironmentId() (envid uid.ID) {
@@ -94,6 +95,13 @@ func (em *environmentMessage) GetError() string {
 	return em.errSt
 }
 
+func (em *environmentMessage) GetSkipConfigured() bool {
+	if em == nil {
+		return false
+	}
+	return em.skipConfigured
+}
+
 func NewEnvironmentMessage(mt taskop.MessageType, envId uid.ID, tasks Tasks, desc Descriptors) (t *TaskmanMessage) {
 	t = newTaskmanMessage(mt)
 	t.environmentMessage = environmentMessage{
@@ -104,6 +112,15 @@ func NewEnvironmentMessage(mt taskop.MessageType, envId uid.ID, tasks Tasks, des
 	return t
 }
 
+// NewConfigureAddedTasksMessage builds a ConfigureTasks message for an environment in which some
+// tasks are already CONFIGURED: those only contribute their inbound channels, and only the other
+// tasks are pushed a CONFIGURE.
+func NewConfigureAddedTasksMessage(envId uid.ID, tasks Tasks) (t *TaskmanMessage) {
+	t = NewEnvironmentMessage(taskop.ConfigureTasks, envId, tasks, nil)
+	t.environmentMessage.skipConfigured = true
+	return t
+}
+
 type transitionTasksMessage struct {
 	src        string
 	event      string
@@ -108,6 +108,8 @@ type Manager struct {
 
 	k8sClient *k8sclient.Client
 	k8sEnvs   *k8sEnvRegistry
+
+	restarts *restartRegistry
 }
 
 func NewManager(shutdown func(), internalEventCh chan<- event.Event) (taskman *Manager, err error) {
@@ -150,6 +152,7 @@ func NewManager(shutdown func(), internalEventCh chan<- event.Event) (taskman *M
 	taskman.reviveOffersTrg = taskman.schedulerState.reviveOffersTrg
 	taskman.ackKilledTasks = safeacks.NewAcks()
 	taskman.k8sEnvs = newK8sEnvRegistry()
+	taskman.restarts = newRestartRegistry()
 	if k8sClient, k8sErr := newK8sClientFromViper(); k8sErr != nil {
 		log.WithField("level", infologger.IL_Devel).WithError(k8sErr).Warn("K8s client init failed, K8s tasks disabled")
 	} else {
@@ -743,6 +746,7 @@ func (m *Manager) releaseTasks(envId uid.ID, tasks Tasks) {
 	taskIdsReleased := make([]string, 0)
 
 	for _, task := range tasks {
+		m.restarts.forget(envId, task.GetParentRolePath(), task.GetTaskId())
 		err := m.releaseTask(envId, task)
 		if err == nil {
 			taskIdsReleased = append(taskIdsReleased, task.GetTaskId())
@@ -772,10 +776,16 @@ func (m *Manager) releaseTask(envId uid.ID, task *Task) error {
 	return nil
 }
 
-func (m *Manager) configureTasks(envId uid.ID, tasks Tasks) error {
+// configureTasks pushes CONFIGURE to tasks. If skipConfigured is set, the tasks which are already
+// CONFIGURED or RUNNING (e.g. when new roles are added to a live environment, or when a task is
+// restarted) only contribute their inbound channels to the bindMap, they don't get pushed a CONFIGURE.
+func (m *Manager) configureTasks(envId uid.ID, tasks Tasks, skipConfigured bool) error {
 	var k8sTasks Tasks
 	var mesosTasks Tasks
 	for _, t := range tasks {
+		if skipConfigured && (t.GetState() == sm.CONFIGURED || t.GetState() == sm.RUNNING) {
+			continue
+		}
 		if t.GetControlMode() == controlmode.KUBERNETES_DIRECT ||
 			t.GetControlMode() == controlmode.KUBERNETES_FAIRMQ {
 			k8sTasks = append(k8sTasks, t)
@@ -1039,6 +1049,40 @@ func (m *Manager) TriggerHooks(envId uid.ID, tasks Tasks) error {
 	return nil
 }
 
+// GetTaskLogs fetches the output of a task launched with stdout or stderr set to "file" from its
+// executor. If lines is greater than 0, only the last lines are returned.
+func (m *Manager) GetTaskLogs(taskId string, lines int) (logs string, truncated bool, err error) {
+	t := m.GetTask(taskId)
+	if t == nil {
+		return "", false, fmt.Errorf("task %s not found", taskId)
+	}
+	if len(t.GetAgentId()) == 0 || len(t.GetExecutorId()) == 0 {
+		return "", false, fmt.Errorf("task %s has not been launched", taskId)
+	}
+
+	notify := make(chan controlcommands.MesosCommandResponse)
+	cmd := controlcommands.NewMesosCommand_GetTaskLogs(t.GetMesosCommandTarget(), lines)
+	err = m.cq.Enqueue(cmd, notify)
+	if err != nil {
+		return "", false, err
+	}
+
+	response := <-notify
+	close(notify)
+
+	if response == nil {
+		return "", false, errors.New("unknown MesosCommand error: nil response received")
+	}
+	if respError := response.Err(); respError != nil {
+		return "", false, respError
+	}
+	logsResponse, ok := response.(*controlcommands.MesosCommandResponse_GetTaskLogs)
+	if !ok {
+		return "", false, fmt.Errorf("unexpected response %s to GetTaskLogs", response.GetCommandName())
+	}
+	return logsResponse.Logs, logsResponse.Truncated, nil
+}
+
 func (m *Manager) GetTaskClass(name string) (b *taskclass.Class) {
 	if m == nil {
 		return
@@ -1346,7 +1390,7 @@ func (m *Manager) handleMessage(tm *TaskmanMessage) error {
 		}()
 	case taskop.ConfigureTasks:
 		go func() {
-			err := m.configureTasks(tm.GetEnvironmentId(), tm.GetTasks())
+			err := m.configureTasks(tm.GetEnvironmentId(), tm.GetTasks(), tm.GetSkipConfigured())
 			m.internalEventCh <- event.NewTasksStateChangedEvent(tm.GetEnvironmentId(), tm.GetTasks().GetTaskIds(), err)
 		}()
 	case taskop.TransitionTasks:
@@ -1389,13 +1433,16 @@ func (m *Manager) handleMessage(tm *TaskmanMessage) error {
 			t := m.GetTask(taskIDValue)
 			if t != nil && t.IsLocked() {
 				go m.updateTaskState(taskIDValue, "ERROR")
+				go m.requestTaskRestart(t)
 			}
 		}
 
 		// This will check if the task update is from a reconciliation, as well as whether the task
 		// is in a state in which a mesos Kill call is possible.
-		// Reconcilation tasks are not part of the taskman.roster
+		// Reconcilation tasks are not part of the taskman.roster, unless they were
+		// adopted from a persisted environment on startup.
 		if mesosStatus.GetReason().String() == "REASON_RECONCILIATION" &&
+			m.GetTask(mesosStatus.GetTaskID().Value) == nil &&
 			(mesosState == mesos.TASK_STAGING ||
 				mesosState == mesos.TASK_STARTING ||
 				mesosState == mesos.TASK_RUNNING ||
@@ -1435,7 +1482,7 @@ func (m *Manager) handleMessage(tm *TaskmanMessage) error {
 			WithField("level", infologger.IL_Devel).
 			WithField("status", tm.status.String()).
 			WithField("source", tm.status.GetSource().String()).
-			Warn("taskman received error: %s", tm.GetError())
+			Warnf("taskman received error: %s", tm.GetError())
 	}
 
 	return nil
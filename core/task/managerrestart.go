@@ -0,0 +1,310 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+)
+
+const (
+	restartActivationTimeout = 60 * time.Second
+	restartStateTimeout      = 10 * time.Second
+)
+
+// restartRegistry counts the restart attempts of each task role, per environment, and keeps
+// track of the dead tasks for which a restart was already requested.
+type restartRegistry struct {
+	mu        sync.Mutex
+	attempts  map[uid.ID]map[string]int
+	requested map[string]struct{}
+}
+
+func newRestartRegistry() *restartRegistry {
+	return &restartRegistry{
+		attempts:  make(map[uid.ID]map[string]int),
+		requested: make(map[string]struct{}),
+	}
+}
+
+// next increments and returns the attempt count for the role of the given dead task, unless
+// maxRetries was already reached or a restart was already requested for this task, in which
+// case ok is false.
+func (r *restartRegistry) next(envId uid.ID, rolePath string, taskId string, maxRetries int) (attempt int, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, alreadyRequested := r.requested[taskId]; alreadyRequested {
+		return 0, false
+	}
+
+	envAttempts, exists := r.attempts[envId]
+	if !exists {
+		envAttempts = make(map[string]int)
+		r.attempts[envId] = envAttempts
+	}
+	if envAttempts[rolePath] >= maxRetries {
+		return envAttempts[rolePath], false
+	}
+	envAttempts[rolePath]++
+	r.requested[taskId] = struct{}{}
+	return envAttempts[rolePath], true
+}
+
+// done clears the restart request of the given dead task, so that a later death of a task with
+// the same ID can be handled again.
+func (r *restartRegistry) done(taskId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.requested, taskId)
+}
+
+// forget resets the attempt count of a role whose task is released from its environment.
+func (r *restartRegistry) forget(envId uid.ID, rolePath string, taskId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.requested, taskId)
+
+	envAttempts, exists := r.attempts[envId]
+	if !exists {
+		return
+	}
+	delete(envAttempts, rolePath)
+	if len(envAttempts) == 0 {
+		delete(r.attempts, envId)
+	}
+}
+
+type descriptorGenerator interface {
+	GenerateTaskDescriptors() Descriptors
+}
+
+// getRestartPolicy returns the restart policy which applies to t, or nil if t should not be
+// restarted when it dies.
+// Critical tasks are never restarted, since their death brings the whole environment down, and
+// neither are hooks and tasks controlled through Kubernetes.
+func getRestartPolicy(t *Task) *taskclass.RestartPolicy {
+	if t == nil || !t.IsLocked() {
+		return nil
+	}
+	class := t.GetTaskClass()
+	if class == nil || class.Restart == nil || class.Restart.MaxRetries == 0 {
+		return nil
+	}
+	traits := t.GetTraits()
+	if traits.Critical || len(traits.Trigger) > 0 {
+		return nil
+	}
+	switch t.GetControlMode() {
+	case controlmode.HOOK, controlmode.KUBERNETES_DIRECT, controlmode.KUBERNETES_FAIRMQ:
+		return nil
+	}
+	return class.Restart
+}
+
+// requestTaskRestart checks the restart policy of a task which just died and, if another attempt
+// is allowed, notifies the environment manager, which decides whether the restart should happen
+// based on the state of the environment.
+func (m *Manager) requestTaskRestart(t *Task) {
+	policy := getRestartPolicy(t)
+	if policy == nil {
+		return
+	}
+
+	parent := t.GetParent()
+	if parent == nil {
+		return
+	}
+	envId := parent.GetEnvironmentId()
+	rolePath := parent.GetPath()
+	attempt, ok := m.restarts.next(envId, rolePath, t.GetTaskId(), policy.MaxRetries)
+	if !ok {
+		if attempt > 0 {
+			log.WithField("partition", envId.String()).
+				WithField("role", rolePath).
+				WithField("taskHost", t.GetHostname()).
+				WithField(infologger.Level, infologger.IL_Ops).
+				Errorf("task '%s' died and will not be restarted: restart policy exhausted after %d attempts", parent.GetName(), attempt)
+		}
+		return
+	}
+
+	delay := policy.GetBackoff(attempt)
+	log.WithField("partition", envId.String()).
+		WithField("role", rolePath).
+		WithField("taskHost", t.GetHostname()).
+		WithField(infologger.Level, infologger.IL_Ops).
+		Warnf("task '%s' died, restart attempt %d/%d in %s", parent.GetName(), attempt, policy.MaxRetries, delay.String())
+
+	m.internalEventCh <- event.NewTaskRestartRequestedEvent(envId, t.GetTaskId(), attempt, delay, policy.TargetState.String())
+}
+
+// RestartTask replaces a dead task of the given environment with a new one deployed on the same
+// host, and drives it to targetState. Only STANDBY, CONFIGURED and RUNNING are valid target states,
+// args are passed to the START transition.
+// The caller is responsible for making sure the environment is not transitioning in the meantime.
+func (m *Manager) RestartTask(envId uid.ID, taskId string, targetState sm.State, args controlcommands.PropertyMap) (err error) {
+	defer m.restarts.done(taskId)
+
+	switch targetState {
+	case sm.STANDBY, sm.CONFIGURED, sm.RUNNING:
+	default:
+		return fmt.Errorf("cannot restart task %s: invalid target state %s", taskId, targetState.String())
+	}
+
+	oldTask := m.GetTask(taskId)
+	if oldTask == nil {
+		return fmt.Errorf("cannot restart task %s: task not found", taskId)
+	}
+	if !oldTask.IsLocked() || oldTask.GetEnvironmentId() != envId {
+		return fmt.Errorf("cannot restart task %s: task is not part of environment %s", taskId, envId.String())
+	}
+	// The death of the task is reported by Mesos before its status is updated
+	if !waitForTask(func() bool { return oldTask.GetStatus() != ACTIVE }, restartStateTimeout) {
+		return fmt.Errorf("cannot restart task %s: task is still active", taskId)
+	}
+
+	parent := oldTask.GetParent()
+	generator, ok := parent.(descriptorGenerator)
+	if !ok {
+		return fmt.Errorf("cannot restart task %s: parent role %s cannot generate task descriptors", taskId, parent.GetPath())
+	}
+	rolePath := parent.GetPath()
+
+	machineId := ""
+	if agentInfo := m.AgentCache.Get(mesos.AgentID{Value: oldTask.GetAgentId()}); agentInfo != nil {
+		machineId, _ = agentInfo.Attributes.Get("machine_id")
+	}
+	if len(machineId) == 0 {
+		return fmt.Errorf("cannot restart task %s: machine_id of host %s unknown", taskId, oldTask.GetHostname())
+	}
+
+	// The dead task is detached from its role and dropped from the roster, so that the role
+	// generates a descriptor for a new task.
+	parent.SetTask(nil)
+	oldTask.SetParent(nil)
+	m.roster.updateTasks(m.roster.filtered(func(t *Task) bool {
+		return t.taskId != oldTask.taskId
+	}))
+
+	descriptors := generator.GenerateTaskDescriptors()
+	if len(descriptors) != 1 {
+		return fmt.Errorf("cannot restart task %s: expected 1 task descriptor for role %s, got %d", taskId, rolePath, len(descriptors))
+	}
+	descriptor := descriptors[0]
+	pinnedConstraints := make(constraint.Constraints, 0, len(descriptor.RoleConstraints)+1)
+	for _, c := range descriptor.RoleConstraints {
+		if c.Attribute != "machine_id" {
+			pinnedConstraints = append(pinnedConstraints, c)
+		}
+	}
+	descriptor.RoleConstraints = append(pinnedConstraints, constraint.Constraint{
+		Attribute: "machine_id",
+		Operator:  constraint.Equals,
+		Value:     machineId,
+	})
+
+	err = m.acquireTasks(envId, descriptors)
+	if err != nil {
+		return fmt.Errorf("cannot redeploy task for role %s: %w", rolePath, err)
+	}
+
+	newTasks := m.roster.filtered(func(t *Task) bool {
+		return t.IsLocked() && t.GetEnvironmentId() == envId && t.GetParentRolePath() == rolePath
+	})
+	if len(newTasks) == 0 {
+		return fmt.Errorf("cannot redeploy task for role %s on host %s: no suitable offer", rolePath, oldTask.GetHostname())
+	}
+	newTask := newTasks[0]
+
+	if !waitForTask(func() bool { return newTask.GetStatus() == ACTIVE }, restartActivationTimeout) {
+		return fmt.Errorf("task for role %s did not become active within %s", rolePath, restartActivationTimeout.String())
+	}
+
+	if targetState == sm.STANDBY {
+		return nil
+	}
+
+	// All the tasks of the environment contribute their inbound channels, but only the new one
+	// is pushed a CONFIGURE.
+	envTasks := m.roster.filtered(func(t *Task) bool {
+		return t.IsLocked() && t.GetEnvironmentId() == envId
+	})
+	err = m.configureTasks(envId, envTasks.Filtered(func(t *Task) bool {
+		return t == newTask || t.GetState() == sm.CONFIGURED || t.GetState() == sm.RUNNING
+	}), true)
+	if err != nil {
+		return fmt.Errorf("cannot configure restarted task for role %s: %w", rolePath, err)
+	}
+	// Failures of non-critical tasks are not reported as errors by configureTasks and transitionTasks,
+	// so we check the state of the new task, which is updated asynchronously.
+	if !waitForTask(func() bool { return newTask.GetState() == sm.CONFIGURED }, restartStateTimeout) {
+		return fmt.Errorf("restarted task for role %s is %s instead of CONFIGURED", rolePath, newTask.GetState().String())
+	}
+
+	if targetState == sm.RUNNING {
+		err = m.transitionTasks(envId, Tasks{newTask}, sm.CONFIGURED.String(), sm.START.String(), sm.RUNNING.String(), args)
+		if err != nil {
+			return fmt.Errorf("cannot start restarted task for role %s: %w", rolePath, err)
+		}
+		if !waitForTask(func() bool { return newTask.GetState() == sm.RUNNING }, restartStateTimeout) {
+			return fmt.Errorf("restarted task for role %s is %s instead of RUNNING", rolePath, newTask.GetState().String())
+		}
+	}
+	return nil
+}
+
+// RestartRequestHandled releases the restart request of a dead task once its handling is over,
+// whether or not the task was restarted.
+func (m *Manager) RestartRequestHandled(taskId string) {
+	m.restarts.done(taskId)
+}
+
+// waitForTask polls condition until it is true or timeout expires.
+func waitForTask(condition func() bool, timeout time.Duration) bool {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for !condition() {
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			return condition()
+		}
+	}
+	return true
+}
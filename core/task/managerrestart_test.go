@@ -0,0 +1,133 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"time"
+
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe("task restart", func() {
+	Describe("restart policy", func() {
+		It("should apply defaults to an empty policy", func() {
+			var class taskclass.Class
+			err := yaml.Unmarshal([]byte("name: qc\nrestart: {}\n"), &class)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(class.Restart).NotTo(BeNil())
+			Expect(class.Restart.MaxRetries).To(Equal(taskclass.DEFAULT_RESTART_MAX_RETRIES))
+			Expect(class.Restart.Backoff).To(Equal(taskclass.DEFAULT_RESTART_BACKOFF))
+			Expect(class.Restart.TargetState).To(Equal(sm.RUNNING))
+		})
+		It("should parse all fields", func() {
+			var class taskclass.Class
+			err := yaml.Unmarshal([]byte("name: qc\nrestart:\n  maxRetries: 5\n  backoff: 2s\n  maxBackoff: 10s\n  targetState: CONFIGURED\n"), &class)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(class.Restart.MaxRetries).To(Equal(5))
+			Expect(class.Restart.Backoff).To(Equal(2 * time.Second))
+			Expect(class.Restart.MaxBackoff).To(Equal(10 * time.Second))
+			Expect(class.Restart.TargetState).To(Equal(sm.CONFIGURED))
+		})
+		It("should not set a policy if none is declared", func() {
+			var class taskclass.Class
+			err := yaml.Unmarshal([]byte("name: qc\n"), &class)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(class.Restart).To(BeNil())
+		})
+		It("should reject invalid target states and durations", func() {
+			var class taskclass.Class
+			Expect(yaml.Unmarshal([]byte("name: qc\nrestart:\n  targetState: ERROR\n"), &class)).To(HaveOccurred())
+			Expect(yaml.Unmarshal([]byte("name: qc\nrestart:\n  backoff: soon\n"), &class)).To(HaveOccurred())
+			Expect(yaml.Unmarshal([]byte("name: qc\nrestart:\n  maxRetries: -1\n"), &class)).To(HaveOccurred())
+		})
+		It("should double the backoff at each attempt up to the maximum", func() {
+			policy := taskclass.RestartPolicy{MaxRetries: 5, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+			Expect(policy.GetBackoff(1)).To(Equal(time.Second))
+			Expect(policy.GetBackoff(2)).To(Equal(2 * time.Second))
+			Expect(policy.GetBackoff(3)).To(Equal(4 * time.Second))
+			Expect(policy.GetBackoff(4)).To(Equal(5 * time.Second))
+			Expect(policy.GetBackoff(10)).To(Equal(5 * time.Second))
+		})
+	})
+
+	Describe("restart registry", func() {
+		var (
+			registry *restartRegistry
+			envId    uid.ID
+		)
+		BeforeEach(func() {
+			registry = newRestartRegistry()
+			envId = uid.New()
+		})
+
+		It("should count attempts per role up to the maximum", func() {
+			attempt, ok := registry.next(envId, "root.qc", "task1", 2)
+			Expect(ok).To(BeTrue())
+			Expect(attempt).To(Equal(1))
+			registry.done("task1")
+
+			attempt, ok = registry.next(envId, "root.qc", "task2", 2)
+			Expect(ok).To(BeTrue())
+			Expect(attempt).To(Equal(2))
+			registry.done("task2")
+
+			attempt, ok = registry.next(envId, "root.qc", "task3", 2)
+			Expect(ok).To(BeFalse())
+			Expect(attempt).To(Equal(2))
+
+			_, ok = registry.next(envId, "root.other", "task4", 2)
+			Expect(ok).To(BeTrue())
+		})
+		It("should request a single restart per dead task", func() {
+			_, ok := registry.next(envId, "root.qc", "task1", 3)
+			Expect(ok).To(BeTrue())
+			_, ok = registry.next(envId, "root.qc", "task1", 3)
+			Expect(ok).To(BeFalse())
+		})
+		It("should reset the count when the task is released", func() {
+			_, ok := registry.next(envId, "root.qc", "task1", 1)
+			Expect(ok).To(BeTrue())
+			registry.forget(envId, "root.qc", "task1")
+			attempt, ok := registry.next(envId, "root.qc", "task2", 1)
+			Expect(ok).To(BeTrue())
+			Expect(attempt).To(Equal(1))
+		})
+		It("should release the request when a restart fails early", func() {
+			m := &Manager{roster: newRoster(), restarts: registry}
+			_, ok := registry.next(envId, "root.qc", "task1", 3)
+			Expect(ok).To(BeTrue())
+
+			Expect(m.RestartTask(envId, "task1", sm.CONFIGURED, nil)).To(HaveOccurred())
+
+			attempt, ok := registry.next(envId, "root.qc", "task1", 3)
+			Expect(ok).To(BeTrue())
+			Expect(attempt).To(Equal(2))
+		})
+	})
+})
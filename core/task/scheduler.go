@@ -387,6 +387,29 @@ func (state *schedulerState) incomingMessageHandler() events.HandlerFunc {
 					TaskId:     mesos.TaskID{Value: res.TaskId},
 				}
 
+				go func() {
+					state.servent.ProcessResponse(&res, sender)
+				}()
+				return
+			case "MesosCommand_GetTaskLogs":
+				var res controlcommands.MesosCommandResponse_GetTaskLogs
+				err = json.Unmarshal(data, &res)
+				if err != nil {
+					log.WithPrefix("scheduler").WithFields(logrus.Fields{
+						"commandName": incomingCommand.CommandName,
+						"agentId":     agentId.GetValue(),
+						"executorId":  executorId.GetValue(),
+						"error":       err.Error(),
+					}).
+						Error("cannot unmarshal incoming MESSAGE")
+					return
+				}
+				sender := controlcommands.MesosCommandTarget{
+					AgentId:    agentId,
+					ExecutorId: executorId,
+					TaskId:     mesos.TaskID{Value: res.TaskId},
+				}
+
 				go func() {
 					state.servent.ProcessResponse(&res, sender)
 				}()
@@ -577,7 +600,7 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 				descriptor := descriptorsStillToDeploy[i]
 				requiredMachineId := ""
 				for _, descriptorConstraint := range descriptorConstraints[descriptor] {
-					if descriptorConstraint.Attribute == "machine_id" {
+					if descriptorConstraint.Attribute == "machine_id" && descriptorConstraint.Operator == constraint.Equals {
 						requiredMachineId = descriptorConstraint.Value
 						break
 					}
@@ -1579,6 +1602,12 @@ func makeTaskForMesosResources(
 				Value: proto.String(ldLibPath),
 			})
 	}
+	// The executor puts each task into its own cgroup under this path, to enforce the task class limits
+	mesosTaskInfo.Executor.Command.Environment.Variables = append(mesosTaskInfo.Executor.Command.Environment.Variables,
+		mesos.Environment_Variable{
+			Name:  "O2_EXECUTOR_CGROUP_ROOT",
+			Value: proto.String(viper.GetString("executorCgroupRoot")),
+		})
 
 	return taskPtr, &mesosTaskInfo
 }
@@ -0,0 +1,158 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/common/gera"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+)
+
+// Snapshot is a serializable copy of the Mesos-side identity of a Task, as
+// well as the state it was last known to be in.
+// It is meant to be persisted, and used by AdoptTask to rebuild a Task which
+// is still running after a core restart.
+type Snapshot struct {
+	TaskId       string                      `json:"taskId"`
+	Name         string                      `json:"name"`
+	ClassName    string                      `json:"className"`
+	RolePath     string                      `json:"rolePath"`
+	Hostname     string                      `json:"hostname"`
+	AgentId      string                      `json:"agentId"`
+	OfferId      string                      `json:"offerId"`
+	ExecutorId   string                      `json:"executorId"`
+	State        string                      `json:"state"`
+	ControlPort  uint64                      `json:"controlPort,omitempty"`
+	LocalBindMap map[string]SnapshotEndpoint `json:"localBindMap,omitempty"`
+}
+
+// SnapshotEndpoint holds exactly one of the concrete channel.Endpoint types.
+type SnapshotEndpoint struct {
+	Tcp *channel.TcpEndpoint `json:"tcp,omitempty"`
+	Ipc *channel.IpcEndpoint `json:"ipc,omitempty"`
+}
+
+func (e SnapshotEndpoint) toEndpoint() channel.Endpoint {
+	switch {
+	case e.Tcp != nil:
+		return *e.Tcp
+	case e.Ipc != nil:
+		return *e.Ipc
+	}
+	return nil
+}
+
+// Snapshot returns a copy of the data needed to adopt t again after a core restart.
+func (t *Task) Snapshot() Snapshot {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	s := Snapshot{
+		TaskId:       t.taskId,
+		Name:         t.name,
+		ClassName:    t.className,
+		Hostname:     t.hostname,
+		AgentId:      t.agentId,
+		OfferId:      t.offerId,
+		ExecutorId:   t.executorId,
+		State:        t.state.String(),
+		LocalBindMap: make(map[string]SnapshotEndpoint, len(t.localBindMap)),
+	}
+	if t.parent != nil {
+		s.RolePath = t.parent.GetPath()
+	}
+	if t.commandInfo != nil {
+		s.ControlPort = t.commandInfo.ControlPort
+	}
+	for k, v := range t.localBindMap {
+		switch endpoint := v.(type) {
+		case channel.TcpEndpoint:
+			s.LocalBindMap[k] = SnapshotEndpoint{Tcp: &endpoint}
+		case channel.IpcEndpoint:
+			s.LocalBindMap[k] = SnapshotEndpoint{Ipc: &endpoint}
+		}
+	}
+	return s
+}
+
+// AdoptTask rebuilds a Task from a Snapshot, attaches it to role and adds it
+// to the roster, without going through the Mesos offer cycle.
+// The adopted task is INACTIVE until Mesos reconciliation reports it as
+// running, at which point it becomes ACTIVE as any newly launched task would.
+// role must be the workflow role that owns the task, i.e. the TaskRole of the
+// Descriptor the task was originally deployed for.
+func (m *Manager) AdoptTask(s Snapshot, role interface{}) (*Task, error) {
+	parent, ok := role.(parentRole)
+	if !ok || parent == nil {
+		return nil, fmt.Errorf("cannot adopt task %s: invalid parent role", s.TaskId)
+	}
+	if len(s.TaskId) == 0 || len(s.AgentId) == 0 || len(s.ExecutorId) == 0 {
+		return nil, fmt.Errorf("cannot adopt task %s: incomplete snapshot", s.TaskId)
+	}
+	if existing := m.GetTask(s.TaskId); existing != nil {
+		return nil, fmt.Errorf("cannot adopt task %s: already in roster", s.TaskId)
+	}
+	class := m.GetTaskClass(s.ClassName)
+	if class == nil {
+		return nil, fmt.Errorf("cannot adopt task %s: unknown task class %s", s.TaskId, s.ClassName)
+	}
+
+	t := &Task{
+		name:         s.Name,
+		parent:       parent,
+		className:    s.ClassName,
+		hostname:     s.Hostname,
+		agentId:      s.AgentId,
+		offerId:      s.OfferId,
+		taskId:       s.TaskId,
+		properties:   gera.MakeMap[string, string]().Wrap(class.Properties),
+		executorId:   s.ExecutorId,
+		localBindMap: make(channel.BindMap),
+		state:        sm.StateFromString(s.State),
+		status:       INACTIVE,
+	}
+	t.GetTaskClass = func() *taskclass.Class {
+		return m.GetTaskClass(t.className)
+	}
+	for k, v := range s.LocalBindMap {
+		if endpoint := v.toEndpoint(); endpoint != nil {
+			t.localBindMap[k] = endpoint
+		}
+	}
+
+	if err := t.BuildTaskCommand(parent); err != nil {
+		return nil, fmt.Errorf("cannot adopt task %s: %w", s.TaskId, err)
+	}
+	if t.commandInfo != nil {
+		t.commandInfo.ControlPort = s.ControlPort
+	}
+
+	m.roster.append(t)
+	parent.SetTask(t)
+	parent.UpdateState(t.state)
+	return t, nil
+}
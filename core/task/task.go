@@ -84,6 +84,12 @@ type Traits struct {
 	Await    string
 	Timeout  string
 	Critical bool
+
+	// Retry policy for call roles, kept as strings so that they can be
+	// templated like Timeout
+	Retries      string
+	RetryBackoff string
+	RetryOn      string // comma-separated gRPC status codes
 }
 
 /*
@@ -158,6 +164,13 @@ func (t *Task) GetState() sm.State {
 	return t.state
 }
 
+func (t *Task) GetStatus() Status {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.status
+}
+
 func (t *Task) GetParentRole() interface{} {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -422,9 +435,9 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 
 		if cmd.Stdout != nil {
 			*cmd.Stdout = strings.TrimSpace(strings.ToLower(*cmd.Stdout))
-			if !utils.StringSliceContains([]string{"stdout", "all", "none"}, *cmd.Stdout) {
+			if !utils.StringSliceContains([]string{"stdout", "all", "file", "none"}, *cmd.Stdout) {
 				err = fmt.Errorf("bad stdout forwarding expression %s, allowed values: %s",
-					*cmd.Stdout, "none, stdout, all")
+					*cmd.Stdout, "none, stdout, all, file")
 				t.commandInfo = &common.TaskCommandInfo{}
 				return
 			}
@@ -435,9 +448,9 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 
 		if cmd.Stderr != nil {
 			*cmd.Stderr = strings.TrimSpace(strings.ToLower(*cmd.Stderr))
-			if !utils.StringSliceContains([]string{"stdout", "all", "none"}, *cmd.Stderr) {
+			if !utils.StringSliceContains([]string{"stdout", "all", "file", "none"}, *cmd.Stderr) {
 				err = fmt.Errorf("bad stderr forwarding expression %s, allowed values: %s",
-					*cmd.Stderr, "none, stdout, all")
+					*cmd.Stderr, "none, stdout, all, file")
 				t.commandInfo = &common.TaskCommandInfo{}
 				return
 			}
@@ -72,6 +72,7 @@ type Class struct {
 	Properties       gera.Map[string, string] `yaml:"properties"`
 	Constraints      []constraint.Constraint  `yaml:"constraints"`
 	Connect          []channel.Outbound       `yaml:"connect"`
+	Restart          *RestartPolicy           `yaml:"restart"`
 	UpdatedTimestamp time.Time                `yaml:"-"`
 }
 
@@ -92,6 +93,7 @@ func (c *Class) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 		Properties  map[string]string       `yaml:"properties"`
 		Constraints []constraint.Constraint `yaml:"constraints"`
 		Connect     []channel.Outbound      `yaml:"connect"`
+		Restart     *RestartPolicy          `yaml:"restart"`
 	}
 	aux := _class{
 		Defaults:   make(map[string]string),
@@ -119,6 +121,7 @@ func (c *Class) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 			Properties:       gera.MakeMapWithMap(aux.Properties),
 			Constraints:      aux.Constraints,
 			Connect:          aux.Connect,
+			Restart:          aux.Restart,
 			UpdatedTimestamp: time.Now(),
 		}
 	}
@@ -139,6 +142,7 @@ func (c *Class) MarshalYAML() (interface{}, error) {
 		Properties  map[string]string       `yaml:"properties,omitempty"`
 		Constraints []constraint.Constraint `yaml:"constraints,omitempty"`
 		Command     *common.CommandInfo     `yaml:"command"`
+		Restart     *RestartPolicy          `yaml:"restart,omitempty"`
 	}
 
 	aux := _class{
@@ -151,6 +155,7 @@ func (c *Class) MarshalYAML() (interface{}, error) {
 		Bind:        c.Bind,
 		Constraints: c.Constraints,
 		Command:     c.Command,
+		Restart:     c.Restart,
 	}
 	aux.Control.Mode = c.Control.Mode.String()
 
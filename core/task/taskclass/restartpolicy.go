@@ -0,0 +1,132 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package taskclass
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task/sm"
+)
+
+const (
+	DEFAULT_RESTART_MAX_RETRIES = 3
+	DEFAULT_RESTART_BACKOFF     = 5 * time.Second
+	DEFAULT_RESTART_MAX_BACKOFF = 5 * time.Minute
+)
+
+// RestartPolicy describes how the task manager should bring back a task of this class after
+// it dies while locked in an environment.
+// A task is restarted at most MaxRetries times per environment, each attempt is delayed by
+// Backoff, doubled at each subsequent attempt and capped to MaxBackoff.
+// The restarted task is driven to the state of its environment, but never beyond TargetState.
+type RestartPolicy struct {
+	MaxRetries  int           `yaml:"maxRetries"`
+	Backoff     time.Duration `yaml:"backoff"`
+	MaxBackoff  time.Duration `yaml:"maxBackoff"`
+	TargetState sm.State      `yaml:"targetState"`
+}
+
+func (rp *RestartPolicy) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _restartPolicy struct {
+		MaxRetries  *int   `yaml:"maxRetries"`
+		Backoff     string `yaml:"backoff"`
+		MaxBackoff  string `yaml:"maxBackoff"`
+		TargetState string `yaml:"targetState"`
+	}
+	aux := _restartPolicy{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	policy := RestartPolicy{
+		MaxRetries:  DEFAULT_RESTART_MAX_RETRIES,
+		Backoff:     DEFAULT_RESTART_BACKOFF,
+		MaxBackoff:  DEFAULT_RESTART_MAX_BACKOFF,
+		TargetState: sm.RUNNING,
+	}
+	if aux.MaxRetries != nil {
+		if *aux.MaxRetries < 0 {
+			return fmt.Errorf("invalid restart policy: maxRetries must not be negative, got %d", *aux.MaxRetries)
+		}
+		policy.MaxRetries = *aux.MaxRetries
+	}
+	if len(aux.Backoff) > 0 {
+		policy.Backoff, err = time.ParseDuration(aux.Backoff)
+		if err != nil {
+			return fmt.Errorf("invalid restart policy backoff: %w", err)
+		}
+	}
+	if len(aux.MaxBackoff) > 0 {
+		policy.MaxBackoff, err = time.ParseDuration(aux.MaxBackoff)
+		if err != nil {
+			return fmt.Errorf("invalid restart policy maxBackoff: %w", err)
+		}
+	}
+	if policy.MaxBackoff < policy.Backoff {
+		policy.MaxBackoff = policy.Backoff
+	}
+	if len(aux.TargetState) > 0 {
+		policy.TargetState = sm.StateFromString(aux.TargetState)
+		switch policy.TargetState {
+		case sm.STANDBY, sm.CONFIGURED, sm.RUNNING:
+		default:
+			return fmt.Errorf("invalid restart policy targetState %s, allowed values are STANDBY, CONFIGURED and RUNNING", aux.TargetState)
+		}
+	}
+
+	*rp = policy
+	return
+}
+
+func (rp *RestartPolicy) MarshalYAML() (interface{}, error) {
+	type _restartPolicy struct {
+		MaxRetries  int    `yaml:"maxRetries"`
+		Backoff     string `yaml:"backoff"`
+		MaxBackoff  string `yaml:"maxBackoff"`
+		TargetState string `yaml:"targetState"`
+	}
+	return _restartPolicy{
+		MaxRetries:  rp.MaxRetries,
+		Backoff:     rp.Backoff.String(),
+		MaxBackoff:  rp.MaxBackoff.String(),
+		TargetState: rp.TargetState.String(),
+	}, nil
+}
+
+// GetBackoff returns the delay before the given restart attempt, starting from 1.
+func (rp *RestartPolicy) GetBackoff(attempt int) time.Duration {
+	if rp == nil {
+		return 0
+	}
+	delay := rp.Backoff
+	for i := 1; i < attempt && delay < rp.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > rp.MaxBackoff {
+		delay = rp.MaxBackoff
+	}
+	return delay
+}
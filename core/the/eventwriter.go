@@ -27,6 +27,7 @@
 package the
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/AliceO2Group/Control/common/event"
@@ -49,15 +50,54 @@ func createOrGetWriter(topic topic.Topic) event.Writer {
 	if writer, ok := writers[topic]; ok {
 		return writer
 	}
+
+	topicWriters := make(event.MultiWriter, 0)
 	if viper.GetBool("enableKafka") {
-		writers[topic] = event.NewWriterWithTopic(topic)
-	} else {
+		topicWriters = append(topicWriters, event.NewWriterWithTopic(topic))
+	}
+	if endpoint := viper.GetString("eventWebhookEndpoint"); endpoint != "" &&
+		topicEnabled(topic, viper.GetStringSlice("eventWebhookTopics")) {
+		topicWriters = append(topicWriters, event.NewWebhookWriterWithTopic(topic, endpoint))
+	}
+	if path := viper.GetString("eventFilePath"); path != "" &&
+		topicEnabled(topic, viper.GetStringSlice("eventFileTopics")) {
+		fileWriter, err := event.NewFileWriterWithTopic(topic, path)
+		if err != nil {
+			log.WithError(err).
+				WithField("topic", topic).
+				Error("cannot create event file writer")
+		} else {
+			topicWriters = append(topicWriters, fileWriter)
+		}
+	}
+
+	switch len(topicWriters) {
+	case 0:
 		writers[topic] = &event.DummyWriter{}
+	case 1:
+		writers[topic] = topicWriters[0]
+	default:
+		writers[topic] = topicWriters
 	}
 
 	return writers[topic]
 }
 
+// topicEnabled returns true if t is selected by filters, i.e. if t is equal to one of them or is
+// one of their subtopics. An empty filter list selects all topics.
+func topicEnabled(t topic.Topic, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		filter = strings.TrimSpace(filter)
+		if string(t) == filter || strings.HasPrefix(string(t), filter+topic.Separator) {
+			return true
+		}
+	}
+	return false
+}
+
 func EventWriter() event.Writer {
 	return createOrGetWriter(topic.Root)
 }
@@ -70,7 +110,7 @@ func ClearEventWriters() {
 	mu.Lock()
 	defer mu.Unlock()
 
-	log.Logf(logrus.InfoLevel, "Clearing %d kafka producers", len(writers))
+	log.Logf(logrus.InfoLevel, "Clearing %d event writers", len(writers))
 	for _, writer := range writers {
 		writer.Close()
 	}
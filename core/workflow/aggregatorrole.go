@@ -32,9 +32,6 @@ import (
 
 	"github.com/AliceO2Group/Control/common/gera"
 
-	"github.com/AliceO2Group/Control/common/event"
-	"github.com/AliceO2Group/Control/common/event/topic"
-	pb "github.com/AliceO2Group/Control/common/protos"
 	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task"
@@ -283,14 +280,8 @@ func (r *aggregatorRole) updateStatus(s task.Status) {
 	log.WithField("new status", r.status.get()).Trace("status merged")
 
 	if oldStatus != r.status.get() {
-		the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
-			Name:          r.Name,
-			Status:        r.status.get().String(),
-			RolePath:      r.GetPath(),
-			EnvironmentId: r.GetEnvironmentId().String(),
-		})
+		roleEvents.changed(r, r.state.get(), oldStatus)
 	}
-	r.SendEvent(&event.RoleEvent{Name: r.Name, Status: r.status.get().String(), RolePath: r.GetPath()})
 	if r.parent != nil {
 		r.parent.updateStatus(r.status.get())
 	}
@@ -307,14 +298,8 @@ func (r *aggregatorRole) updateState(s sm.State) {
 		Tracef("updated state to %s upon input state %s", r.state.get().String(), s.String())
 
 	if oldState != r.state.get() {
-		the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
-			Name:          r.Name,
-			State:         r.state.get().String(),
-			RolePath:      r.GetPath(),
-			EnvironmentId: r.GetEnvironmentId().String(),
-		})
+		roleEvents.changed(r, oldState, r.status.get())
 	}
-	r.SendEvent(&event.RoleEvent{Name: r.Name, State: r.state.get().String(), RolePath: r.GetPath()})
 	if r.parent != nil {
 		r.parent.updateState(r.state.get())
 	}
@@ -28,6 +28,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"sync"
 	texttemplate "text/template"
@@ -40,11 +41,13 @@ import (
 	"github.com/AliceO2Group/Control/common/monitoring"
 	evpb "github.com/AliceO2Group/Control/common/protos"
 	"github.com/AliceO2Group/Control/common/utils"
+	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/integration"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/the"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
 )
 
 var (
@@ -61,6 +64,9 @@ type Call struct {
 
 	await       chan error
 	awaitCancel context.CancelFunc
+
+	startTime time.Time
+	endTime   time.Time
 }
 
 type Calls []*Call
@@ -120,6 +126,12 @@ func (c *Call) callableMetric(name string) monitoring.Metric {
 }
 
 func (c *Call) Call() error {
+	return c.call(context.Background())
+}
+
+// call runs the call, retrying it according to its retry policy. Waiting
+// between attempts is interrupted when ctx is done.
+func (c *Call) call(ctx context.Context) error {
 	log.WithField("trigger", c.Traits.Trigger).
 		WithField("await", c.Traits.Await).
 		WithField("partition", c.parentRole.GetEnvironmentId().String()).
@@ -129,6 +141,9 @@ func (c *Call) Call() error {
 	metric := c.callableMetric("callablecall")
 	defer monitoring.TimerSendSingle(&metric, monitoring.Millisecond)()
 
+	c.startTime, c.endTime = time.Now(), time.Time{}
+	defer func() { c.endTime = time.Now() }()
+
 	the.EventWriterWithTopic(topic.Call).WriteEvent(&evpb.Ev_CallEvent{
 		Path:       c.GetParentRolePath(),
 		Func:       c.Func,
@@ -143,38 +158,50 @@ func (c *Call) Call() error {
 		EnvironmentId: c.parentRole.GetEnvironmentId().String(),
 	})
 
-	output := "{{" + c.Func + "}}"
-	returnVar := c.Return
-	fields := template.Fields{
-		template.WrapPointer(&output),
-		template.WrapPointer(&returnVar),
-	}
-	var err error
-	c.VarStack, err = c.parentRole.ConsolidatedVarStack()
+	policy, err := NewRetryPolicy(c.Traits)
 	if err != nil {
-		log.WithField("trigger", c.Traits.Trigger).
+		log.WithError(err).
 			WithField("partition", c.parentRole.GetEnvironmentId().String()).
-			Debug("could not instantiate varStack")
+			WithField("call", c.Func).
+			Warn("could not parse retry policy for hook call, the call will not be retried")
 	}
-	c.VarStack["environment_id"] = c.parentRole.GetEnvironmentId().String()
-	c.VarStack["__call_func"] = c.Func
-	c.VarStack["__call_timeout"] = c.Traits.Timeout
-	c.VarStack["__call_trigger"] = c.Traits.Trigger
-	c.VarStack["__call_await"] = c.Traits.Await
-	c.VarStack["__call_critical"] = strconv.FormatBool(c.Traits.Critical)
-	c.VarStack["__call_rolepath"] = c.GetParentRolePath()
+	breaker := integration.BreakerFor(pluginNameOf(c.Func))
+
+	var output string
+RETRY_LOOP:
+	for attempt := 0; ; attempt++ {
+		output, err = c.attempt(breaker)
+		if err == nil {
+			break
+		}
+		var cErr *callError
+		if !errors.As(err, &cErr) || !policy.ShouldRetry(attempt, cErr.code) {
+			break
+		}
 
-	objStack := integration.PluginsInstance().CallStack(c)
+		backoff := policy.BackoffFor(attempt)
+		if !policy.WithinTimeout(time.Since(c.startTime), backoff) {
+			log.WithField("partition", c.parentRole.GetEnvironmentId().String()).
+				WithField("level", infologger.IL_Support).
+				WithField("call", c.Func).
+				WithField("attempt", attempt+1).
+				WithField("timeout", policy.Timeout.String()).
+				Warn("hook call failed, not retrying because the call would exceed its timeout")
+			break
+		}
 
-	var errMsg string
-	err = fields.Execute(apricot.Instance(), c.GetName(), c.VarStack, objStack, nil, make(map[string]texttemplate.Template), nil)
-	if err != nil {
-		errMsg = err.Error()
+		log.WithField("partition", c.parentRole.GetEnvironmentId().String()).
+			WithField("level", infologger.IL_Support).
+			WithField("call", c.Func).
+			WithField("attempt", attempt+1).
+			WithField("code", cErr.code.String()).
+			WithField("backoff", backoff.String()).
+			Warnf("hook call failed, retrying: %s", err.Error())
 
 		the.EventWriterWithTopic(topic.Call).WriteEvent(&evpb.Ev_CallEvent{
 			Path:       c.GetParentRolePath(),
 			Func:       c.Func,
-			CallStatus: evpb.OpStatus_DONE_ERROR,
+			CallStatus: evpb.OpStatus_ONGOING,
 			Return:     c.Return,
 			Traits: &evpb.Traits{
 				Trigger:  c.Traits.Trigger,
@@ -183,23 +210,19 @@ func (c *Call) Call() error {
 				Critical: c.Traits.Critical,
 			},
 			Output:        output,
-			Error:         errMsg,
+			Error:         err.Error(),
 			EnvironmentId: c.parentRole.GetEnvironmentId().String(),
 		})
 
-		metric.AddResult(monitoring.ERROR)
-		return err
-	}
-	if len(returnVar) > 0 {
-		c.parentRole.SetRuntimeVar(returnVar, output)
+		select {
+		case <-ctx.Done():
+			err = fmt.Errorf("hook call cancelled while waiting to retry: %w", err)
+			break RETRY_LOOP
+		case <-time.After(backoff):
+		}
 	}
 
-	// if __call_error was written into the VarStack we treat it as an error exit from the call
-	var ok bool
-	if errMsg, ok = c.VarStack["__call_error"]; ok && len(errMsg) > 0 {
-		if errReason, ok := c.VarStack["__call_error_reason"]; ok && len(errReason) > 0 {
-			errMsg += ". REASON: " + errReason
-		}
+	if err != nil {
 		the.EventWriterWithTopic(topic.Call).WriteEvent(&evpb.Ev_CallEvent{
 			Path:       c.GetParentRolePath(),
 			Func:       c.Func,
@@ -212,12 +235,12 @@ func (c *Call) Call() error {
 				Critical: c.Traits.Critical,
 			},
 			Output:        output,
-			Error:         errMsg,
+			Error:         err.Error(),
 			EnvironmentId: c.parentRole.GetEnvironmentId().String(),
 		})
 
 		metric.AddResult(monitoring.ERROR)
-		return errors.New(errMsg)
+		return err
 	}
 
 	the.EventWriterWithTopic(topic.Call).WriteEvent(&evpb.Ev_CallEvent{
@@ -239,6 +262,64 @@ func (c *Call) Call() error {
 	return nil
 }
 
+// attempt evaluates the call expression once. Failures reported by the
+// called function are returned as *callError, along with their gRPC code,
+// and are fed to the plugin's circuit breaker.
+func (c *Call) attempt(breaker *integration.CircuitBreaker) (output string, err error) {
+	if err = breaker.Allow(); err != nil {
+		return "", &callError{msg: err.Error(), code: codes.Unavailable}
+	}
+
+	output = "{{" + c.Func + "}}"
+	returnVar := c.Return
+	fields := template.Fields{
+		template.WrapPointer(&output),
+		template.WrapPointer(&returnVar),
+	}
+	c.VarStack, err = c.parentRole.ConsolidatedVarStack()
+	if err != nil {
+		log.WithField("trigger", c.Traits.Trigger).
+			WithField("partition", c.parentRole.GetEnvironmentId().String()).
+			Debug("could not instantiate varStack")
+	}
+	c.VarStack["environment_id"] = c.parentRole.GetEnvironmentId().String()
+	c.VarStack["__call_func"] = c.Func
+	c.VarStack["__call_timeout"] = c.Traits.Timeout
+	c.VarStack["__call_trigger"] = c.Traits.Trigger
+	c.VarStack["__call_await"] = c.Traits.Await
+	c.VarStack["__call_critical"] = strconv.FormatBool(c.Traits.Critical)
+	c.VarStack["__call_rolepath"] = c.GetParentRolePath()
+
+	objStack := integration.PluginsInstance().CallStack(c)
+
+	err = fields.Execute(apricot.Instance(), c.GetName(), c.VarStack, objStack, nil, make(map[string]texttemplate.Template), nil)
+	if err != nil {
+		// the expression could not be evaluated, which tells us nothing about the endpoint
+		breaker.Release()
+		return output, err
+	}
+	if len(returnVar) > 0 {
+		c.parentRole.SetRuntimeVar(returnVar, output)
+	}
+
+	// if __call_error was written into the VarStack we treat it as an error exit from the call
+	if errMsg, ok := c.VarStack["__call_error"]; ok && len(errMsg) > 0 {
+		if errReason, ok := c.VarStack["__call_error_reason"]; ok && len(errReason) > 0 {
+			errMsg += ". REASON: " + errReason
+		}
+		code := CallErrorCode(c.VarStack)
+		if code == codes.Unavailable {
+			breaker.RecordFailure(errMsg)
+		} else {
+			breaker.RecordSuccess()
+		}
+		return output, &callError{msg: errMsg, code: code}
+	}
+
+	breaker.RecordSuccess()
+	return output, nil
+}
+
 func (c *Call) Start() {
 	c.await = make(chan error)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -250,7 +331,7 @@ func (c *Call) Start() {
 		callId := fmt.Sprintf("hook:%s:%s", c.GetTraits().Trigger, c.GetName())
 		log.Debugf("%s started", callId)
 		defer utils.TimeTrack(time.Now(), callId, log.WithPrefix("callable"))
-		err := c.Call()
+		err := c.call(ctx)
 		select {
 		case c.await <- err:
 			if err == nil {
@@ -303,6 +384,38 @@ func (c *Call) GetName() string {
 	return c.parentRole.GetPath()
 }
 
+// GetPluginName returns the name of the plugin which provides the called
+// function, or an empty string if the call is not a plugin call.
+func (c *Call) GetPluginName() string {
+	return pluginNameOf(c.Func)
+}
+
+// GetDuration returns how long the last execution of the call took, or 0 if
+// it has not completed yet.
+func (c *Call) GetDuration() time.Duration {
+	if c.endTime.IsZero() {
+		return 0
+	}
+	return c.endTime.Sub(c.startTime)
+}
+
+// CallStackFunctions returns the sorted names of the functions which plugin
+// provides to call roles through its CallStack. The CallStack is built for a
+// call without a parent role, which is enough to list it since none of its
+// functions is run, but not for plugins which need a live environment to build
+// it. The ObjectStack is not listed, its functions cannot be called by hooks.
+func CallStackFunctions(plugin integration.Plugin) []string {
+	probe := &Call{VarStack: map[string]string{
+		"environment_id": uid.New().String(),
+	}}
+	names := make([]string, 0)
+	for name := range plugin.CallStack(probe) {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
 func (c *Call) GetTraits() task.Traits {
 	return c.Traits
 }
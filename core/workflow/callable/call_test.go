@@ -0,0 +1,280 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package callable
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/integration"
+	"github.com/AliceO2Group/Control/core/task"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+)
+
+type fakeParentRole struct {
+	mu          sync.Mutex
+	traits      task.Traits
+	runtimeVars map[string]string
+}
+
+func (f *fakeParentRole) GetPath() string            { return "root.call" }
+func (f *fakeParentRole) GetTaskTraits() task.Traits { return f.traits }
+func (f *fakeParentRole) GetEnvironmentId() uid.ID   { return uid.NilID() }
+func (f *fakeParentRole) ConsolidatedVarStack() (map[string]string, error) {
+	return map[string]string{}, nil
+}
+func (f *fakeParentRole) SendEvent(event.Event) {}
+func (f *fakeParentRole) SetRuntimeVar(key string, value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.runtimeVars[key] = value
+}
+func (f *fakeParentRole) SetRuntimeVars(kv map[string]string)          {}
+func (f *fakeParentRole) DeleteRuntimeVar(key string)                  {}
+func (f *fakeParentRole) DeleteRuntimeVars(keys []string)              {}
+func (f *fakeParentRole) SetGlobalRuntimeVar(key string, value string) {}
+func (f *fakeParentRole) SetGlobalRuntimeVars(kv map[string]string)    {}
+func (f *fakeParentRole) DeleteGlobalRuntimeVar(key string)            {}
+func (f *fakeParentRole) DeleteGlobalRuntimeVars(keys []string)        {}
+func (f *fakeParentRole) GetCurrentRunNumber() uint32                  { return 0 }
+
+// flakyPlugin fails its first `failures` calls with the given reason.
+type flakyPlugin struct {
+	mu       sync.Mutex
+	calls    int
+	failures int
+	reason   string
+}
+
+func (p *flakyPlugin) GetName() string                                       { return "flaky" }
+func (p *flakyPlugin) GetPrettyName() string                                 { return "Flaky plugin" }
+func (p *flakyPlugin) GetEndpoint() string                                   { return "" }
+func (p *flakyPlugin) GetConnectionState() string                            { return "TRANSIENT_FAILURE" }
+func (p *flakyPlugin) GetData(_ []any) string                                { return "" }
+func (p *flakyPlugin) GetEnvironmentsData(_ []uid.ID) map[uid.ID]string      { return nil }
+func (p *flakyPlugin) GetEnvironmentsShortData(_ []uid.ID) map[uid.ID]string { return nil }
+func (p *flakyPlugin) Init(_ string) error                                   { return nil }
+func (p *flakyPlugin) Destroy() error                                        { return nil }
+func (p *flakyPlugin) ObjectStack(_, _ map[string]string) map[string]interface{} {
+	return map[string]interface{}{
+		"Info": func() string { return "flaky" },
+	}
+}
+
+func (p *flakyPlugin) CallStack(data interface{}) map[string]interface{} {
+	call := data.(*Call)
+	return map[string]interface{}{
+		"Do": func() string {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.calls++
+			if p.calls <= p.failures {
+				call.VarStack["__call_error"] = "flaky call failed"
+				call.VarStack["__call_error_reason"] = p.reason
+				return "failed"
+			}
+			return "done"
+		},
+	}
+}
+
+func (p *flakyPlugin) getCalls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls
+}
+
+var _ = Describe("retry policy", func() {
+	It("defaults to no retries on UNAVAILABLE", func() {
+		policy, err := NewRetryPolicy(task.Traits{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Retries).To(Equal(0))
+		Expect(policy.Backoff).To(Equal(DEFAULT_RETRY_BACKOFF))
+		Expect(policy.RetryOn).To(ConsistOf(codes.Unavailable))
+		Expect(policy.ShouldRetry(0, codes.Unavailable)).To(BeFalse())
+	})
+
+	It("parses the retry traits", func() {
+		policy, err := NewRetryPolicy(task.Traits{Retries: "3", RetryBackoff: "200ms", RetryOn: "UNAVAILABLE, DeadlineExceeded"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.RetryOn).To(ConsistOf(codes.Unavailable, codes.DeadlineExceeded))
+		Expect(policy.ShouldRetry(2, codes.DeadlineExceeded)).To(BeTrue())
+		Expect(policy.ShouldRetry(3, codes.DeadlineExceeded)).To(BeFalse())
+		Expect(policy.ShouldRetry(0, codes.Unknown)).To(BeFalse())
+		Expect(policy.BackoffFor(0)).To(Equal(200 * time.Millisecond))
+		Expect(policy.BackoffFor(2)).To(Equal(800 * time.Millisecond))
+		Expect(policy.BackoffFor(20)).To(Equal(MAX_RETRY_BACKOFF))
+	})
+
+	It("caps the retries by the call timeout", func() {
+		policy, err := NewRetryPolicy(task.Traits{Retries: "3", Timeout: "10s"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Timeout).To(Equal(10 * time.Second))
+		Expect(policy.WithinTimeout(5*time.Second, 4*time.Second)).To(BeTrue())
+		Expect(policy.WithinTimeout(5*time.Second, 5*time.Second)).To(BeFalse())
+
+		policy, err = NewRetryPolicy(task.Traits{Retries: "3"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.WithinTimeout(time.Hour, time.Hour)).To(BeTrue())
+	})
+
+	DescribeTable("rejects invalid traits",
+		func(traits task.Traits) {
+			_, err := NewRetryPolicy(traits)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("negative retries", task.Traits{Retries: "-1"}),
+		Entry("non-numeric retries", task.Traits{Retries: "many"}),
+		Entry("bad backoff", task.Traits{RetryBackoff: "soon"}),
+		Entry("unknown code", task.Traits{RetryOn: "UNAVAILABLE,FLAKY"}),
+		Entry("OK code", task.Traits{RetryOn: "OK"}),
+	)
+
+	DescribeTable("infers the code of a failed call",
+		func(varStack map[string]string, expected codes.Code) {
+			Expect(CallErrorCode(varStack)).To(Equal(expected))
+		},
+		Entry("explicit code", map[string]string{"__call_error_code": "Unavailable", "__call_error_reason": "whatever"}, codes.Unavailable),
+		Entry("gRPC status text", map[string]string{"__call_error_reason": "rpc error: code = ResourceExhausted desc = busy"}, codes.ResourceExhausted),
+		Entry("context deadline", map[string]string{"__call_error_reason": "SOR failed: context deadline exceeded"}, codes.DeadlineExceeded),
+		Entry("anything else", map[string]string{"__call_error_reason": "detector TPC in ERROR"}, codes.Unknown),
+	)
+
+	It("lists the call stack functions of a plugin without calling them", func() {
+		plugin := &flakyPlugin{failures: 1}
+		Expect(CallStackFunctions(plugin)).To(Equal([]string{"Do"}))
+		Expect(plugin.getCalls()).To(BeZero())
+	})
+
+	It("extracts the plugin name from the call expression", func() {
+		Expect(pluginNameOf("dcs.StartOfRun()")).To(Equal("dcs"))
+		Expect(pluginNameOf(" odc.Configure()")).To(Equal("odc"))
+		Expect(pluginNameOf("!trg.RunLoad()")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("calls", Ordered, func() {
+	var (
+		plugin *flakyPlugin
+		parent *fakeParentRole
+	)
+
+	newCall := func(traits task.Traits) *Call {
+		parent = &fakeParentRole{traits: traits, runtimeVars: map[string]string{}}
+		return NewCall("flaky.Do()", "result", parent)
+	}
+
+	BeforeEach(func() {
+		integration.Reset()
+		viper.Reset()
+		viper.Set("config_endpoint", "mock://")
+		viper.Set("flakyEndpoint", "none")
+		viper.Set("integrationPlugins", []string{"flaky"})
+		viper.Set("integrationBreakerThreshold", 0)
+		plugin = &flakyPlugin{reason: "rpc error: code = Unavailable desc = gateway unreachable"}
+		integration.RegisterPlugin("flaky", "flakyEndpoint", func(string) integration.Plugin { return plugin })
+	})
+
+	It("retries UNAVAILABLE failures until the call succeeds", func() {
+		plugin.failures = 2
+		call := newCall(task.Traits{Retries: "2", RetryBackoff: "1ms"})
+		Expect(call.Call()).To(Succeed())
+		Expect(plugin.getCalls()).To(Equal(3))
+		Expect(parent.runtimeVars).To(HaveKeyWithValue("result", "done"))
+	})
+
+	It("records the plugin and the duration of the call", func() {
+		call := newCall(task.Traits{})
+		Expect(call.GetDuration()).To(BeZero())
+		Expect(call.Call()).To(Succeed())
+		Expect(call.GetPluginName()).To(Equal("flaky"))
+		Expect(call.GetDuration()).To(BeNumerically(">", 0))
+	})
+
+	It("gives up once the retries are exhausted", func() {
+		plugin.failures = 5
+		call := newCall(task.Traits{Retries: "2", RetryBackoff: "1ms"})
+		err := call.Call()
+		Expect(err).To(MatchError(ContainSubstring("gateway unreachable")))
+		Expect(plugin.getCalls()).To(Equal(3))
+	})
+
+	It("does not start a retry which would exceed the call timeout", func() {
+		plugin.failures = 5
+		call := newCall(task.Traits{Retries: "3", RetryBackoff: "1h", Timeout: "1s"})
+		Expect(call.Call()).To(MatchError(ContainSubstring("gateway unreachable")))
+		Expect(plugin.getCalls()).To(Equal(1))
+	})
+
+	It("stops waiting for a retry once the call is cancelled", func() {
+		plugin.failures = 5
+		call := newCall(task.Traits{Retries: "3", RetryBackoff: "1h"})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		called := make(chan error, 1)
+		go func() {
+			called <- call.call(ctx)
+		}()
+		Eventually(plugin.getCalls).Should(Equal(1))
+
+		cancel()
+		Eventually(called).Should(Receive(MatchError(ContainSubstring("cancelled while waiting to retry"))))
+		Expect(plugin.getCalls()).To(Equal(1))
+	})
+
+	It("does not retry codes which are not listed", func() {
+		plugin.failures = 1
+		plugin.reason = "rpc error: code = FailedPrecondition desc = wrong state"
+		call := newCall(task.Traits{Retries: "3", RetryBackoff: "1ms"})
+		Expect(call.Call()).NotTo(Succeed())
+		Expect(plugin.getCalls()).To(Equal(1))
+	})
+
+	It("fails fast once the plugin's circuit breaker is open", func() {
+		viper.Set("integrationBreakerThreshold", 2)
+		viper.Set("integrationBreakerCooldown", time.Hour)
+		plugin.failures = 100
+
+		Expect(newCall(task.Traits{}).Call()).NotTo(Succeed())
+		Expect(newCall(task.Traits{}).Call()).NotTo(Succeed())
+		Expect(plugin.getCalls()).To(Equal(2))
+
+		err := newCall(task.Traits{Retries: "1", RetryBackoff: "1ms"}).Call()
+		Expect(err).To(MatchError(ContainSubstring("failing fast")))
+		Expect(plugin.getCalls()).To(Equal(2))
+		Expect(integration.BreakerFor("flaky").GetState()).To(Equal(integration.BREAKER_OPEN))
+	})
+})
+
+func TestCallable(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Callable Test Suite")
+}
@@ -0,0 +1,188 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package callable
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	DEFAULT_RETRY_BACKOFF = 1 * time.Second
+	MAX_RETRY_BACKOFF     = 30 * time.Second
+)
+
+var (
+	DEFAULT_RETRY_ON = []codes.Code{codes.Unavailable}
+
+	grpcCodePattern = regexp.MustCompile(`code = (\w+) desc =`)
+	pluginPattern   = regexp.MustCompile(`^\s*([A-Za-z_][A-Za-z0-9_]*)\.`)
+)
+
+// RetryPolicy is built from the retries, retryBackoff and retryOn traits of
+// a call. A call is retried at most Retries times, waiting Backoff before the
+// first retry and doubling the wait for each following one, and only if it
+// failed with one of the RetryOn gRPC codes. If the call declares a timeout,
+// no retry is started which would end after Timeout has elapsed since the
+// first attempt.
+type RetryPolicy struct {
+	Retries int
+	Backoff time.Duration
+	RetryOn []codes.Code
+	Timeout time.Duration
+}
+
+func NewRetryPolicy(traits task.Traits) (policy RetryPolicy, err error) {
+	policy = RetryPolicy{
+		Backoff: DEFAULT_RETRY_BACKOFF,
+		RetryOn: DEFAULT_RETRY_ON,
+	}
+	if retries := strings.TrimSpace(traits.Retries); len(retries) > 0 {
+		policy.Retries, err = strconv.Atoi(retries)
+		if err != nil || policy.Retries < 0 {
+			return RetryPolicy{}, fmt.Errorf("invalid retries value %q: expected a non-negative integer", traits.Retries)
+		}
+	}
+	if backoff := strings.TrimSpace(traits.RetryBackoff); len(backoff) > 0 {
+		policy.Backoff, err = time.ParseDuration(backoff)
+		if err != nil || policy.Backoff < 0 {
+			return RetryPolicy{}, fmt.Errorf("invalid retryBackoff value %q: expected a non-negative duration", traits.RetryBackoff)
+		}
+	}
+	// an unparseable timeout is reported when the call itself acquires it,
+	// here it only leaves the retries uncapped
+	if timeout, parseErr := time.ParseDuration(strings.TrimSpace(traits.Timeout)); parseErr == nil && timeout > 0 {
+		policy.Timeout = timeout
+	}
+	if retryOn := strings.TrimSpace(traits.RetryOn); len(retryOn) > 0 {
+		policy.RetryOn = nil
+		for _, name := range strings.Split(retryOn, ",") {
+			code, ok := ParseCode(name)
+			if !ok {
+				return RetryPolicy{}, fmt.Errorf("invalid retryOn value %q: unknown gRPC code %q", traits.RetryOn, strings.TrimSpace(name))
+			}
+			policy.RetryOn = append(policy.RetryOn, code)
+		}
+	}
+	return
+}
+
+// ShouldRetry reports whether a call which already failed attempt+1 times
+// with the given code may be attempted again.
+func (p RetryPolicy) ShouldRetry(attempt int, code codes.Code) bool {
+	if attempt >= p.Retries {
+		return false
+	}
+	for _, retryOn := range p.RetryOn {
+		if retryOn == code {
+			return true
+		}
+	}
+	return false
+}
+
+// WithinTimeout reports whether a retry started after waiting backoff, with
+// elapsed time already spent on the call, would still begin within Timeout.
+func (p RetryPolicy) WithinTimeout(elapsed time.Duration, backoff time.Duration) bool {
+	return p.Timeout <= 0 || elapsed+backoff < p.Timeout
+}
+
+// BackoffFor returns how long to wait after the given failed attempt, counted
+// from 0.
+func (p RetryPolicy) BackoffFor(attempt int) time.Duration {
+	backoff := p.Backoff
+	for i := 0; i < attempt && backoff < MAX_RETRY_BACKOFF; i++ {
+		backoff *= 2
+	}
+	if backoff > MAX_RETRY_BACKOFF {
+		backoff = MAX_RETRY_BACKOFF
+	}
+	return backoff
+}
+
+// ParseCode accepts gRPC code names both in their canonical upper snake case
+// form (e.g. DEADLINE_EXCEEDED) and as printed by Go (e.g. DeadlineExceeded).
+// OK is not accepted, as it never describes a failure.
+func ParseCode(name string) (codes.Code, bool) {
+	name = strings.TrimSpace(name)
+	var code codes.Code
+	if err := code.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(name)))); err == nil && code != codes.OK {
+		return code, true
+	}
+	for c := codes.Canceled; c <= codes.Unauthenticated; c++ {
+		if strings.EqualFold(c.String(), name) {
+			return c, true
+		}
+	}
+	return codes.OK, false
+}
+
+// CallErrorCode returns the gRPC code of a failed call. Plugins may set it
+// explicitly in __call_error_code, otherwise it is inferred from the gRPC
+// status or context error text in __call_error_reason.
+func CallErrorCode(varStack map[string]string) codes.Code {
+	if name, ok := varStack["__call_error_code"]; ok {
+		if code, ok := ParseCode(name); ok {
+			return code
+		}
+	}
+	reason := varStack["__call_error_reason"]
+	if match := grpcCodePattern.FindStringSubmatch(reason); len(match) == 2 {
+		if code, ok := ParseCode(match[1]); ok {
+			return code
+		}
+	}
+	if strings.Contains(reason, context.DeadlineExceeded.Error()) {
+		return codes.DeadlineExceeded
+	}
+	return codes.Unknown
+}
+
+// pluginNameOf returns the name of the object a call function belongs to,
+// e.g. dcs for dcs.StartOfRun().
+func pluginNameOf(funcCall string) string {
+	match := pluginPattern.FindStringSubmatch(funcCall)
+	if len(match) != 2 {
+		return ""
+	}
+	return match[1]
+}
+
+// callError is an error reported by the called function itself, as opposed to
+// a failure to evaluate the call expression, which is never retried.
+type callError struct {
+	msg  string
+	code codes.Code
+}
+
+func (e *callError) Error() string {
+	return e.msg
+}
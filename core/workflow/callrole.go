@@ -26,6 +26,7 @@ package workflow
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	texttemplate "text/template"
 	"time"
@@ -35,9 +36,6 @@ import (
 	"github.com/AliceO2Group/Control/common/logger/infologger"
 	"github.com/AliceO2Group/Control/core/task/sm"
 
-	"github.com/AliceO2Group/Control/common/event"
-	"github.com/AliceO2Group/Control/common/event/topic"
-	pb "github.com/AliceO2Group/Control/common/protos"
 	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task"
@@ -76,6 +74,10 @@ func (t *callRole) UnmarshalYAML(unmarshal func(interface{}) error) (err error)
 			Await    *string
 			Timeout  *string
 			Critical *bool
+
+			Retries      *string
+			RetryBackoff *string     `yaml:"retryBackoff"`
+			RetryOn      interface{} `yaml:"retryOn"`
 		}
 	}{}
 
@@ -122,6 +124,27 @@ func (t *callRole) UnmarshalYAML(unmarshal func(interface{}) error) (err error)
 	} else {
 		role.Critical = true
 	}
+
+	if aux.Call.Retries != nil {
+		role.Retries = *aux.Call.Retries
+	}
+	if aux.Call.RetryBackoff != nil {
+		role.RetryBackoff = *aux.Call.RetryBackoff
+	}
+	// retryOn may be a list of gRPC codes or a single comma-separated string
+	switch retryOn := aux.Call.RetryOn.(type) {
+	case nil:
+	case string:
+		role.RetryOn = retryOn
+	case []interface{}:
+		codes := make([]string, 0, len(retryOn))
+		for _, code := range retryOn {
+			codes = append(codes, fmt.Sprintf("%v", code))
+		}
+		role.RetryOn = strings.Join(codes, ",")
+	default:
+		return fmt.Errorf("invalid retryOn value for call %s: expected a string or a list of gRPC codes", aux.Call.Func)
+	}
 	t.status.status = task.ACTIVE
 
 	*t = callRole(role)
@@ -140,6 +163,15 @@ func (t *callRole) MarshalYAML() (interface{}, error) {
 		callRole["timeout"] = t.Traits.Timeout
 	}
 	callRole["critical"] = t.Traits.Critical
+	if t.Traits.Retries != "" {
+		callRole["retries"] = t.Traits.Retries
+	}
+	if t.Traits.RetryBackoff != "" {
+		callRole["retryBackoff"] = t.Traits.RetryBackoff
+	}
+	if t.Traits.RetryOn != "" {
+		callRole["retryOn"] = t.Traits.RetryOn
+	}
 	callRole["func"] = t.FuncCall
 	callRole["return"] = t.ReturnVar
 
@@ -176,6 +208,9 @@ func (t *callRole) ProcessTemplates(workflowRepo repos.IRepo, _ LoadSubworkflowF
 			template.WrapPointer(&t.Timeout),
 			template.WrapPointer(&t.Trigger),
 			template.WrapPointer(&t.Await),
+			template.WrapPointer(&t.Retries),
+			template.WrapPointer(&t.RetryBackoff),
+			template.WrapPointer(&t.RetryOn),
 		},
 		template.STAGE5: append(append(
 			WrapConstraints(t.Constraints),
@@ -237,14 +272,8 @@ func (t *callRole) updateStatus(s task.Status) {
 	t.status.merge(s, t)
 
 	if oldStatus != t.status.get() {
-		the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
-			Name:          t.Name,
-			Status:        t.status.get().String(),
-			RolePath:      t.GetPath(),
-			EnvironmentId: t.GetEnvironmentId().String(),
-		})
+		roleEvents.changed(t, t.state.get(), oldStatus)
 	}
-	t.SendEvent(&event.RoleEvent{Name: t.Name, Status: t.status.get().String(), RolePath: t.GetPath()})
 
 	t.parent.updateStatus(s)
 }
@@ -262,12 +291,7 @@ func (t *callRole) updateState(s sm.State) {
 		Tracef("updated state to %s upon input state %s", t.state.get().String(), s.String())
 
 	if oldState != t.state.get() {
-		the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
-			Name:          t.Name,
-			State:         t.state.get().String(),
-			RolePath:      t.GetPath(),
-			EnvironmentId: t.GetEnvironmentId().String(),
-		})
+		roleEvents.changed(t, oldState, t.status.get())
 		if t.state.get() == sm.ERROR {
 			log.WithField("partition", t.GetEnvironmentId().String()).
 				WithField("level", infologger.IL_Support).
@@ -276,7 +300,6 @@ func (t *callRole) updateState(s sm.State) {
 				Error("call went into ERROR")
 		}
 	}
-	t.SendEvent(&event.RoleEvent{Name: t.Name, State: t.state.get().String(), RolePath: t.GetPath()})
 
 	if t.Critical == true {
 		t.parent.updateState(s)
@@ -347,6 +370,20 @@ func (t *callRole) GetTaskTraits() task.Traits {
 	return t.Traits
 }
 
+func (t *callRole) GetFuncCall() string {
+	if t == nil {
+		return ""
+	}
+	return t.FuncCall
+}
+
+func (t *callRole) GetReturnVar() string {
+	if t == nil {
+		return ""
+	}
+	return t.ReturnVar
+}
+
 func (t *callRole) GetTaskClasses() []string {
 	return nil
 }
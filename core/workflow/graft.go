@@ -26,8 +26,11 @@ package workflow
 
 import (
 	"fmt"
-	"gopkg.in/yaml.v3"
 	"strings"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/the"
+	"gopkg.in/yaml.v3"
 )
 
 // Graft takes a root node, a path to a role in root, byte array with an existing role and appends this
@@ -106,3 +109,96 @@ func appendRole(parent *yaml.Node, toAdd []byte) (err error) {
 	}
 	return
 }
+
+// GraftRole loads the workflow template workflowTemplate and attaches it as a child named graftedName
+// of the aggregator role found at parentPath in the role tree of root.
+// Templates of the grafted sub-tree are processed against the variable stack of the new parent, and the
+// task classes it requires are refreshed with the task manager. The grafted role is returned so that
+// the caller can deploy and configure its tasks.
+func GraftRole(root Role, parentPath string, graftedName string, workflowTemplate string, taskManager *task.Manager, baseConfigStack map[string]string) (grafted Role, err error) {
+	if len(graftedName) == 0 {
+		return nil, fmt.Errorf("cannot graft role with empty name")
+	}
+	if FindRole(root, parentPath+PATH_SEPARATOR+graftedName) != nil {
+		return nil, fmt.Errorf("role %s already exists", parentPath+PATH_SEPARATOR+graftedName)
+	}
+
+	var parent *aggregatorRole
+	switch typed := FindRole(root, parentPath).(type) {
+	case *aggregatorRole:
+		parent = typed
+	case *includeRole:
+		parent = &typed.aggregatorRole
+	case nil:
+		return nil, fmt.Errorf("role %s not found", parentPath)
+	default:
+		return nil, fmt.Errorf("role %s is not an aggregator role", parentPath)
+	}
+
+	subWfRoot, workflowRepo, err := loadSubworkflowFromRepo(workflowTemplate, parent)
+	if err != nil {
+		return nil, err
+	}
+	subWfRoot.Name = graftedName
+
+	err = subWfRoot.ProcessTemplates(workflowRepo, loadSubworkflowFromRepo, baseConfigStack)
+	if err != nil {
+		return nil, err
+	}
+	if !subWfRoot.IsEnabled() {
+		return nil, fmt.Errorf("role %s is disabled after template processing", subWfRoot.GetPath())
+	}
+
+	taskClassesRequired := subWfRoot.GetTaskClasses()
+	err = the.RepoManager().EnsureReposPresent(taskClassesRequired)
+	if err != nil {
+		return nil, err
+	}
+	err = taskManager.RefreshClasses(taskClassesRequired)
+	if err != nil {
+		return nil, err
+	}
+
+	parent.Roles = append(parent.Roles, subWfRoot)
+	return subWfRoot, nil
+}
+
+// DetachRole removes the role found at path from the children of its parent aggregator role and
+// returns it. The detached role keeps its tasks, it's up to the caller to release them.
+func DetachRole(root Role, path string) (detached Role, err error) {
+	detached = FindRole(root, path)
+	if detached == nil {
+		return nil, fmt.Errorf("role %s not found", path)
+	}
+
+	var parent *aggregatorRole
+	switch typed := detached.GetParentRole().(type) {
+	case *aggregatorRole:
+		parent = typed
+	case *includeRole:
+		parent = &typed.aggregatorRole
+	case nil:
+		return nil, fmt.Errorf("cannot detach root role %s", path)
+	default:
+		return nil, fmt.Errorf("cannot detach role %s, its parent is not an aggregator role", path)
+	}
+
+	remainingRoles := make([]Role, 0, len(parent.Roles))
+	for _, role := range parent.Roles {
+		if role != detached {
+			remainingRoles = append(remainingRoles, role)
+		}
+	}
+	parent.Roles = remainingRoles
+	return detached, nil
+}
+
+// FindRole returns the role at path in the role tree of root, or nil if no such role exists.
+func FindRole(root Role, path string) (found Role) {
+	Walk(root, func(role Role) {
+		if found == nil && role.GetPath() == path {
+			found = role
+		}
+	})
+	return
+}
@@ -0,0 +1,77 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("role tree modification", func() {
+	var (
+		root   Role
+		leaves map[string]Role
+	)
+
+	BeforeEach(func() {
+		root, leaves = complexRoleTree()
+	})
+
+	Describe("FindRole", func() {
+		It("should find roles by their full path", func() {
+			Expect(FindRole(root, "root")).To(BeIdenticalTo(root))
+			Expect(FindRole(root, "root.task1")).To(BeIdenticalTo(leaves["task1"]))
+			Expect(FindRole(root, "root.agg1.agg1task_critical")).To(BeIdenticalTo(leaves["agg1task_critical"]))
+		})
+		It("should return nil for unknown paths", func() {
+			Expect(FindRole(root, "root.nonexistent")).To(BeNil())
+			Expect(FindRole(root, "agg1")).To(BeNil())
+		})
+	})
+
+	Describe("DetachRole", func() {
+		It("should remove a role from its parent aggregator", func() {
+			detached, err := DetachRole(root, "root.task1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detached).To(BeIdenticalTo(leaves["task1"]))
+			Expect(FindRole(root, "root.task1")).To(BeNil())
+			Expect(root.GetRoles()).To(HaveLen(3))
+		})
+		It("should remove a role from an include role", func() {
+			detached, err := DetachRole(root, "root.agg1.agg1task_noncritical")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(detached).To(BeIdenticalTo(leaves["agg1task_noncritical"]))
+			Expect(FindRole(root, "root.agg1.agg1task_noncritical")).To(BeNil())
+			Expect(FindRole(root, "root.agg1.agg1task_critical")).NotTo(BeNil())
+		})
+		It("should fail for the root role", func() {
+			_, err := DetachRole(root, "root")
+			Expect(err).To(HaveOccurred())
+		})
+		It("should fail for unknown roles", func() {
+			_, err := DetachRole(root, "root.nonexistent")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,742 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/gera"
+	"github.com/AliceO2Group/Control/configuration/template"
+	"github.com/AliceO2Group/Control/core/repos"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/task/taskclass"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/valyala/fasttemplate"
+	"gopkg.in/yaml.v3"
+)
+
+type LintSeverity int
+
+const (
+	LINT_ERROR LintSeverity = iota
+	LINT_WARNING
+)
+
+func (s LintSeverity) String() string {
+	if s == LINT_WARNING {
+		return "warning"
+	}
+	return "error"
+}
+
+// Checks performed by Lint, as reported in LintFinding.Check
+const (
+	LINT_INVALID_TEMPLATE   = "invalid-template"
+	LINT_UNDEFINED_VARIABLE = "undefined-variable"
+	LINT_UNRESOLVED_INCLUDE = "unresolved-include"
+	LINT_UNRESOLVED_TASK    = "unresolved-task"
+	LINT_UNMATCHED_CHANNEL  = "unmatched-channel"
+	LINT_UNKNOWN_CALL       = "unknown-call"
+	LINT_DUPLICATE_ROLE     = "duplicate-role"
+)
+
+// Variables which are not declared in templates, but set by the core at
+// runtime or when building task commands.
+var lintRuntimeVars = []string{
+	"environment_id",
+	"detectors",
+	"last_request_user",
+	"enter_state_time_ms",
+	"run_number",
+	"runNumber",
+	"last_run_number",
+	"run_type",
+	"run_start_time_ms",
+	"run_end_time_ms",
+	"run_start_completion_time_ms",
+	"run_end_completion_time_ms",
+	"trg_start_time_ms",
+	"trg_end_time_ms",
+	"trg_global_run_enabled",
+	"fill_info_fill_number",
+	"fill_info_filling_scheme",
+	"fill_info_beam_type",
+	"fill_info_beam_mode",
+	"fill_info_stable_beams_start_ms",
+	"fill_info_stable_beams_end_ms",
+	"lhc_period",
+	"pdp_n_hbf_per_tf",
+	"pdp_beam_type",
+	"pdp_override_run_start_time",
+	"odc_script",
+	"call_history",
+}
+
+// Variables which are only available when processing task templates.
+var lintTaskVars = []string{
+	"task_id",
+	"task_name",
+	"task_class_name",
+	"task_hostname",
+	"task_parent_role",
+}
+
+// LintFinding is a problem found by Lint in a workflow or task template.
+type LintFinding struct {
+	Severity LintSeverity
+	Check    string
+	Template string // path of the template within its repository, e.g. workflows/readout.yaml
+	RolePath string // path of the role within the workflow which is being checked, if any
+	Message  string
+}
+
+type LintOptions struct {
+	// KnownVars are variables defined outside of the repository, e.g. the
+	// defaults and vars from the configuration service.
+	KnownVars []string
+	// PluginCalls are the CallStack functions of the loaded plugins, by plugin
+	// name. A nil slice means that the functions of that plugin are unknown.
+	// If PluginCalls is nil, calls are not checked.
+	PluginCalls map[string][]string
+}
+
+// Lint statically checks all the workflow and task templates of a repository.
+// Workflows are checked with their includes expanded, as long as these point
+// to the same repository and revision, while templated expressions are never
+// evaluated.
+func Lint(files *repos.TemplateFiles, opts LintOptions) []LintFinding {
+	l := &linter{
+		files:     files,
+		opts:      opts,
+		workflows: make(map[string]*aggregatorRole),
+		classes:   make(map[string]*taskclass.Class),
+		broken:    make(map[string]struct{}),
+		builtins:  make(map[string]struct{}),
+		known:     make(map[string]struct{}),
+		linted:    make(map[string]struct{}),
+		seen:      make(map[string]struct{}),
+		findings:  make([]LintFinding, 0),
+	}
+	l.parse()
+	l.collectKnownNames()
+
+	included := make(map[string]struct{})
+	for _, root := range l.workflows {
+		walkRoles(root, func(role Role) {
+			if r, ok := role.(*includeRole); ok && !isTemplated(r.Include) {
+				if name, local := l.resolveLocal(r.Include, "workflows"); local {
+					included[name] = struct{}{}
+				}
+			}
+		})
+	}
+
+	names := sortedKeys(l.workflows)
+	for _, name := range names {
+		if _, ok := included[name]; !ok {
+			l.lintWorkflow(name)
+		}
+	}
+	// workflows which are only ever included, e.g. within an include cycle,
+	// are still checked on their own
+	for _, name := range names {
+		if _, ok := l.linted[name]; !ok {
+			l.lintWorkflow(name)
+		}
+	}
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		return l.findings[i].Template < l.findings[j].Template
+	})
+	return l.findings
+}
+
+type linter struct {
+	files     *repos.TemplateFiles
+	opts      LintOptions
+	workflows map[string]*aggregatorRole
+	classes   map[string]*taskclass.Class
+	broken    map[string]struct{} // templates which failed to parse, by path
+	builtins  map[string]struct{}
+	known     map[string]struct{}
+	linted    map[string]struct{}
+	seen      map[string]struct{}
+	findings  []LintFinding
+}
+
+// lintContext holds the channels of a top-level workflow, with its includes
+// expanded.
+type lintContext struct {
+	binds            map[string]struct{}
+	globals          map[string]struct{}
+	templatedBinds   bool
+	templatedGlobals bool
+	connects         []lintConnect
+	including        []string
+}
+
+type lintConnect struct {
+	template string
+	rolePath string
+	channel.Outbound
+}
+
+type lintScope struct {
+	vars   map[string]struct{}
+	parent *lintScope
+}
+
+func (s *lintScope) with(names ...[]string) *lintScope {
+	child := &lintScope{
+		vars:   make(map[string]struct{}),
+		parent: s,
+	}
+	for _, n := range names {
+		for _, name := range n {
+			child.vars[name] = struct{}{}
+		}
+	}
+	return child
+}
+
+func (s *lintScope) has(name string) bool {
+	for ; s != nil; s = s.parent {
+		if _, ok := s.vars[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *linter) report(severity LintSeverity, check string, template string, rolePath string, message string) {
+	// the same task template is checked once for each role which loads it, so
+	// its findings are only reported for the first one
+	key := strings.Join([]string{check, template, message}, "\x00")
+	if !strings.HasPrefix(template, "tasks/") {
+		key += "\x00" + rolePath
+	}
+	if _, ok := l.seen[key]; ok {
+		return
+	}
+	l.seen[key] = struct{}{}
+
+	l.findings = append(l.findings, LintFinding{
+		Severity: severity,
+		Check:    check,
+		Template: template,
+		RolePath: rolePath,
+		Message:  message,
+	})
+}
+
+func (l *linter) parse() {
+	for _, name := range sortedKeys(l.files.Tasks) {
+		class := new(taskclass.Class)
+		err := yaml.Unmarshal(l.files.Tasks[name], class)
+		if err != nil {
+			l.broken[taskTemplatePath(name)] = struct{}{}
+			l.report(LINT_ERROR, LINT_INVALID_TEMPLATE, taskTemplatePath(name), "", "cannot parse task template: "+err.Error())
+			continue
+		}
+		l.classes[name] = class
+	}
+
+	for _, name := range sortedKeys(l.files.Workflows) {
+		root := new(aggregatorRole)
+		err := yaml.Unmarshal(l.files.Workflows[name], root)
+		if err != nil {
+			l.broken[workflowTemplatePath(name)] = struct{}{}
+			l.report(LINT_ERROR, LINT_INVALID_TEMPLATE, workflowTemplatePath(name), "", "cannot parse workflow template: "+err.Error())
+			continue
+		}
+		if root.Name != name {
+			l.report(LINT_ERROR, LINT_INVALID_TEMPLATE, workflowTemplatePath(name), root.Name,
+				fmt.Sprintf("workflow name %q does not match the file name", root.Name))
+		}
+		l.workflows[name] = root
+	}
+}
+
+func (l *linter) collectKnownNames() {
+	namespaces := []map[string]interface{}{
+		template.MakeUtilFuncMap(nil),
+		template.MakeConfigAndRepoAccessFuncs(nil, nil, nil),
+		template.MakeConfigAccessObject(nil, nil),
+	}
+	for _, namespace := range namespaces {
+		for name := range namespace {
+			l.builtins[name] = struct{}{}
+		}
+	}
+	for name := range template.MakeConfigAccessFuncs(nil, nil) {
+		l.builtins[name] = struct{}{}
+	}
+	for name := range template.MakeConfigAccessFuncsMultiVar(nil, nil) {
+		l.builtins[name] = struct{}{}
+	}
+	// object stack entries added by roles and tasks
+	for _, name := range []string{"addNil", "Parent", "Up", "This", "ToPtree"} {
+		l.builtins[name] = struct{}{}
+	}
+
+	for name := range l.builtins {
+		l.known[name] = struct{}{}
+	}
+	for _, name := range lintRuntimeVars {
+		l.known[name] = struct{}{}
+	}
+	for _, name := range l.opts.KnownVars {
+		l.known[name] = struct{}{}
+	}
+	// the odc object is always in the object stack, see integration.Plugins.ObjectStack
+	l.known["odc"] = struct{}{}
+	for name := range l.opts.PluginCalls {
+		l.known[name] = struct{}{}
+	}
+
+	// call results are set as runtime vars, and may be used anywhere once the
+	// call has returned
+	for _, root := range l.workflows {
+		walkRoles(root, func(role Role) {
+			if r, ok := role.(*callRole); ok && len(r.ReturnVar) > 0 && !isTemplated(r.ReturnVar) {
+				l.known[r.ReturnVar] = struct{}{}
+			}
+		})
+	}
+}
+
+// resolveLocal returns the name of the template which expr points to, if it
+// belongs to the repository and revision being checked.
+func (l *linter) resolveLocal(expr string, dir string) (name string, local bool) {
+	if i := strings.Index(expr, "@"); i >= 0 {
+		revision := expr[i+1:]
+		if revision != l.files.Revision && revision != l.files.Hash {
+			return "", false
+		}
+		expr = expr[:i]
+	}
+	if !strings.Contains(expr, "/") {
+		return strings.TrimSuffix(expr, ".yaml"), true
+	}
+	prefix := l.files.RepoIdentifier + "/" + dir + "/"
+	if strings.HasPrefix(expr, prefix) {
+		return strings.TrimSuffix(strings.TrimPrefix(expr, prefix), ".yaml"), true
+	}
+	return "", false
+}
+
+func (l *linter) lintWorkflow(name string) {
+	ctx := &lintContext{
+		binds:     make(map[string]struct{}),
+		globals:   make(map[string]struct{}),
+		connects:  make([]lintConnect, 0),
+		including: []string{name},
+	}
+	l.linted[name] = struct{}{}
+
+	root := l.workflows[name]
+	l.lintRole(ctx, workflowTemplatePath(name), root, "", nil)
+	l.lintChannels(ctx)
+}
+
+func (l *linter) lintRole(ctx *lintContext, file string, role Role, parentPath string, scope *lintScope) {
+	switch r := role.(type) {
+	case *aggregatorRole:
+		path := joinPath(parentPath, r.Name)
+		scope = l.lintRoleBase(ctx, file, &r.roleBase, path, scope)
+		l.lintChildren(ctx, file, r.Roles, path, scope)
+	case *iteratorRole:
+		l.lintIterator(ctx, file, r, parentPath, scope)
+	case *taskRole:
+		path := joinPath(parentPath, r.Name)
+		scope = l.lintRoleBase(ctx, file, &r.roleBase, path, scope)
+		l.lintStrings(file, path, scope, r.Timeout, r.LoadTaskClass)
+		l.lintTaskClass(ctx, file, r.LoadTaskClass, path, scope)
+	case *callRole:
+		path := joinPath(parentPath, r.Name)
+		scope = l.lintRoleBase(ctx, file, &r.roleBase, path, scope)
+		l.lintStrings(file, path, scope, r.Timeout, r.Retries, r.RetryBackoff, r.ReturnVar)
+		if isTemplated(r.FuncCall) {
+			l.lintStrings(file, path, scope, r.FuncCall)
+		} else {
+			l.lintExpression(file, path, scope, r.FuncCall, true)
+		}
+	case *includeRole:
+		path := joinPath(parentPath, r.Name)
+		scope = l.lintRoleBase(ctx, file, &r.roleBase, path, scope)
+		l.lintStrings(file, path, scope, r.Include)
+		l.lintInclude(ctx, file, r.Include, path, scope)
+	}
+}
+
+func (l *linter) lintIterator(ctx *lintContext, file string, r *iteratorRole, parentPath string, scope *lintScope) {
+	switch f := r.For.(type) {
+	case *iteratorRangeExpr:
+		l.lintStrings(file, parentPath, scope, f.Range)
+	case *iteratorRangeFor:
+		l.lintStrings(file, parentPath, scope, f.Begin, f.End)
+	}
+
+	var templateRole Role
+	switch t := r.template.(type) {
+	case *aggregatorTemplate:
+		templateRole = &t.aggregatorRole
+	case *taskTemplate:
+		templateRole = &t.taskRole
+	case *callTemplate:
+		templateRole = &t.callRole
+	case *includeTemplate:
+		templateRole = &t.includeRole
+	default:
+		return
+	}
+	l.lintRole(ctx, file, templateRole, parentPath, scope.with([]string{r.For.GetVar()}))
+}
+
+func (l *linter) lintChildren(ctx *lintContext, file string, roles []Role, path string, scope *lintScope) {
+	names := make(map[string]struct{})
+	for _, role := range roles {
+		if _, ok := role.(*iteratorRole); ok {
+			continue
+		}
+		name := role.GetName()
+		if name == "" || isTemplated(name) {
+			continue
+		}
+		if _, ok := names[name]; ok {
+			l.report(LINT_ERROR, LINT_DUPLICATE_ROLE, file, path,
+				fmt.Sprintf("role name %q is used by more than one child role", name))
+		}
+		names[name] = struct{}{}
+	}
+
+	for _, role := range roles {
+		l.lintRole(ctx, file, role, path, scope)
+	}
+}
+
+// lintRoleBase checks the fields common to all roles, collects their
+// channels, and returns the scope seen by the role's own fields and children.
+func (l *linter) lintRoleBase(ctx *lintContext, file string, r *roleBase, path string, scope *lintScope) *lintScope {
+	scope = scope.with(sortedKeys(r.Defaults.Raw()), sortedKeys(r.Vars.Raw()))
+
+	l.lintStrings(file, path, scope, r.Name, r.Enabled)
+	l.lintStrings(file, path, scope, mapValues(r.Defaults)...)
+	l.lintStrings(file, path, scope, mapValues(r.Vars)...)
+	l.lintConstraints(file, path, scope, r.Constraints)
+	l.lintBinds(ctx, file, path, scope, r.Bind)
+	for _, outbound := range r.Connect {
+		l.lintStrings(file, path, scope, outbound.Name, outbound.Target, outbound.RateLogging)
+		ctx.connects = append(ctx.connects, lintConnect{
+			template: file,
+			rolePath: path,
+			Outbound: outbound,
+		})
+	}
+	return scope
+}
+
+func (l *linter) lintConstraints(file string, path string, scope *lintScope, constraints []constraint.Constraint) {
+	for _, c := range constraints {
+		l.lintStrings(file, path, scope, c.Attribute, c.Value)
+	}
+}
+
+func (l *linter) lintBinds(ctx *lintContext, file string, path string, scope *lintScope, binds []channel.Inbound) {
+	for _, inbound := range binds {
+		l.lintStrings(file, path, scope, inbound.Name, inbound.Global, inbound.Target, inbound.RateLogging)
+
+		if isTemplated(inbound.Name) {
+			ctx.templatedBinds = true
+		} else {
+			ctx.binds[inbound.Name] = struct{}{}
+		}
+		if isTemplated(inbound.Global) {
+			ctx.templatedGlobals = true
+		} else if len(inbound.Global) > 0 {
+			ctx.globals[inbound.Global] = struct{}{}
+		}
+	}
+}
+
+func (l *linter) lintTaskClass(ctx *lintContext, file string, load string, path string, scope *lintScope) {
+	if isTemplated(load) {
+		return
+	}
+	name, local := l.resolveLocal(load, "tasks")
+	if !local {
+		return
+	}
+	class, ok := l.classes[name]
+	if !ok {
+		if _, broken := l.broken[taskTemplatePath(name)]; !broken {
+			l.report(LINT_ERROR, LINT_UNRESOLVED_TASK, file, path,
+				fmt.Sprintf("task template %q not found", load))
+		}
+		return
+	}
+
+	classFile := taskTemplatePath(name)
+	scope = scope.with(sortedKeys(class.Defaults.Raw()), sortedKeys(class.Vars.Raw()), lintTaskVars)
+
+	l.lintStrings(classFile, path, scope, mapValues(class.Defaults)...)
+	l.lintStrings(classFile, path, scope, mapValues(class.Vars)...)
+	l.lintStrings(classFile, path, scope, mapValues(class.Properties)...)
+	if cmd := class.Command; cmd != nil {
+		for _, field := range []*string{cmd.Value, cmd.User, cmd.Stdout, cmd.Stderr} {
+			if field != nil {
+				l.lintStrings(classFile, path, scope, *field)
+			}
+		}
+		l.lintStrings(classFile, path, scope, cmd.Env...)
+		l.lintStrings(classFile, path, scope, cmd.Arguments...)
+	}
+	l.lintConstraints(classFile, path, scope, class.Constraints)
+	l.lintBinds(ctx, classFile, path, scope, class.Bind)
+	for _, outbound := range class.Connect {
+		l.lintStrings(classFile, path, scope, outbound.Name, outbound.RateLogging)
+	}
+}
+
+func (l *linter) lintInclude(ctx *lintContext, file string, include string, path string, scope *lintScope) {
+	if isTemplated(include) {
+		return
+	}
+	name, local := l.resolveLocal(include, "workflows")
+	if !local {
+		return
+	}
+	root, ok := l.workflows[name]
+	if !ok {
+		if _, broken := l.broken[workflowTemplatePath(name)]; !broken {
+			l.report(LINT_ERROR, LINT_UNRESOLVED_INCLUDE, file, path,
+				fmt.Sprintf("included workflow template %q not found", include))
+		}
+		return
+	}
+	if slices.Contains(ctx.including, name) {
+		l.report(LINT_ERROR, LINT_UNRESOLVED_INCLUDE, file, path,
+			fmt.Sprintf("include cycle: %s → %s", strings.Join(ctx.including, " → "), name))
+		return
+	}
+
+	l.linted[name] = struct{}{}
+	ctx.including = append(ctx.including, name)
+	l.lintRole(ctx, workflowTemplatePath(name), root, "", scope)
+	ctx.including = ctx.including[:len(ctx.including)-1]
+}
+
+func (l *linter) lintChannels(ctx *lintContext) {
+	for _, c := range ctx.connects {
+		target := c.Target
+		if len(target) == 0 || strings.HasPrefix(target, "tcp://") || strings.HasPrefix(target, "ipc://") {
+			continue
+		}
+
+		if i := strings.LastIndex(target, ":"); i >= 0 {
+			name := target[i+1:]
+			if isTemplated(name) || strings.Contains(name, "}}") || ctx.templatedBinds {
+				continue
+			}
+			if _, ok := ctx.binds[name]; !ok {
+				l.report(LINT_ERROR, LINT_UNMATCHED_CHANNEL, c.template, c.rolePath,
+					fmt.Sprintf("channel %q connects to %q, but no role binds a channel named %q", c.Name, target, name))
+			}
+		} else {
+			if isTemplated(target) || ctx.templatedGlobals {
+				continue
+			}
+			if _, ok := ctx.globals[target]; !ok {
+				l.report(LINT_ERROR, LINT_UNMATCHED_CHANNEL, c.template, c.rolePath,
+					fmt.Sprintf("channel %q connects to %q, but no role binds a global channel named %q", c.Name, target, target))
+			}
+		}
+	}
+}
+
+func (l *linter) lintStrings(file string, path string, scope *lintScope, fields ...string) {
+	for _, field := range fields {
+		if !isTemplated(field) {
+			continue
+		}
+		tmpl, err := fasttemplate.NewTemplate(field, "{{", "}}")
+		if err != nil {
+			l.report(LINT_ERROR, LINT_INVALID_TEMPLATE, file, path,
+				fmt.Sprintf("%q is not a valid template: %s", field, err.Error()))
+			continue
+		}
+		_, _ = tmpl.ExecuteFunc(io.Discard, func(w io.Writer, tag string) (int, error) {
+			l.lintExpression(file, path, scope, tag, false)
+			return 0, nil
+		})
+	}
+}
+
+// lintExpression checks the identifiers used in an expression. For call
+// expressions, plugin function calls are also checked against the loaded
+// plugins.
+func (l *linter) lintExpression(file string, path string, scope *lintScope, expression string, isCall bool) {
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		l.report(LINT_ERROR, LINT_INVALID_TEMPLATE, file, path,
+			fmt.Sprintf("%q is not a valid expression: %s", strings.TrimSpace(expression), err.Error()))
+		return
+	}
+
+	v := &lintVisitor{
+		identifiers: make([]*ast.IdentifierNode, 0),
+		declared:    make(map[string]struct{}),
+		callees:     make(map[*ast.IdentifierNode]string),
+	}
+	ast.Walk(&tree.Node, v)
+
+	for _, identifier := range v.identifiers {
+		name := identifier.Value
+		if _, ok := v.callees[identifier]; ok && isCall {
+			continue // checked as a plugin call below
+		}
+		if _, ok := v.declared[name]; ok || strings.HasPrefix(name, "__") || scope.has(name) {
+			continue
+		}
+		if _, ok := l.known[name]; ok {
+			continue
+		}
+		l.report(LINT_WARNING, LINT_UNDEFINED_VARIABLE, file, path,
+			fmt.Sprintf("variable %q is not defined in this scope", name))
+	}
+
+	if !isCall || l.opts.PluginCalls == nil {
+		return
+	}
+	for identifier, function := range v.callees {
+		plugin := identifier.Value
+		if _, ok := l.builtins[plugin]; ok {
+			continue
+		}
+		functions, loaded := l.opts.PluginCalls[plugin]
+		if !loaded {
+			l.report(LINT_WARNING, LINT_UNKNOWN_CALL, file, path,
+				fmt.Sprintf("call %s.%s refers to plugin %q, which is not loaded", plugin, function, plugin))
+		} else if functions != nil && !slices.Contains(functions, function) {
+			l.report(LINT_ERROR, LINT_UNKNOWN_CALL, file, path,
+				fmt.Sprintf("plugin %q has no call %q", plugin, function))
+		}
+	}
+}
+
+type lintVisitor struct {
+	identifiers []*ast.IdentifierNode
+	declared    map[string]struct{}
+	callees     map[*ast.IdentifierNode]string // roots of `root.Function()` calls, with the function name
+}
+
+func (v *lintVisitor) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.IdentifierNode:
+		v.identifiers = append(v.identifiers, n)
+	case *ast.VariableDeclaratorNode:
+		v.declared[n.Name] = struct{}{}
+	case *ast.CallNode:
+		member, ok := n.Callee.(*ast.MemberNode)
+		if !ok {
+			return
+		}
+		root, ok := member.Node.(*ast.IdentifierNode)
+		if !ok {
+			return
+		}
+		if property, ok := member.Property.(*ast.StringNode); ok {
+			v.callees[root] = property.Value
+		}
+	}
+}
+
+// walkRoles calls fn on role and all its descendants within the same
+// template, without expanding includes.
+func walkRoles(role Role, fn func(Role)) {
+	fn(role)
+	switch r := role.(type) {
+	case *aggregatorRole:
+		for _, child := range r.Roles {
+			walkRoles(child, fn)
+		}
+	case *iteratorRole:
+		switch t := r.template.(type) {
+		case *aggregatorTemplate:
+			walkRoles(&t.aggregatorRole, fn)
+		case *taskTemplate:
+			fn(&t.taskRole)
+		case *callTemplate:
+			fn(&t.callRole)
+		case *includeTemplate:
+			fn(&t.includeRole)
+		}
+	}
+}
+
+func isTemplated(s string) bool {
+	return strings.Contains(s, "{{")
+}
+
+func joinPath(parentPath string, name string) string {
+	if parentPath == "" {
+		return name
+	}
+	return parentPath + PATH_SEPARATOR + name
+}
+
+func workflowTemplatePath(name string) string {
+	return "workflows/" + name + ".yaml"
+}
+
+func taskTemplatePath(name string) string {
+	return "tasks/" + name + ".yaml"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func mapValues(m gera.Map[string, string]) []string {
+	if m == nil {
+		return nil
+	}
+	raw := m.Raw()
+	values := make([]string, 0, len(raw))
+	for _, k := range sortedKeys(raw) {
+		values = append(values, raw[k])
+	}
+	return values
+}
@@ -0,0 +1,266 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"github.com/AliceO2Group/Control/core/repos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const lintTestReadout = `name: readout
+defaults:
+  readout_cfg_uri: "file:///etc/readout.cfg"
+roles:
+  - name: readout
+    task:
+      load: readout
+  - name: stfb
+    connect:
+      - name: readout
+        type: pull
+        target: "{{ Parent().Path }}.readout:readout"
+    task:
+      load: stfbuilder
+`
+
+const lintTestReadoutTask = `name: readout
+defaults:
+  log_level: info
+command:
+  value: "o2-readout-exe"
+  arguments:
+    - "{{ readout_cfg_uri }}"
+    - "--loglevel={{ log_level }}"
+    - "--id={{ task_id }}"
+bind:
+  - name: readout
+    type: push
+`
+
+const lintTestStfbTask = `name: stfbuilder
+command:
+  value: "StfBuilder"
+  arguments:
+    - "--detector={{ detector }}"
+`
+
+func lintTestFiles() *repos.TemplateFiles {
+	return &repos.TemplateFiles{
+		RepoIdentifier: "github.com/AliceO2Group/ControlWorkflows",
+		Revision:       "master",
+		Hash:           "0123456789abcdef",
+		Workflows: map[string][]byte{
+			"readout": []byte(lintTestReadout),
+		},
+		Tasks: map[string][]byte{
+			"readout":    []byte(lintTestReadoutTask),
+			"stfbuilder": []byte(lintTestStfbTask),
+		},
+	}
+}
+
+func lintTestFinding(check string, template string, rolePath string) OmegaMatcher {
+	return And(
+		HaveField("Check", check),
+		HaveField("Template", template),
+		HaveField("RolePath", rolePath),
+	)
+}
+
+var _ = Describe("workflow template lint", func() {
+	var (
+		files *repos.TemplateFiles
+		opts  LintOptions
+	)
+
+	BeforeEach(func() {
+		files = lintTestFiles()
+		opts = LintOptions{
+			KnownVars: []string{"detector"},
+			PluginCalls: map[string][]string{
+				"testplugin": {"Noop", "Test"},
+				"opaque":     nil,
+			},
+		}
+	})
+
+	It("should accept valid templates", func() {
+		Expect(Lint(files, opts)).To(BeEmpty())
+	})
+
+	It("should report templates which do not parse", func() {
+		files.Workflows["broken"] = []byte("name: broken\nroles: [")
+		files.Tasks["broken"] = []byte("name: [")
+		findings := Lint(files, opts)
+		Expect(findings).To(ConsistOf(
+			lintTestFinding(LINT_INVALID_TEMPLATE, "tasks/broken.yaml", ""),
+			lintTestFinding(LINT_INVALID_TEMPLATE, "workflows/broken.yaml", ""),
+		))
+		Expect(findings[0].Severity).To(Equal(LINT_ERROR))
+	})
+
+	It("should report variables which are not defined in scope", func() {
+		opts.KnownVars = nil
+		files.Workflows["readout"] = []byte(lintTestReadout + `  - name: "{{ undefined_var }}-extra"
+    task:
+      load: readout
+`)
+		findings := Lint(files, opts)
+		Expect(findings).To(ConsistOf(
+			lintTestFinding(LINT_UNDEFINED_VARIABLE, "tasks/stfbuilder.yaml", "readout.stfb"),
+			lintTestFinding(LINT_UNDEFINED_VARIABLE, "workflows/readout.yaml", "readout.{{ undefined_var }}-extra"),
+		))
+		Expect(findings[0].Severity).To(Equal(LINT_WARNING))
+		Expect(findings[0].Message).To(ContainSubstring(`"detector"`))
+	})
+
+	It("should take iterator variables, let bindings and call results into account", func() {
+		files.Workflows["readout"] = []byte(lintTestReadout + `  - name: "stfb-{{ it }}"
+    for:
+      range: "{{ hosts }}"
+      var: it
+    task:
+      load: stfbuilder
+  - name: counter
+    call:
+      func: testplugin.Noop()
+      return: counter_output
+      trigger: CONFIGURE
+  - name: user
+    enabled: "{{ let x = counter_output; x != '' }}"
+    task:
+      load: stfbuilder
+`)
+		opts.KnownVars = append(opts.KnownVars, "hosts")
+		Expect(Lint(files, opts)).To(BeEmpty())
+	})
+
+	It("should report includes and task templates which cannot be resolved", func() {
+		files.Workflows["readout"] = []byte(lintTestReadout + `  - name: missing
+    include: nonexistent
+  - name: elsewhere
+    include: github.com/someone/else/workflows/nonexistent
+  - name: jit
+    include: "{{ dpl.Generate('command') }}"
+  - name: task
+    task:
+      load: nonexistent
+`)
+		Expect(Lint(files, opts)).To(ConsistOf(
+			lintTestFinding(LINT_UNRESOLVED_INCLUDE, "workflows/readout.yaml", "readout.missing"),
+			lintTestFinding(LINT_UNRESOLVED_TASK, "workflows/readout.yaml", "readout.task"),
+		))
+	})
+
+	It("should check included workflows in the context of the including workflow", func() {
+		files.Workflows["main"] = []byte(`name: main
+defaults:
+  detector: TPC
+roles:
+  - name: readout
+    include: readout
+  - name: consumer
+    connect:
+      - name: from-readout
+        type: pull
+        target: "readout.readout:readout"
+    task:
+      load: stfbuilder
+`)
+		opts.KnownVars = nil
+		findings := Lint(files, opts)
+		// readout is included by main, where detector is defined, so it is not checked on its own
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("should report include cycles", func() {
+		files.Workflows["a"] = []byte("name: a\nroles:\n  - name: b\n    include: b\n")
+		files.Workflows["b"] = []byte("name: b\nroles:\n  - name: a\n    include: a\n")
+		findings := Lint(files, opts)
+		Expect(findings).NotTo(BeEmpty())
+		Expect(findings).To(HaveEach(HaveField("Check", LINT_UNRESOLVED_INCLUDE)))
+	})
+
+	It("should report channels which no role binds", func() {
+		files.Workflows["readout"] = []byte(lintTestReadout + `  - name: sink
+    connect:
+      - name: data
+        type: pull
+        target: "{{ Parent().Path }}.readout:nonexistent"
+      - name: global
+        type: pull
+        target: some_global_channel
+      - name: static
+        type: pull
+        target: "tcp://localhost:5555"
+    task:
+      load: stfbuilder
+`)
+		findings := Lint(files, opts)
+		Expect(findings).To(ConsistOf(
+			lintTestFinding(LINT_UNMATCHED_CHANNEL, "workflows/readout.yaml", "readout.sink"),
+			lintTestFinding(LINT_UNMATCHED_CHANNEL, "workflows/readout.yaml", "readout.sink"),
+		))
+	})
+
+	It("should report calls to functions which plugins do not provide", func() {
+		files.Workflows["readout"] = []byte(lintTestReadout + `  - name: calls
+    roles:
+      - name: good
+        call:
+          func: testplugin.Test()
+          trigger: CONFIGURE
+      - name: unknown_function
+        call:
+          func: testplugin.Nonexistent()
+          trigger: CONFIGURE
+      - name: unknown_plugin
+        call:
+          func: nonexistent.Test()
+          trigger: CONFIGURE
+      - name: unlisted
+        call:
+          func: opaque.Anything()
+          trigger: CONFIGURE
+`)
+		findings := Lint(files, opts)
+		Expect(findings).To(ConsistOf(
+			And(lintTestFinding(LINT_UNKNOWN_CALL, "workflows/readout.yaml", "readout.calls.unknown_function"),
+				HaveField("Severity", LINT_ERROR)),
+			And(lintTestFinding(LINT_UNKNOWN_CALL, "workflows/readout.yaml", "readout.calls.unknown_plugin"),
+				HaveField("Severity", LINT_WARNING)),
+		))
+	})
+
+	It("should report duplicate role names", func() {
+		files.Workflows["readout"] = []byte(lintTestReadout + `  - name: stfb
+    task:
+      load: stfbuilder
+`)
+		Expect(Lint(files, opts)).To(ConsistOf(
+			lintTestFinding(LINT_DUPLICATE_ROLE, "workflows/readout.yaml", "readout"),
+		))
+	})
+})
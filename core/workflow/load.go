@@ -48,31 +48,7 @@ type LoadSubworkflowFunc func(workflowPathExpr string, parent Updatable) (root *
 func Load(workflowPath string, parent Updatable, taskManager *task.Manager, userProperties map[string]string, baseConfigStack map[string]string) (workflow Role, err error) {
 	repoManager := the.RepoManager()
 
-	var loadSubworkflow LoadSubworkflowFunc = func(workflowPathExpr string, parent Updatable) (root *aggregatorRole, workflowRepo repos.IRepo, err error) {
-		var resolvedWorkflowPath string
-
-		resolvedWorkflowPath, workflowRepo, err = repoManager.GetWorkflow(workflowPathExpr) //Will fail if repo unknown
-		if err != nil {
-			return
-		}
-
-		var yamlDoc []byte
-		yamlDoc, err = os.ReadFile(resolvedWorkflowPath)
-		if err != nil {
-			return
-		}
-
-		root = new(aggregatorRole)
-		root.parent = parent
-		err = yaml.Unmarshal(yamlDoc, root)
-		if err != nil {
-			return nil, nil, err
-		}
-		if parent != nil {
-			root.setParent(parent)
-		}
-		return
-	}
+	var loadSubworkflow LoadSubworkflowFunc = loadSubworkflowFromRepo
 
 	root, workflowRepo, err := loadSubworkflow(workflowPath, parent)
 	if err != nil {
@@ -145,6 +121,53 @@ func Load(workflowPath string, parent Updatable, taskManager *task.Manager, user
 	return
 }
 
+// loadSubworkflowFromRepo is the default LoadSubworkflowFunc: it resolves workflowPathExpr
+// through the RepoManager, reads the workflow template and unmarshals it into a new root
+// aggregatorRole, reparented to parent if one is provided.
+func loadSubworkflowFromRepo(workflowPathExpr string, parent Updatable) (root *aggregatorRole, workflowRepo repos.IRepo, err error) {
+	var resolvedWorkflowPath string
+
+	resolvedWorkflowPath, workflowRepo, err = the.RepoManager().GetWorkflow(workflowPathExpr) //Will fail if repo unknown
+	if err != nil {
+		return
+	}
+
+	var yamlDoc []byte
+	yamlDoc, err = os.ReadFile(resolvedWorkflowPath)
+	if err != nil {
+		return
+	}
+
+	root, err = parseTemplate(yamlDoc, parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	return
+}
+
+// ParseTemplate unmarshals the workflow template yamlDoc into a new role tree, reparented to
+// parent if one is provided. The templates of the returned tree are not processed yet.
+func ParseTemplate(yamlDoc []byte, parent Updatable) (root Role, err error) {
+	parsed, err := parseTemplate(yamlDoc, parent)
+	if err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+func parseTemplate(yamlDoc []byte, parent Updatable) (root *aggregatorRole, err error) {
+	root = new(aggregatorRole)
+	root.parent = parent
+	err = yaml.Unmarshal(yamlDoc, root)
+	if err != nil {
+		return nil, err
+	}
+	if parent != nil {
+		root.setParent(parent)
+	}
+	return
+}
+
 func LoadDPL(tasks []*taskclass.Class, rootRoleName string, extraVarsMap map[string]string) (workflow Role, err error) {
 	root := new(aggregatorRole)
 
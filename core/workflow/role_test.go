@@ -520,5 +520,37 @@ roles:
 				Expect(cvs["dcs_sor_parameters"]).To(Equal("{}"))
 			})
 		})
+
+		Context("when a call role declares a retry policy", func() {
+			It("should accept retryOn as a list", func() {
+				role := new(callRole)
+				err := yaml.Unmarshal([]byte(`
+name: sor
+call:
+  func: dcs.StartOfRun()
+  trigger: before_START_ACTIVITY
+  retries: 3
+  retryBackoff: 2s
+  retryOn: [UNAVAILABLE, DEADLINE_EXCEEDED]
+`), role)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(role.GetTaskTraits().Retries).To(Equal("3"))
+				Expect(role.GetTaskTraits().RetryBackoff).To(Equal("2s"))
+				Expect(role.GetTaskTraits().RetryOn).To(Equal("UNAVAILABLE,DEADLINE_EXCEEDED"))
+			})
+			It("should accept retryOn as a string", func() {
+				role := new(callRole)
+				err := yaml.Unmarshal([]byte(`
+name: sor
+call:
+  func: dcs.StartOfRun()
+  trigger: before_START_ACTIVITY
+  retryOn: UNAVAILABLE
+`), role)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(role.GetTaskTraits().Retries).To(BeEmpty())
+				Expect(role.GetTaskTraits().RetryOn).To(Equal("UNAVAILABLE"))
+			})
+		})
 	})
 })
@@ -0,0 +1,126 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/event/topic"
+	pb "github.com/AliceO2Group/Control/common/protos"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/spf13/viper"
+)
+
+// roleEventSource is the part of a role which is needed to publish its events.
+type roleEventSource interface {
+	GetName() string
+	GetPath() string
+	GetEnvironmentId() uid.ID
+	GetState() sm.State
+	GetStatus() task.Status
+	SendEvent(event.Event)
+}
+
+type pendingRoleEvent struct {
+	timer     *time.Timer
+	oldState  sm.State
+	oldStatus task.Status
+}
+
+// roleEventDebouncer coalesces the state and status changes of each role which
+// happen within a window starting at the first change, and then publishes a
+// single event with the state and status of the role at the end of the window.
+// Nothing is published if the role is back to where it was at the first change.
+type roleEventDebouncer struct {
+	mu      sync.Mutex
+	pending map[roleEventSource]*pendingRoleEvent
+	window  func() time.Duration
+	publish func(r roleEventSource)
+}
+
+var roleEvents = newRoleEventDebouncer(
+	func() time.Duration { return viper.GetDuration("roleEventDebounce") },
+	publishRoleEvent,
+)
+
+func newRoleEventDebouncer(window func() time.Duration, publish func(r roleEventSource)) *roleEventDebouncer {
+	return &roleEventDebouncer{
+		pending: make(map[roleEventSource]*pendingRoleEvent),
+		window:  window,
+		publish: publish,
+	}
+}
+
+// changed must be called after a state or status change of r, with the state
+// and status r had before the change.
+func (d *roleEventDebouncer) changed(r roleEventSource, oldState sm.State, oldStatus task.Status) {
+	window := d.window()
+	if window <= 0 {
+		d.publish(r)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.pending[r]; ok {
+		return
+	}
+	pending := &pendingRoleEvent{oldState: oldState, oldStatus: oldStatus}
+	pending.timer = time.AfterFunc(window, func() {
+		d.mu.Lock()
+		delete(d.pending, r)
+		d.mu.Unlock()
+
+		if r.GetState() == pending.oldState && r.GetStatus() == pending.oldStatus {
+			return
+		}
+		d.publish(r)
+	})
+	d.pending[r] = pending
+}
+
+func publishRoleEvent(r roleEventSource) {
+	state, status := r.GetState().String(), r.GetStatus().String()
+	envId := r.GetEnvironmentId().String()
+
+	the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
+		Name:          r.GetName(),
+		Status:        status,
+		State:         state,
+		RolePath:      r.GetPath(),
+		EnvironmentId: envId,
+	})
+	r.SendEvent(&event.RoleEvent{
+		Name:          r.GetName(),
+		Status:        status,
+		State:         state,
+		RolePath:      r.GetPath(),
+		EnvironmentId: envId,
+	})
+}
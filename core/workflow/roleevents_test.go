@@ -0,0 +1,130 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/common/utils/uid"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/sm"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeRoleEventSource struct {
+	mu     sync.Mutex
+	state  sm.State
+	status task.Status
+}
+
+func (f *fakeRoleEventSource) GetName() string          { return "fake" }
+func (f *fakeRoleEventSource) GetPath() string          { return "root.fake" }
+func (f *fakeRoleEventSource) GetEnvironmentId() uid.ID { return uid.NilID() }
+func (f *fakeRoleEventSource) SendEvent(event.Event)    {}
+
+func (f *fakeRoleEventSource) GetState() sm.State {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.state
+}
+
+func (f *fakeRoleEventSource) GetStatus() task.Status {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.status
+}
+
+func (f *fakeRoleEventSource) setState(s sm.State) (old sm.State) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	old, f.state = f.state, s
+	return
+}
+
+var _ = Describe("role event debouncer", func() {
+	var (
+		mu        sync.Mutex
+		published []sm.State
+		window    time.Duration
+		d         *roleEventDebouncer
+		role      *fakeRoleEventSource
+	)
+
+	publishedStates := func() []sm.State {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]sm.State{}, published...)
+	}
+
+	change := func(s sm.State) {
+		old := role.setState(s)
+		d.changed(role, old, role.GetStatus())
+	}
+
+	BeforeEach(func() {
+		published = nil
+		window = 50 * time.Millisecond
+		d = newRoleEventDebouncer(
+			func() time.Duration { return window },
+			func(r roleEventSource) {
+				mu.Lock()
+				defer mu.Unlock()
+				published = append(published, r.GetState())
+			},
+		)
+		role = &fakeRoleEventSource{state: sm.STANDBY, status: task.ACTIVE}
+	})
+
+	It("should coalesce changes within the window into one event with the latest state", func() {
+		change(sm.MIXED)
+		change(sm.CONFIGURED)
+		change(sm.RUNNING)
+		Consistently(publishedStates, 30*time.Millisecond).Should(BeEmpty())
+		Eventually(publishedStates).Should(Equal([]sm.State{sm.RUNNING}))
+		Consistently(publishedStates, 100*time.Millisecond).Should(HaveLen(1))
+	})
+
+	It("should publish nothing if the role is back to its state before the window", func() {
+		change(sm.ERROR)
+		change(sm.STANDBY)
+		Consistently(publishedStates, 150*time.Millisecond).Should(BeEmpty())
+	})
+
+	It("should start a new window for changes after the previous one closed", func() {
+		change(sm.CONFIGURED)
+		Eventually(publishedStates).Should(HaveLen(1))
+		change(sm.RUNNING)
+		Eventually(publishedStates).Should(Equal([]sm.State{sm.CONFIGURED, sm.RUNNING}))
+	})
+
+	It("should publish every change immediately when the window is 0", func() {
+		window = 0
+		change(sm.CONFIGURED)
+		change(sm.RUNNING)
+		Expect(publishedStates()).To(Equal([]sm.State{sm.CONFIGURED, sm.RUNNING}))
+	})
+})
@@ -25,6 +25,8 @@
 package workflow
 
 import (
+	"sort"
+
 	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/constraint"
@@ -145,3 +147,28 @@ func GetActiveTasks(r Role) task.Tasks {
 		return false
 	})
 }
+
+// GetDetectors returns the sorted set of values of the "detector" variable of the enabled
+// task and call roles in the role tree of r.
+func GetDetectors(r Role) []string {
+	detectorSet := make(map[string]struct{})
+	LeafWalk(r, func(role Role) {
+		if !role.IsEnabled() {
+			return
+		}
+		varStack, err := role.ConsolidatedVarStack()
+		if err != nil {
+			return
+		}
+		if detector := varStack["detector"]; len(detector) != 0 {
+			detectorSet[detector] = struct{}{}
+		}
+	})
+
+	detectors := make([]string, 0, len(detectorSet))
+	for detector := range detectorSet {
+		detectors = append(detectors, detector)
+	}
+	sort.Strings(detectors)
+	return detectors
+}
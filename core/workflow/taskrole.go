@@ -33,9 +33,6 @@ import (
 	"github.com/AliceO2Group/Control/common/logger/infologger"
 	"github.com/AliceO2Group/Control/core/task/sm"
 
-	"github.com/AliceO2Group/Control/common/event"
-	"github.com/AliceO2Group/Control/common/event/topic"
-	pb "github.com/AliceO2Group/Control/common/protos"
 	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task"
@@ -225,14 +222,8 @@ func (t *taskRole) updateStatus(s task.Status) {
 	t.status.merge(s, t)
 
 	if oldStatus != t.status.get() {
-		the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
-			Name:          t.Name,
-			Status:        t.status.get().String(),
-			RolePath:      t.GetPath(),
-			EnvironmentId: t.GetEnvironmentId().String(),
-		})
+		roleEvents.changed(t, t.state.get(), oldStatus)
 	}
-	t.SendEvent(&event.RoleEvent{Name: t.Name, Status: t.status.get().String(), RolePath: t.GetPath()})
 
 	t.parent.updateStatus(s)
 }
@@ -250,12 +241,7 @@ func (t *taskRole) updateState(s sm.State) {
 		Tracef("updated state to %s upon input state %s", t.state.get().String(), s.String())
 
 	if oldState != t.state.get() {
-		the.EventWriterWithTopic(topic.Role).WriteEvent(&pb.Ev_RoleEvent{
-			Name:          t.Name,
-			State:         t.state.get().String(),
-			RolePath:      t.GetPath(),
-			EnvironmentId: t.GetEnvironmentId().String(),
-		})
+		roleEvents.changed(t, oldState, t.status.get())
 		if t.state.get() == sm.ERROR {
 			host := "unknown"
 			if t.Task != nil {
@@ -273,7 +259,6 @@ func (t *taskRole) updateState(s sm.State) {
 
 		}
 	}
-	t.SendEvent(&event.RoleEvent{Name: t.Name, State: t.state.get().String(), RolePath: t.GetPath()})
 
 	if t.Critical == true {
 		t.parent.updateState(s)
@@ -30,6 +30,7 @@ import (
 	"errors"
 	"io"
 	"os/exec"
+	"sync"
 	"syscall"
 	"time"
 
@@ -69,6 +70,7 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 	if t.taskCmd == nil {
 		return errors.New("could not instantiate basic task command")
 	}
+	cgroup := t.prepareTaskCgroup(t.taskCmd)
 
 	// Set up pipes for controlled process
 	var errStdout, errStderr error
@@ -86,6 +88,10 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 		t.Tci.Stderr = &none
 	}
 
+	// stdout and stderr can share the same log file, which we close once both are done
+	logFile := t.openTaskLogFile()
+	copyWg := sync.WaitGroup{}
+
 	switch *t.Tci.Stdout {
 	case "stdout":
 		stdoutLog = log.WithPrefix("task-stdout").
@@ -108,6 +114,13 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 			WriterLevel(logrus.TraceLevel)
 		stdout = io.MultiWriter(stdoutLog, &stdoutBuf)
 
+	case "file":
+		if logFile != nil {
+			stdout = io.MultiWriter(logFile, &stdoutBuf)
+		} else {
+			stdout = &stdoutBuf
+		}
+
 	default:
 		// Nothing goes to the log, we go straight to the buffer
 		stdout = &stdoutBuf
@@ -136,6 +149,13 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 
 		stderr = io.MultiWriter(stderrLog, &stderrBuf)
 
+	case "file":
+		if logFile != nil {
+			stderr = io.MultiWriter(logFile, &stderrBuf)
+		} else {
+			stderr = &stderrBuf
+		}
+
 	default:
 		// Nothing goes to the log, we go straight to the buffer
 		stderr = &stderrBuf
@@ -156,6 +176,10 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 			Error("failed to run basic task")
 
 		closePipeWriters(stdoutLog, stderrLog)
+		t.releaseTaskCgroup(cgroup)
+		if logFile != nil {
+			_ = logFile.Close()
+		}
 
 		return err
 	}
@@ -164,12 +188,21 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 		WithField("task", t.ti.Name).
 		Debug("basic task started")
 
+	copyWg.Add(2)
 	go func() {
+		defer copyWg.Done()
 		_, errStdout = io.Copy(stdout, stdoutIn)
 	}()
 	go func() {
+		defer copyWg.Done()
 		_, errStderr = io.Copy(stderr, stderrIn)
 	}()
+	go func() {
+		copyWg.Wait()
+		if logFile != nil {
+			_ = logFile.Close()
+		}
+	}()
 
 	go func() {
 		taskCmd := t.taskCmd
@@ -177,6 +210,7 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 		// ^ when this unblocks, the task is done
 
 		closePipeWriters(stdoutLog, stderrLog)
+		t.releaseTaskCgroup(cgroup)
 
 		pendingState := mesos.TASK_FINISHED
 		var tciCommandStr string
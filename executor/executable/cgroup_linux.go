@@ -0,0 +1,208 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+)
+
+const (
+	// CGROUP_ROOT_ENV is set by the core in the environment of the executor, and contains the path of the
+	// cgroup v2 directory under which each task gets its own cgroup. An empty value disables cgroups.
+	CGROUP_ROOT_ENV = "O2_EXECUTOR_CGROUP_ROOT"
+
+	cgroupCpuPeriod = 100000 // µs, the kernel default for cpu.max
+	cgroupV2Marker  = "/sys/fs/cgroup/cgroup.controllers"
+)
+
+// taskCgroup is a cgroup v2 directory which contains a single task and all of its children.
+// It is created with cpu.max and memory.max derived from the limits in the TaskInfo, and
+// the task process is cloned straight into it.
+type taskCgroup struct {
+	path string
+	dir  *os.File
+}
+
+func newTaskCgroup(ti *mesos.TaskInfo) (*taskCgroup, error) {
+	root := os.Getenv(CGROUP_ROOT_ENV)
+	if root == "" {
+		return nil, nil
+	}
+	if _, err := os.Stat(cgroupV2Marker); err != nil {
+		return nil, errors.New("cgroup v2 unified hierarchy not available")
+	}
+
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("cannot create cgroup root %s: %w", root, err)
+	}
+	// The root has no processes of its own, so we can delegate the controllers to its children.
+	// If they are already enabled, or cannot be, creating the task cgroup will tell us.
+	_ = os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte("+cpu +memory"), 0644)
+
+	path := filepath.Join(root, "task-"+sanitizeCgroupName(ti.TaskID.GetValue()))
+	if err := os.Mkdir(path, 0755); err != nil && !os.IsExist(err) {
+		return nil, fmt.Errorf("cannot create cgroup %s: %w", path, err)
+	}
+
+	cg := &taskCgroup{path: path}
+	cpus, mem := getTaskLimits(ti)
+	settings := [][2]string{
+		{"cpu.max", cgroupCpuMax(cpus)},
+		{"memory.max", cgroupMemoryMax(mem)},
+		// if the task goes over its memory limit, the OOM killer takes down the whole process tree
+		{"memory.oom.group", "1"},
+	}
+	for _, s := range settings {
+		if err := cg.write(s[0], s[1]); err != nil {
+			cg.release()
+			return nil, err
+		}
+	}
+	if !math.IsInf(mem, 1) {
+		// best effort: without this, a memory limited task might be pushed to swap instead of being OOM killed
+		_ = cg.write("memory.swap.max", "0")
+	}
+
+	dir, err := os.Open(path)
+	if err != nil {
+		cg.release()
+		return nil, fmt.Errorf("cannot open cgroup %s: %w", path, err)
+	}
+	cg.dir = dir
+	return cg, nil
+}
+
+func (cg *taskCgroup) write(file string, value string) error {
+	err := os.WriteFile(filepath.Join(cg.path, file), []byte(value), 0644)
+	if err != nil {
+		return fmt.Errorf("cannot set %s=%s in cgroup %s: %w", file, value, cg.path, err)
+	}
+	return nil
+}
+
+// apply makes taskCmd start directly inside the cgroup, so that no child process can escape it.
+func (cg *taskCgroup) apply(taskCmd *exec.Cmd) {
+	if cg == nil || cg.dir == nil {
+		return
+	}
+	taskCmd.SysProcAttr.UseCgroupFD = true
+	taskCmd.SysProcAttr.CgroupFD = int(cg.dir.Fd())
+}
+
+// oomKillCount returns how many processes of the cgroup were killed by the OOM killer.
+func (cg *taskCgroup) oomKillCount() uint64 {
+	if cg == nil {
+		return 0
+	}
+	file, err := os.Open(filepath.Join(cg.path, "memory.events"))
+	if err != nil {
+		return 0
+	}
+	defer file.Close()
+	return parseOomKillCount(bufio.NewScanner(file))
+}
+
+func (cg *taskCgroup) memoryMax() uint64 {
+	if cg == nil {
+		return 0
+	}
+	data, err := os.ReadFile(filepath.Join(cg.path, "memory.max"))
+	if err != nil {
+		return 0
+	}
+	value, _ := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	return value // 0 if "max"
+}
+
+// release removes the cgroup, which only succeeds once all the processes in it are gone.
+func (cg *taskCgroup) release() error {
+	if cg == nil {
+		return nil
+	}
+	if cg.dir != nil {
+		_ = cg.dir.Close()
+		cg.dir = nil
+	}
+	return os.Remove(cg.path)
+}
+
+func getTaskLimits(ti *mesos.TaskInfo) (cpus float64, mem float64) {
+	cpus, mem = math.Inf(1), math.Inf(1)
+	if limit, ok := ti.GetLimits()["cpus"]; ok && limit.GetValue() > 0 {
+		cpus = limit.GetValue()
+	}
+	if limit, ok := ti.GetLimits()["mem"]; ok && limit.GetValue() > 0 {
+		mem = limit.GetValue()
+	}
+	return
+}
+
+func cgroupCpuMax(cpus float64) string {
+	if math.IsInf(cpus, 1) {
+		return fmt.Sprintf("max %d", cgroupCpuPeriod)
+	}
+	quota := int64(math.Ceil(cpus * cgroupCpuPeriod))
+	if quota < 1000 { // the kernel rejects quotas under 1ms
+		quota = 1000
+	}
+	return fmt.Sprintf("%d %d", quota, cgroupCpuPeriod)
+}
+
+// cgroupMemoryMax converts a Mesos mem value (MB) to memory.max (bytes)
+func cgroupMemoryMax(mem float64) string {
+	if math.IsInf(mem, 1) {
+		return "max"
+	}
+	return strconv.FormatUint(uint64(mem*1024*1024), 10)
+}
+
+func parseOomKillCount(scanner *bufio.Scanner) uint64 {
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "oom_kill" {
+			count, _ := strconv.ParseUint(fields[1], 10, 64)
+			return count
+		}
+	}
+	return 0
+}
+
+func sanitizeCgroupName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\n' || r == 0 {
+			return '_'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,67 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"bufio"
+	"math"
+	"strings"
+
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("task cgroup", func() {
+	When("converting task limits", func() {
+		It("should derive cpu.max from the cpus limit", func() {
+			Expect(cgroupCpuMax(math.Inf(1))).To(Equal("max 100000"))
+			Expect(cgroupCpuMax(1.5)).To(Equal("150000 100000"))
+			Expect(cgroupCpuMax(0.001)).To(Equal("1000 100000"))
+		})
+		It("should derive memory.max from the mem limit in MB", func() {
+			Expect(cgroupMemoryMax(math.Inf(1))).To(Equal("max"))
+			Expect(cgroupMemoryMax(512)).To(Equal("536870912"))
+		})
+		It("should treat missing or infinite limits as unlimited", func() {
+			ti := &mesos.TaskInfo{Limits: map[string]mesos.Value_Scalar{
+				"cpus": {Value: 2},
+				"mem":  {Value: math.Inf(1)},
+			}}
+			cpus, mem := getTaskLimits(ti)
+			Expect(cpus).To(Equal(2.0))
+			Expect(math.IsInf(mem, 1)).To(BeTrue())
+
+			cpus, _ = getTaskLimits(&mesos.TaskInfo{})
+			Expect(math.IsInf(cpus, 1)).To(BeTrue())
+		})
+	})
+	When("reading memory.events", func() {
+		It("should return the oom_kill counter", func() {
+			events := "low 0\nhigh 0\nmax 12\noom 2\noom_kill 1\noom_group_kill 1\n"
+			Expect(parseOomKillCount(bufio.NewScanner(strings.NewReader(events)))).To(Equal(uint64(1)))
+			Expect(parseOomKillCount(bufio.NewScanner(strings.NewReader("low 0\n")))).To(BeZero())
+		})
+	})
+})
@@ -32,6 +32,7 @@ import (
 	"os/exec"
 	"reflect"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -112,8 +113,10 @@ func (t *ControllableTask) Launch() error {
 	// Control returns to the event loop which can safely access *internalState.
 	// Anything in the following goroutine must not touch *internalState, except
 	// via channels.
+	cgroup := t.prepareTaskCgroup(taskCmd)
+
 	go func() {
-		t.doLaunchTask(taskCmd, launchStartTime)
+		t.doLaunchTask(taskCmd, cgroup, launchStartTime)
 	}()
 
 	log.WithFields(defaultLogFields).
@@ -122,7 +125,7 @@ func (t *ControllableTask) Launch() error {
 	return nil
 }
 
-func (t *ControllableTask) doLaunchTask(taskCmd *exec.Cmd, launchStartTime time.Time) {
+func (t *ControllableTask) doLaunchTask(taskCmd *exec.Cmd, cgroup *taskCgroup, launchStartTime time.Time) {
 	defaultLogFields := logrus.Fields{
 		"taskId":    t.ti.TaskID.GetValue(),
 		"taskName":  t.ti.Name,
@@ -148,6 +151,7 @@ func (t *ControllableTask) doLaunchTask(taskCmd *exec.Cmd, launchStartTime time.
 			Error("failed to run task")
 
 		t.sendStatus(t.knownEnvironmentId, mesos.TASK_FAILED, err.Error())
+		t.releaseTaskCgroup(cgroup)
 		// no need to close IO pipes, Cmd.Start does it on failure
 		return
 	}
@@ -163,7 +167,9 @@ func (t *ControllableTask) doLaunchTask(taskCmd *exec.Cmd, launchStartTime time.
 	// We start to Wait() for the result already, so we have access to ProcessState on an early failure
 	t.taskDoneCh = make(chan error, 1)
 	go func() {
-		t.taskDoneCh <- taskCmd.Wait()
+		err := taskCmd.Wait()
+		t.releaseTaskCgroup(cgroup)
+		t.taskDoneCh <- err
 	}()
 
 	log.WithFields(defaultLogFields).
@@ -376,7 +382,19 @@ func (t *ControllableTask) initTaskStdLogging(stdoutIn io.ReadCloser, stderrIn i
 		t.Tci.Stderr = &none
 	}
 
+	// stdout and stderr can share the same log file, which we close once both are done
+	logFile := t.openTaskLogFile()
+	copyWg := sync.WaitGroup{}
+	copyWg.Add(2)
 	go func() {
+		copyWg.Wait()
+		if logFile != nil {
+			_ = logFile.Close()
+		}
+	}()
+
+	go func() {
+		defer copyWg.Done()
 		var errStdout error
 		switch *t.Tci.Stdout {
 		case "stdout":
@@ -400,6 +418,12 @@ func (t *ControllableTask) initTaskStdLogging(stdoutIn io.ReadCloser, stderrIn i
 			}
 			_, errStdout = io.Copy(writer, stdoutIn)
 			writer.Flush()
+		case "file":
+			if logFile != nil {
+				_, errStdout = io.Copy(logFile, stdoutIn)
+			} else {
+				_, errStdout = io.Copy(io.Discard, stdoutIn)
+			}
 		default:
 			_, errStdout = io.Copy(io.Discard, stdoutIn)
 		}
@@ -412,6 +436,7 @@ func (t *ControllableTask) initTaskStdLogging(stdoutIn io.ReadCloser, stderrIn i
 	}()
 
 	go func() {
+		defer copyWg.Done()
 		var errStderr error
 		switch *t.Tci.Stderr {
 		case "stdout":
@@ -435,6 +460,12 @@ func (t *ControllableTask) initTaskStdLogging(stdoutIn io.ReadCloser, stderrIn i
 			}
 			_, errStderr = io.Copy(writer, stderrIn)
 			writer.Flush()
+		case "file":
+			if logFile != nil {
+				_, errStderr = io.Copy(logFile, stderrIn)
+			} else {
+				_, errStderr = io.Copy(io.Discard, stderrIn)
+			}
 		default:
 			_, errStderr = io.Copy(io.Discard, stderrIn)
 		}
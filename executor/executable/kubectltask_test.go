@@ -25,6 +25,7 @@
 package executable
 
 import (
+	"os/exec"
 	"testing"
 	"time"
 
@@ -41,6 +42,11 @@ var _ = Describe("kubectl task test", func() {
 	task.Tci.Arguments = []string{"exampletask.yaml"}
 	task.configYaml = "exampletask.yaml"
 	task.ti = &mesos.TaskInfo{Name: "exampletask"}
+	BeforeEach(func() {
+		if _, err := exec.LookPath("kubectl"); err != nil {
+			Skip("kubectl not found in PATH")
+		}
+	})
 	When("starting and stoping the task", func() {
 		It("should start and stop accordingly", func() {
 			err := task.Launch()
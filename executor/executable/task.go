@@ -43,10 +43,12 @@ import (
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/executor/executorcmd"
 	"github.com/AliceO2Group/Control/executor/executorutil"
+	pb "github.com/AliceO2Group/Control/executor/protos"
 	mesos "github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/sirupsen/logrus"
 )
@@ -258,3 +260,58 @@ func prepareTaskCmd(commandInfo *common.TaskCommandInfo) (*exec.Cmd, error) {
 
 	return taskCmd, nil
 }
+
+// prepareTaskCgroup creates a cgroup for the task, with the resource limits from its TaskInfo,
+// and makes taskCmd start inside it. If cgroups cannot be used on this host, the task runs
+// without limits.
+func (t *taskBase) prepareTaskCgroup(taskCmd *exec.Cmd) *taskCgroup {
+	cgroup, err := newTaskCgroup(t.ti)
+	if err != nil {
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithField("taskId", t.ti.TaskID.GetValue()).
+			WithField(infologger.Level, infologger.IL_Devel).
+			WithError(err).
+			Warning("cannot set up cgroup for task, resource limits will not be enforced")
+		return nil
+	}
+	cgroup.apply(taskCmd)
+	return cgroup
+}
+
+// releaseTaskCgroup must be called once the task process is done. If the task was killed for
+// going over its memory limit, a TASK_OOM_KILLED DeviceEvent is sent to the core.
+func (t *taskBase) releaseTaskCgroup(cgroup *taskCgroup) {
+	if cgroup == nil {
+		return
+	}
+
+	if oomKills := cgroup.oomKillCount(); oomKills > 0 {
+		taskClassName, _ := utils.ExtractTaskClassName(t.ti.Name)
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithField(infologger.Level, infologger.IL_Ops).
+			Errorf("task '%s' was killed for exceeding its memory limit", utils.TrimJitPrefix(taskClassName))
+
+		deo := event.DeviceEventOrigin{
+			AgentId:    t.ti.AgentID,
+			ExecutorId: t.ti.GetExecutor().ExecutorID,
+			TaskId:     t.ti.TaskID,
+		}
+		deviceEvent := event.NewDeviceEvent(deo, pb.DeviceEventType_TASK_OOM_KILLED)
+		if oom, ok := deviceEvent.(*event.TaskOOMKilled); ok {
+			oom.OomKillCount = oomKills
+			oom.MemoryLimit = cgroup.memoryMax()
+			oom.SetLabels(map[string]string{"detector": t.knownDetector, "environmentId": t.knownEnvironmentId.String()})
+			t.sendDeviceEvent(t.knownEnvironmentId, oom)
+		}
+	}
+
+	if err := cgroup.release(); err != nil {
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("taskId", t.ti.TaskID.GetValue()).
+			WithField(infologger.Level, infologger.IL_Devel).
+			WithError(err).
+			Debug("cannot remove task cgroup")
+	}
+}
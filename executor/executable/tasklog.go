@@ -0,0 +1,247 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	TASK_LOG_MAX_SIZE    = 10 * 1024 * 1024 // bytes per file, before rotation
+	TASK_LOG_MAX_BACKUPS = 3                // rotated files kept next to the current one
+	TASK_LOG_MAX_FETCH   = 1024 * 1024      // bytes returned by a single ReadTaskLog
+)
+
+var taskLogDir = "tasklogs"
+
+// SetTaskLogDirectory sets the directory in which the output of tasks launched with
+// stdout or stderr set to "file" is written, normally within the executor sandbox.
+func SetTaskLogDirectory(dir string) {
+	taskLogDir = dir
+}
+
+func taskLogPath(taskId string) string {
+	return filepath.Join(taskLogDir, sanitizeTaskLogName(taskId)+".log")
+}
+
+func sanitizeTaskLogName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == os.PathSeparator || r == 0 {
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+// rotatingFile is a thread-safe io.WriteCloser which appends to a file, and renames it
+// to <name>.1 (shifting the previous backups) once it would grow beyond maxSize.
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	rf := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *rotatingFile) open() error {
+	file, err := os.OpenFile(rf.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	rf.file = file
+	rf.size = info.Size()
+	return nil
+}
+
+func (rf *rotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return err
+	}
+	for i := rf.maxBackups - 1; i >= 1; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", rf.path, i), fmt.Sprintf("%s.%d", rf.path, i+1))
+	}
+	if rf.maxBackups > 0 {
+		if err := os.Rename(rf.path, rf.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(rf.path); err != nil {
+		return err
+	}
+	return rf.open()
+}
+
+func (rf *rotatingFile) Write(p []byte) (n int, err error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.file == nil {
+		return 0, os.ErrClosed
+	}
+	if rf.size > 0 && rf.size+int64(len(p)) > rf.maxSize {
+		if err = rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err = rf.file.Write(p)
+	rf.size += int64(n)
+	return
+}
+
+func (rf *rotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
+
+// ReadTaskLog returns the output of a task launched with stdout or stderr set to "file".
+// If lines is greater than 0, only the last lines are returned. In any case no more than
+// maxBytes are returned, and truncated is set if older output had to be left out.
+func ReadTaskLog(taskId string, lines int, maxBytes int) (logs string, truncated bool, err error) {
+	path := taskLogPath(taskId)
+	if _, err = os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = fmt.Errorf("no log file for task %s, was it launched with stdout or stderr set to file?", taskId)
+		}
+		return
+	}
+
+	// newest first: the current file, then <path>.1, <path>.2, ...
+	files := []string{path}
+	for i := 1; i <= TASK_LOG_MAX_BACKUPS; i++ {
+		backup := fmt.Sprintf("%s.%d", path, i)
+		if _, statErr := os.Stat(backup); statErr != nil {
+			break
+		}
+		files = append(files, backup)
+	}
+
+	var data []byte
+	for i, file := range files {
+		var chunk []byte
+		chunk, truncated, err = readFileTail(file, maxBytes-len(data))
+		if err != nil {
+			return
+		}
+		data = append(chunk, data...)
+		if truncated || len(data) >= maxBytes {
+			truncated = truncated || i < len(files)-1
+			break
+		}
+		if lines > 0 && bytes.Count(data, []byte("\n")) > lines {
+			break
+		}
+	}
+
+	if lines > 0 {
+		trimmed := bytes.TrimSuffix(data, []byte("\n"))
+		idx := len(trimmed)
+		for i := 0; i < lines && idx >= 0; i++ {
+			idx = bytes.LastIndexByte(trimmed[:idx], '\n')
+		}
+		if idx >= 0 {
+			data = data[idx+1:]
+			truncated = false // we returned exactly what was asked for
+		}
+	}
+
+	return string(data), truncated, nil
+}
+
+// readFileTail reads at most maxBytes from the end of a file.
+func readFileTail(path string, maxBytes int) (data []byte, truncated bool, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, false, err
+	}
+	offset := int64(0)
+	if info.Size() > int64(maxBytes) {
+		offset = info.Size() - int64(maxBytes)
+		truncated = true
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return nil, false, err
+	}
+	data, err = io.ReadAll(io.LimitReader(file, int64(maxBytes)))
+	return data, truncated, err
+}
+
+// openTaskLogFile opens the log file of the task if its stdout or stderr is set to "file".
+// It returns nil if neither is, or if the file cannot be created.
+func (t *taskBase) openTaskLogFile() *rotatingFile {
+	if (t.Tci.Stdout == nil || *t.Tci.Stdout != "file") &&
+		(t.Tci.Stderr == nil || *t.Tci.Stderr != "file") {
+		return nil
+	}
+	logFile, err := newRotatingFile(taskLogPath(t.ti.TaskID.GetValue()), TASK_LOG_MAX_SIZE, TASK_LOG_MAX_BACKUPS)
+	if err != nil {
+		log.WithField("partition", t.knownEnvironmentId.String()).
+			WithField("detector", t.knownDetector).
+			WithField("taskId", t.ti.TaskID.GetValue()).
+			WithError(err).
+			Warning("cannot create task log file, task output will be discarded")
+		return nil
+	}
+	return logFile
+}
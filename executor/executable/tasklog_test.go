@@ -0,0 +1,107 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2026 CERN and copyright holders of ALICE O².
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("task log files", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		SetTaskLogDirectory(dir)
+	})
+
+	When("writing a task log", func() {
+		It("should rotate the file once it exceeds its maximum size", func() {
+			rf, err := newRotatingFile(taskLogPath("task1"), 16, 2)
+			Expect(err).NotTo(HaveOccurred())
+			for i := 0; i < 4; i++ {
+				_, err = fmt.Fprintf(rf, "line %04d\n", i)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(rf.Close()).To(Succeed())
+
+			current, err := os.ReadFile(filepath.Join(dir, "task1.log"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(current)).To(Equal("line 0003\n"))
+			backup, err := os.ReadFile(filepath.Join(dir, "task1.log.2"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(backup)).To(Equal("line 0001\n"))
+			Expect(filepath.Join(dir, "task1.log.3")).NotTo(BeAnExistingFile())
+		})
+		It("should refuse writes after being closed", func() {
+			rf, err := newRotatingFile(taskLogPath("task1"), 1024, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rf.Close()).To(Succeed())
+			_, err = rf.Write([]byte("late\n"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("reading a task log", func() {
+		BeforeEach(func() {
+			rf, err := newRotatingFile(taskLogPath("task1"), 20, TASK_LOG_MAX_BACKUPS)
+			Expect(err).NotTo(HaveOccurred())
+			for i := 0; i < 6; i++ {
+				_, err = fmt.Fprintf(rf, "line %04d\n", i)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(rf.Close()).To(Succeed())
+		})
+		It("should return the output across rotated files, oldest first", func() {
+			logs, truncated, err := ReadTaskLog("task1", 0, TASK_LOG_MAX_FETCH)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(truncated).To(BeFalse())
+			Expect(strings.Split(strings.TrimSpace(logs), "\n")).To(Equal([]string{
+				"line 0000", "line 0001", "line 0002", "line 0003", "line 0004", "line 0005",
+			}))
+		})
+		It("should return only the last lines if asked to", func() {
+			logs, truncated, err := ReadTaskLog("task1", 3, TASK_LOG_MAX_FETCH)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(truncated).To(BeFalse())
+			Expect(logs).To(Equal("line 0003\nline 0004\nline 0005\n"))
+		})
+		It("should truncate older output beyond the size limit", func() {
+			logs, truncated, err := ReadTaskLog("task1", 0, 25)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(truncated).To(BeTrue())
+			Expect(logs).To(HaveLen(25))
+			Expect(logs).To(HaveSuffix("line 0004\nline 0005\n"))
+		})
+		It("should fail for tasks without a log file", func() {
+			_, _, err := ReadTaskLog("task2", 0, TASK_LOG_MAX_FETCH)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
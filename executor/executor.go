@@ -36,6 +36,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
@@ -163,6 +164,7 @@ func Run(cfg config.Config) {
 
 	// Chmod executor directory from 750 to 755 because of OCTRL-766
 	_ = syscall.Chmod(cfg.Directory, 0755)
+	executable.SetTaskLogDirectory(filepath.Join(cfg.Directory, "tasklogs"))
 
 	// Main loop for (re)subscription. Once we're subscribed, we jump into the event loop for handling the agent.
 	for {
@@ -239,6 +239,45 @@ func handleMessageEvent(state *internalState, data []byte) (err error) {
 			}
 		}()
 
+	case "MesosCommand_GetTaskLogs":
+		// The task doesn't need to be active, its log file outlives it
+		go func() {
+			var cmd = new(controlcommands.MesosCommand_GetTaskLogs)
+			err = json.Unmarshal(data, cmd)
+			if err != nil {
+				log.WithFields(logrus.Fields{
+					"name":    incoming.Name,
+					"message": string(data[:]),
+					"error":   err.Error(),
+				}).
+					Error("cannot unmarshal incoming MESSAGE")
+				return
+			}
+
+			logs, truncated, readErr := executable.ReadTaskLog(taskId.Value, cmd.Lines, executable.TASK_LOG_MAX_FETCH)
+			response := controlcommands.NewMesosCommandResponse_GetTaskLogs(cmd, readErr, taskId.Value, logs, truncated)
+
+			jsonData, marshalError := json.Marshal(response)
+			if marshalError != nil {
+				log.WithFields(logrus.Fields{
+					"commandName":  response.GetCommandName(),
+					"commandId":    response.GetCommandId(),
+					"marshalError": marshalError,
+				}).
+					Error("cannot marshal MesosCommandResponse for sending as MESSAGE")
+				return
+			}
+
+			_, _ = state.cli.Send(context.TODO(), calls.NonStreaming(calls.Message(jsonData)))
+			log.WithFields(logrus.Fields{
+				"commandName": response.GetCommandName(),
+				"commandId":   response.GetCommandId(),
+				"taskId":      response.TaskId,
+				"bytes":       len(logs),
+			}).
+				Trace("response sent")
+		}()
+
 	default:
 		err = errors.New(fmt.Sprintf("unrecognized controlcommand %s", incoming.Name))
 	}